@@ -22,9 +22,20 @@ func CreateBaseballSavantCmd() *cobra.Command {
 	cmd.Flags().IntP("concurrency", "c", 1, fmt.Sprintf("Max number of concurrent goroutines. Dependent on data feed (%s)", feed.BaseballSavantConcurrencyOptions))
 	cmd.Flags().String("feed", "", fmt.Sprintf("The data feed to extract. Options: %s", feed.BaseballSavantOptions))
 	shared.EmbedDateFlag(cmd)
+	shared.EmbedBackfillFlags(cmd)
+	shared.EmbedResumeFlags(cmd)
 	shared.EmbedDestinationFlag(cmd)
 	shared.EmbedFileFormatFlag(cmd)
 	shared.EmbedParquetFlags(cmd)
+	shared.EmbedCSVFlags(cmd)
+	shared.EmbedAvroFlags(cmd)
+	shared.EmbedArrowFlags(cmd)
+	shared.EmbedKafkaFlags(cmd)
 	shared.EmbedS3Flags(cmd)
+	shared.EmbedRateLimitFlags(cmd)
+	shared.EmbedProxyFlag(cmd)
+	shared.EmbedMetricsFlag(cmd)
+	shared.EmbedRawDestinationFlag(cmd)
+	shared.EmbedValidateFlag(cmd)
 	return cmd
 }
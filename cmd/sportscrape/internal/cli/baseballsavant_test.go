@@ -22,6 +22,11 @@ func TestCreateBaseballSavantCmd(t *testing.T) {
 		flags := []string{
 			"feed",
 			"date",
+			"start-date",
+			"end-date",
+			"backfill-concurrency",
+			"resume",
+			"checkpoint-file",
 			"destination",
 			"file-format",
 			"concurrency",
@@ -29,8 +34,22 @@ func TestCreateBaseballSavantCmd(t *testing.T) {
 			"parquet-row-group-size",
 			"parquet-page-size",
 			"parquet-write-parallelism",
+			"csv-delimiter",
+			"csv-quote-all",
+			"avro-codec",
+			"arrow-compression",
+			"kafka-batch-size",
+			"kafka-batch-timeout",
+			"kafka-acks",
+			"kafka-compression",
 			"aws-region",
 			"aws-endpoint",
+			"rate-limit",
+			"rate-burst",
+			"proxy",
+			"metrics-file",
+			"raw-destination",
+			"validate",
 		}
 		for _, flag := range flags {
 			if cmd.Flags().Lookup(flag) == nil {
@@ -50,10 +69,29 @@ func TestCreateBaseballSavantCmd(t *testing.T) {
 			{"parquet-row-group-size", "134217728"}, // 128*1024*1024
 			{"parquet-page-size", "8192"},           // 8*1024
 			{"parquet-write-parallelism", "1"},
+			{"csv-delimiter", ","},
+			{"csv-quote-all", "false"},
+			{"avro-codec", "deflate"},
+			{"arrow-compression", "none"},
+			{"kafka-batch-size", "100"},
+			{"kafka-batch-timeout", "10ms"},
+			{"kafka-acks", "all"},
+			{"kafka-compression", "none"},
 			{"aws-region", "us-east-1"},
 			{"aws-endpoint", ""},
+			{"rate-limit", "0"},
+			{"rate-burst", "0"},
+			{"proxy", ""},
+			{"metrics-file", ""},
+			{"raw-destination", ""},
+			{"validate", ""},
 			{"destination", ""},
 			{"date", ""},
+			{"start-date", ""},
+			{"end-date", ""},
+			{"backfill-concurrency", "1"},
+			{"resume", "false"},
+			{"checkpoint-file", "sportscrape.checkpoint.jsonl"},
 			{"feed", ""},
 		}
 		for _, tc := range cases {
@@ -22,10 +22,20 @@ func createESPNUFCCmd() *cobra.Command {
 	cmd.Flags().String("feed", "", fmt.Sprintf("The data feed to extract. Options: %s", feed.ESPNMMAOptions))
 	cmd.Flags().String("year", "", "YYYY year to extract.")
 	shared.EmbedTimeoutFlag(cmd)
+	shared.EmbedChromeFlags(cmd)
 	shared.EmbedDestinationFlag(cmd)
 	shared.EmbedFileFormatFlag(cmd)
 	shared.EmbedParquetFlags(cmd)
+	shared.EmbedCSVFlags(cmd)
+	shared.EmbedAvroFlags(cmd)
+	shared.EmbedArrowFlags(cmd)
+	shared.EmbedKafkaFlags(cmd)
 	shared.EmbedS3Flags(cmd)
+	shared.EmbedRateLimitFlags(cmd)
+	shared.EmbedProxyFlag(cmd)
+	shared.EmbedMetricsFlag(cmd)
+	shared.EmbedRawDestinationFlag(cmd)
+	shared.EmbedValidateFlag(cmd)
 
 	return cmd
 }
@@ -22,10 +22,21 @@ func createFSMLBCmd() *cobra.Command {
 	cmd.Flags().String("feed", "", fmt.Sprintf("The data feed to extract. Options: %s", feed.FSMLBOptions))
 
 	shared.EmbedDateFlag(cmd)
+	shared.EmbedBackfillFlags(cmd)
+	shared.EmbedResumeFlags(cmd)
 	shared.EmbedDestinationFlag(cmd)
 	shared.EmbedFileFormatFlag(cmd)
 	shared.EmbedParquetFlags(cmd)
+	shared.EmbedCSVFlags(cmd)
+	shared.EmbedAvroFlags(cmd)
+	shared.EmbedArrowFlags(cmd)
+	shared.EmbedKafkaFlags(cmd)
 	shared.EmbedS3Flags(cmd)
+	shared.EmbedRateLimitFlags(cmd)
+	shared.EmbedProxyFlag(cmd)
+	shared.EmbedMetricsFlag(cmd)
+	shared.EmbedRawDestinationFlag(cmd)
+	shared.EmbedValidateFlag(cmd)
 
 	return cmd
 }
@@ -43,10 +54,21 @@ func createFSNBACmd() *cobra.Command {
 	cmd.Flags().String("feed", "", fmt.Sprintf("The data feed to extract. Options: %s", feed.FSNBAOptions))
 
 	shared.EmbedDateFlag(cmd)
+	shared.EmbedBackfillFlags(cmd)
+	shared.EmbedResumeFlags(cmd)
 	shared.EmbedDestinationFlag(cmd)
 	shared.EmbedFileFormatFlag(cmd)
 	shared.EmbedParquetFlags(cmd)
+	shared.EmbedCSVFlags(cmd)
+	shared.EmbedAvroFlags(cmd)
+	shared.EmbedArrowFlags(cmd)
+	shared.EmbedKafkaFlags(cmd)
 	shared.EmbedS3Flags(cmd)
+	shared.EmbedRateLimitFlags(cmd)
+	shared.EmbedProxyFlag(cmd)
+	shared.EmbedMetricsFlag(cmd)
+	shared.EmbedRawDestinationFlag(cmd)
+	shared.EmbedValidateFlag(cmd)
 
 	return cmd
 }
@@ -64,10 +86,21 @@ func createFSWNBACmd() *cobra.Command {
 	cmd.Flags().String("feed", "", fmt.Sprintf("The data feed to extract. Options: %s", feed.FSNBAOptions))
 
 	shared.EmbedDateFlag(cmd)
+	shared.EmbedBackfillFlags(cmd)
+	shared.EmbedResumeFlags(cmd)
 	shared.EmbedDestinationFlag(cmd)
 	shared.EmbedFileFormatFlag(cmd)
 	shared.EmbedParquetFlags(cmd)
+	shared.EmbedCSVFlags(cmd)
+	shared.EmbedAvroFlags(cmd)
+	shared.EmbedArrowFlags(cmd)
+	shared.EmbedKafkaFlags(cmd)
 	shared.EmbedS3Flags(cmd)
+	shared.EmbedRateLimitFlags(cmd)
+	shared.EmbedProxyFlag(cmd)
+	shared.EmbedMetricsFlag(cmd)
+	shared.EmbedRawDestinationFlag(cmd)
+	shared.EmbedValidateFlag(cmd)
 
 	return cmd
 }
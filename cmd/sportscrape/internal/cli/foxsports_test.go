@@ -47,6 +47,11 @@ func testFSSubCmd(t *testing.T, name string, createFn func() *cobra.Command) {
 		flags := []string{
 			"feed",
 			"date",
+			"start-date",
+			"end-date",
+			"backfill-concurrency",
+			"resume",
+			"checkpoint-file",
 			"concurrency",
 			"destination",
 			"file-format",
@@ -54,8 +59,22 @@ func testFSSubCmd(t *testing.T, name string, createFn func() *cobra.Command) {
 			"parquet-row-group-size",
 			"parquet-page-size",
 			"parquet-write-parallelism",
+			"csv-delimiter",
+			"csv-quote-all",
+			"avro-codec",
+			"arrow-compression",
+			"kafka-batch-size",
+			"kafka-batch-timeout",
+			"kafka-acks",
+			"kafka-compression",
 			"aws-region",
 			"aws-endpoint",
+			"rate-limit",
+			"rate-burst",
+			"proxy",
+			"metrics-file",
+			"raw-destination",
+			"validate",
 		}
 		for _, flag := range flags {
 			if cmd.Flags().Lookup(flag) == nil {
@@ -75,10 +94,29 @@ func testFSSubCmd(t *testing.T, name string, createFn func() *cobra.Command) {
 			{"parquet-row-group-size", "134217728"},
 			{"parquet-page-size", "8192"},
 			{"parquet-write-parallelism", "1"},
+			{"csv-delimiter", ","},
+			{"csv-quote-all", "false"},
+			{"avro-codec", "deflate"},
+			{"arrow-compression", "none"},
+			{"kafka-batch-size", "100"},
+			{"kafka-batch-timeout", "10ms"},
+			{"kafka-acks", "all"},
+			{"kafka-compression", "none"},
 			{"aws-region", "us-east-1"},
 			{"aws-endpoint", ""},
+			{"rate-limit", "0"},
+			{"rate-burst", "0"},
+			{"proxy", ""},
+			{"metrics-file", ""},
+			{"raw-destination", ""},
+			{"validate", ""},
 			{"destination", ""},
 			{"date", ""},
+			{"start-date", ""},
+			{"end-date", ""},
+			{"backfill-concurrency", "1"},
+			{"resume", "false"},
+			{"checkpoint-file", "sportscrape.checkpoint.jsonl"},
 			{"feed", ""},
 		}
 		for _, tc := range cases {
@@ -21,11 +21,24 @@ func CreateNBACmd() *cobra.Command {
 	cmd.Flags().IntP("concurrency", "c", 1, fmt.Sprintf("Max number of concurrent goroutines. Dependent on data feed (%s)", feed.NBAConcurrencyOptions))
 	cmd.Flags().String("feed", "", fmt.Sprintf("The data feed to extract. Options: %s", feed.NBAOptions))
 	shared.EmbedDateFlag(cmd)
+	shared.EmbedBackfillFlags(cmd)
+	shared.EmbedResumeFlags(cmd)
 	shared.EmbedTimeoutFlag(cmd)
+	shared.EmbedChromeFlags(cmd)
+	shared.EmbedHTTPFirstFlag(cmd)
 	shared.EmbedDestinationFlag(cmd)
 	shared.EmbedFileFormatFlag(cmd)
 	shared.EmbedParquetFlags(cmd)
+	shared.EmbedCSVFlags(cmd)
+	shared.EmbedAvroFlags(cmd)
+	shared.EmbedArrowFlags(cmd)
+	shared.EmbedKafkaFlags(cmd)
 	shared.EmbedS3Flags(cmd)
+	shared.EmbedRateLimitFlags(cmd)
+	shared.EmbedProxyFlag(cmd)
+	shared.EmbedMetricsFlag(cmd)
+	shared.EmbedRawDestinationFlag(cmd)
+	shared.EmbedValidateFlag(cmd)
 
 	return cmd
 }
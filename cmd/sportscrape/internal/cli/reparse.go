@@ -0,0 +1,29 @@
+package cli
+
+import (
+	"github.com/lightning-dabbler/sportscrape/cmd/sportscrape/internal/shared"
+
+	"github.com/spf13/cobra"
+)
+
+func CreateReparseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reparse",
+		Short: "Rebuild data from archived raw payloads",
+		Long:  "Rebuild the data of a feed from the raw payloads archived with --raw-destination, without fetching anything",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return shared.Reparse(cmd)
+		},
+	}
+	cmd.Flags().String("feed", "", "The archived data feed to reparse, named as in the archive keys, e.g. 'nba-play-by-play' or 'fox-sports-mlb-odds-total'")
+	cmd.Flags().String("source", "", "Raw payload archive to read: a local directory (or file://) or s3://bucket/prefix")
+	shared.EmbedDestinationFlag(cmd)
+	shared.EmbedFileFormatFlag(cmd)
+	shared.EmbedParquetFlags(cmd)
+	shared.EmbedCSVFlags(cmd)
+	shared.EmbedAvroFlags(cmd)
+	shared.EmbedArrowFlags(cmd)
+	shared.EmbedKafkaFlags(cmd)
+	shared.EmbedS3Flags(cmd)
+	return cmd
+}
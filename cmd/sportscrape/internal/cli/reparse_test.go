@@ -0,0 +1,79 @@
+//go:build unit
+
+package cli
+
+import (
+	"testing"
+)
+
+func TestCreateReparseCmd(t *testing.T) {
+	cmd := CreateReparseCmd()
+
+	t.Run("command metadata", func(t *testing.T) {
+		if cmd.Use != "reparse" {
+			t.Errorf("Use = %q, want %q", cmd.Use, "reparse")
+		}
+	})
+
+	t.Run("required flags exist", func(t *testing.T) {
+		flags := []string{
+			"feed",
+			"source",
+			"destination",
+			"file-format",
+			"parquet-compression",
+			"parquet-row-group-size",
+			"parquet-page-size",
+			"parquet-write-parallelism",
+			"csv-delimiter",
+			"csv-quote-all",
+			"avro-codec",
+			"arrow-compression",
+			"kafka-batch-size",
+			"kafka-batch-timeout",
+			"kafka-acks",
+			"kafka-compression",
+			"aws-region",
+			"aws-endpoint",
+		}
+		for _, flag := range flags {
+			if cmd.Flags().Lookup(flag) == nil {
+				t.Errorf("expected flag --%s to be registered", flag)
+			}
+		}
+	})
+
+	t.Run("flag defaults", func(t *testing.T) {
+		cases := []struct {
+			flag string
+			want string
+		}{
+			{"file-format", "jsonl"},
+			{"parquet-compression", "SNAPPY"},
+			{"parquet-row-group-size", "134217728"}, // 128*1024*1024
+			{"parquet-page-size", "8192"},           // 8*1024
+			{"parquet-write-parallelism", "1"},
+			{"csv-delimiter", ","},
+			{"csv-quote-all", "false"},
+			{"avro-codec", "deflate"},
+			{"arrow-compression", "none"},
+			{"kafka-batch-size", "100"},
+			{"kafka-batch-timeout", "10ms"},
+			{"kafka-acks", "all"},
+			{"kafka-compression", "none"},
+			{"aws-region", "us-east-1"},
+			{"aws-endpoint", ""},
+			{"destination", ""},
+			{"source", ""},
+			{"feed", ""},
+		}
+		for _, tc := range cases {
+			t.Run(tc.flag, func(t *testing.T) {
+				got := cmd.Flags().Lookup(tc.flag).DefValue
+				if got != tc.want {
+					t.Errorf("flag --%s default = %q, want %q", tc.flag, got, tc.want)
+				}
+			})
+		}
+	})
+}
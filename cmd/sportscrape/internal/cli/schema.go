@@ -0,0 +1,21 @@
+package cli
+
+import (
+	"github.com/lightning-dabbler/sportscrape/cmd/sportscrape/internal/shared"
+
+	"github.com/spf13/cobra"
+)
+
+func CreateSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Print the output schema of a data feed",
+		Long:  "Print the output schema of a data feed as JSON Schema, a Parquet message or an Avro record schema, derived from the model's struct tags and field comments",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return shared.Schema(cmd)
+		},
+	}
+	cmd.Flags().String("feed", "", "The data feed, named as in the raw archive keys (e.g. 'nba-play-by-play', 'fox-sports-mlb-matchup') or as a sportscrape feed (e.g. 'nba play by play')")
+	cmd.Flags().String("format", "json-schema", "The schema format. Options: json-schema, parquet, avro")
+	return cmd
+}
@@ -0,0 +1,82 @@
+//go:build unit
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestCreateSchemaCmd(t *testing.T) {
+	t.Run("command metadata", func(t *testing.T) {
+		cmd := CreateSchemaCmd()
+		if cmd.Use != "schema" {
+			t.Errorf("Use = %q, want %q", cmd.Use, "schema")
+		}
+	})
+
+	t.Run("flag defaults", func(t *testing.T) {
+		cmd := CreateSchemaCmd()
+		cases := []struct {
+			flag string
+			want string
+		}{
+			{"feed", ""},
+			{"format", "json-schema"},
+		}
+		for _, tc := range cases {
+			t.Run(tc.flag, func(t *testing.T) {
+				got := cmd.Flags().Lookup(tc.flag).DefValue
+				if got != tc.want {
+					t.Errorf("flag --%s default = %q, want %q", tc.flag, got, tc.want)
+				}
+			})
+		}
+	})
+
+	t.Run("output", func(t *testing.T) {
+		cases := []struct {
+			args     []string
+			contains string
+			json     bool
+		}{
+			{args: []string{"--feed", "nba-play-by-play"}, contains: `"$schema"`, json: true},
+			{args: []string{"--feed", "nba play by play", "--format", "avro"}, contains: `"logicalType": "timestamp-millis"`, json: true},
+			{args: []string{"--feed", "baseball-savant-mlb-play-by-play", "--format", "parquet"}, contains: "optional int32 hit_distance;"},
+		}
+		for _, tc := range cases {
+			t.Run(strings.Join(tc.args, " "), func(t *testing.T) {
+				cmd := CreateSchemaCmd()
+				var out bytes.Buffer
+				cmd.SetOut(&out)
+				cmd.SetArgs(tc.args)
+				if err := cmd.Execute(); err != nil {
+					t.Fatal(err)
+				}
+				if !strings.Contains(out.String(), tc.contains) {
+					t.Errorf("output does not contain %q:\n%s", tc.contains, out.String())
+				}
+				if tc.json && !json.Valid(out.Bytes()) {
+					t.Errorf("output is not valid JSON:\n%s", out.String())
+				}
+			})
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, args := range [][]string{
+			{"--feed", "nba-unknown"},
+			{"--feed", "nba-play-by-play", "--format", "xml"},
+		} {
+			cmd := CreateSchemaCmd()
+			cmd.SetArgs(args)
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+			if err := cmd.Execute(); err == nil {
+				t.Errorf("%v: expected an error", args)
+			}
+		}
+	})
+}
@@ -0,0 +1,160 @@
+package exporters
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/lightning-dabbler/sportscrape/schema"
+)
+
+// ArrowCompression compresses the buffers of the record batches of an Arrow IPC file.
+type ArrowCompression string
+
+const (
+	ArrowUncompressed ArrowCompression = "none"
+	ArrowLZ4          ArrowCompression = "lz4"
+	ArrowZstd         ArrowCompression = "zstd"
+)
+
+// ParseArrowCompression returns the ArrowCompression named compression.
+func ParseArrowCompression(compression string) (ArrowCompression, error) {
+	switch c := ArrowCompression(compression); c {
+	case ArrowUncompressed, ArrowLZ4, ArrowZstd:
+		return c, nil
+	default:
+		return "", fmt.Errorf("invalid arrow compression %q, valid options: none, lz4, zstd", compression)
+	}
+}
+
+type ArrowConfig struct {
+	// Compression compresses every buffer of the record batches. Default ArrowUncompressed.
+	Compression ArrowCompression
+}
+
+type ArrowConfigOption func(*ArrowConfig)
+
+func WithArrowCompression(compression ArrowCompression) ArrowConfigOption {
+	return func(cfg *ArrowConfig) { cfg.Compression = compression }
+}
+
+func newArrowConfig(opts ...ArrowConfigOption) ArrowConfig {
+	cfg := ArrowConfig{Compression: ArrowUncompressed}
+	for _, o := range opts {
+		o(&cfg)
+	}
+	return cfg
+}
+
+// arrowBatchRows is the max number of records per record batch.
+const arrowBatchRows = 64 * 1024
+
+// encodeArrow renders records as an Arrow IPC file (Feather v2) of record
+// batches whose schema is derived from the struct tags of E (see schema.Of):
+// strings are utf8, timestamps are timestamp[ms, UTC], dates are date32 and
+// pointer fields are nullable.
+func encodeArrow[E any](records []E, cfg ArrowConfig) ([]byte, error) {
+	s, err := schema.Of[E]()
+	if err != nil {
+		return nil, fmt.Errorf("arrow schema: %w", err)
+	}
+	fields := columnFields[E](s.Columns)
+	arrowSchema := arrowSchemaOf(s)
+	opts := []ipc.Option{ipc.WithSchema(arrowSchema)}
+	switch cfg.Compression {
+	case ArrowLZ4:
+		opts = append(opts, ipc.WithLZ4())
+	case ArrowZstd:
+		opts = append(opts, ipc.WithZstd())
+	}
+
+	var out bytes.Buffer
+	w, err := ipc.NewFileWriter(&out, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("arrow writer: %w", err)
+	}
+	b := array.NewRecordBuilder(memory.DefaultAllocator, arrowSchema)
+	defer b.Release()
+	for start := 0; start < len(records); start += arrowBatchRows {
+		for _, record := range records[start:min(start+arrowBatchRows, len(records))] {
+			v := recordValue(record)
+			for j, column := range s.Columns {
+				appendArrowValue(b.Field(j), column, v.FieldByIndex(fields[j]))
+			}
+		}
+		batch := b.NewRecordBatch()
+		err := w.Write(batch)
+		batch.Release()
+		if err != nil {
+			return nil, fmt.Errorf("write record batch: %w", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("close arrow writer: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// arrowSchemaOf returns the Arrow schema of s.
+func arrowSchemaOf(s schema.Schema) *arrow.Schema {
+	fields := make([]arrow.Field, len(s.Columns))
+	for i, column := range s.Columns {
+		fields[i] = arrow.Field{Name: column.Name, Type: arrowType(column.Type), Nullable: column.Nullable}
+	}
+	return arrow.NewSchema(fields, nil)
+}
+
+// arrowType returns the Arrow data type of t.
+func arrowType(t schema.Type) arrow.DataType {
+	switch t {
+	case schema.Boolean:
+		return arrow.FixedWidthTypes.Boolean
+	case schema.Int32:
+		return arrow.PrimitiveTypes.Int32
+	case schema.Int64:
+		return arrow.PrimitiveTypes.Int64
+	case schema.Float:
+		return arrow.PrimitiveTypes.Float32
+	case schema.Double:
+		return arrow.PrimitiveTypes.Float64
+	case schema.Timestamp:
+		return &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "UTC"}
+	case schema.Date:
+		return arrow.FixedWidthTypes.Date32
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+// appendArrowValue appends the value of column held by v to b, null for a nil pointer.
+func appendArrowValue(b array.Builder, column schema.Column, v reflect.Value) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			b.AppendNull()
+			return
+		}
+		v = v.Elem()
+	}
+	switch column.Type {
+	case schema.Boolean:
+		b.(*array.BooleanBuilder).Append(v.Bool())
+	case schema.Int32:
+		b.(*array.Int32Builder).Append(int32(intValue(v)))
+	case schema.Int64:
+		b.(*array.Int64Builder).Append(intValue(v))
+	case schema.Float:
+		b.(*array.Float32Builder).Append(float32(v.Float()))
+	case schema.Double:
+		b.(*array.Float64Builder).Append(v.Float())
+	case schema.Timestamp:
+		b.(*array.TimestampBuilder).Append(arrow.Timestamp(timestampMillis(v)))
+	case schema.Date:
+		b.(*array.Date32Builder).Append(arrow.Date32(dateDays(v)))
+	default:
+		b.(*array.StringBuilder).Append(v.String())
+	}
+}
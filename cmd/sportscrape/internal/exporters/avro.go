@@ -0,0 +1,138 @@
+package exporters
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hamba/avro/v2"
+	"github.com/hamba/avro/v2/ocf"
+	"github.com/lightning-dabbler/sportscrape/schema"
+)
+
+// AvroCodec compresses the blocks of an Avro object container file.
+type AvroCodec string
+
+const (
+	AvroNull    AvroCodec = "null"
+	AvroDeflate AvroCodec = "deflate"
+	AvroSnappy  AvroCodec = "snappy"
+)
+
+// ParseAvroCodec returns the AvroCodec named codec.
+func ParseAvroCodec(codec string) (AvroCodec, error) {
+	switch c := AvroCodec(codec); c {
+	case AvroNull, AvroDeflate, AvroSnappy:
+		return c, nil
+	default:
+		return "", fmt.Errorf("invalid avro codec %q, valid options: null, deflate, snappy", codec)
+	}
+}
+
+type AvroConfig struct {
+	// Codec compresses the file's blocks. Default AvroDeflate.
+	Codec AvroCodec
+}
+
+type AvroConfigOption func(*AvroConfig)
+
+func WithAvroCodec(codec AvroCodec) AvroConfigOption {
+	return func(cfg *AvroConfig) { cfg.Codec = codec }
+}
+
+func newAvroConfig(opts ...AvroConfigOption) AvroConfig {
+	cfg := AvroConfig{Codec: AvroDeflate}
+	for _, o := range opts {
+		o(&cfg)
+	}
+	return cfg
+}
+
+// avroBlockSize is the uncompressed size past which records start a new block.
+const avroBlockSize = 1 << 20
+
+// encodeAvro renders records as an Avro object container file whose schema is
+// derived from the struct tags of E (see schema.Of).
+func encodeAvro[E any](records []E, cfg AvroConfig) ([]byte, error) {
+	s, err := schema.Of[E]()
+	if err != nil {
+		return nil, fmt.Errorf("avro schema: %w", err)
+	}
+	avroSchema, err := parseAvroSchema(s)
+	if err != nil {
+		return nil, err
+	}
+	fields := columnFields[E](s.Columns)
+
+	var out bytes.Buffer
+	enc, err := ocf.NewEncoderWithSchema(avroSchema, &out,
+		ocf.WithCodec(ocf.CodecName(cfg.Codec)),
+		ocf.WithBlockSize(avroBlockSize),
+		// Keep the docs and logical types of schema.Schema.Avro in the file header
+		ocf.WithSchemaMarshaler(ocf.FullSchemaMarshaler),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("avro encoder: %w", err)
+	}
+	for i, record := range records {
+		if err := enc.Encode(avroDatum(s.Columns, fields, recordValue(record))); err != nil {
+			return nil, fmt.Errorf("encode record %d: %w", i, err)
+		}
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("close avro encoder: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// parseAvroSchema parses the Avro schema of s (see schema.Schema.Avro).
+func parseAvroSchema(s schema.Schema) (avro.Schema, error) {
+	b, err := s.Avro()
+	if err != nil {
+		return nil, fmt.Errorf("avro schema: %w", err)
+	}
+	avroSchema, err := avro.ParseBytes(b)
+	if err != nil {
+		return nil, fmt.Errorf("parse avro schema: %w", err)
+	}
+	return avroSchema, nil
+}
+
+// avroDatum returns the record held by v as a map of its column values,
+// encoded by avro.Marshal and ocf.Encoder. Nil pointers are null.
+func avroDatum(columns []schema.Column, fields [][]int, v reflect.Value) map[string]any {
+	datum := make(map[string]any, len(columns))
+	for i, column := range columns {
+		datum[column.Name] = avroValue(column, v.FieldByIndex(fields[i]))
+	}
+	return datum
+}
+
+// avroValue returns the Avro value of column held by v, nil for a nil pointer.
+func avroValue(column schema.Column, v reflect.Value) any {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	switch column.Type {
+	case schema.Boolean:
+		return v.Bool()
+	case schema.Int32:
+		return int32(intValue(v))
+	case schema.Int64:
+		return intValue(v)
+	case schema.Float:
+		return float32(v.Float())
+	case schema.Double:
+		return v.Float()
+	case schema.Timestamp:
+		return time.UnixMilli(timestampMillis(v)).UTC()
+	case schema.Date:
+		return time.Unix(int64(dateDays(v))*24*60*60, 0).UTC()
+	default:
+		return v.String()
+	}
+}
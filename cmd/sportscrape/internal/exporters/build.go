@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log/slog"
 	"net/url"
+
+	"github.com/lightning-dabbler/sportscrape"
 )
 
 type S3Config struct {
@@ -12,6 +14,23 @@ type S3Config struct {
 	Region   string
 }
 
+// Options configure the exporters returned by Build. Options of other
+// destinations and formats are ignored.
+type Options struct {
+	// S3 configures s3:// destinations.
+	S3      S3Config
+	Parquet []ParquetConfigOption
+	CSV     []CSVConfigOption
+	Avro    []AvroConfigOption
+	Arrow   []ArrowConfigOption
+	Kafka   []KafkaConfigOption
+	// Provider and Feed are the source of the records, e.g. sent in the
+	// provider and feed headers of Kafka messages. Feed also names the
+	// default table of SQLite and PostgreSQL destinations.
+	Provider sportscrape.Provider
+	Feed     sportscrape.Feed
+}
+
 var (
 	ErrUnsupportedDestination = fmt.Errorf("unsupported destination")
 	ErrUnsupportedFormat      = fmt.Errorf("unsupported format")
@@ -19,7 +38,7 @@ var (
 
 func ValidateFormat(format string) error {
 	switch format {
-	case "jsonl", "parquet":
+	case "jsonl", "parquet", "csv", "avro", "arrow":
 		return nil
 	default:
 		return fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
@@ -29,7 +48,10 @@ func ValidateFormat(format string) error {
 // Build returns the appropriate Exporter based on the outputPath scheme.
 // Local paths (no scheme or file://) return a LocalExporter.
 // s3:// paths return an S3Exporter.
-func Build[E any](outputPath string, s3cfg S3Config, parquetOpts ...ParquetConfigOption) (Exporter[E], error) {
+// sqlite:// and postgres:// paths return an SQLiteExporter and a PostgresExporter,
+// which ignore the file format.
+// kafka:// paths return a KafkaExporter, publishing jsonl or avro messages.
+func Build[E any](outputPath string, options Options) (Exporter[E], error) {
 	destination, err := url.Parse(outputPath)
 	if err != nil {
 		return nil, fmt.Errorf("invalid output path %q: %w", outputPath, err)
@@ -37,34 +59,48 @@ func Build[E any](outputPath string, s3cfg S3Config, parquetOpts ...ParquetConfi
 
 	switch destination.Scheme {
 	case "file", "":
-		return NewLocalExporter[E](outputPath, parquetOpts...), nil
+		exp := NewLocalExporter[E](outputPath, options.Parquet...)
+		exp.CSVConfig = newCSVConfig(options.CSV...)
+		exp.AvroConfig = newAvroConfig(options.Avro...)
+		exp.ArrowConfig = newArrowConfig(options.Arrow...)
+		return exp, nil
 	case "s3":
-		return NewS3Exporter[E](outputPath, parquetOpts,
-			WithEndpoint(s3cfg.Endpoint),
-			WithRegion(s3cfg.Region),
-		), nil
+		exp := NewS3Exporter[E](outputPath, options.Parquet,
+			WithEndpoint(options.S3.Endpoint),
+			WithRegion(options.S3.Region),
+		)
+		exp.CSVConfig = newCSVConfig(options.CSV...)
+		exp.AvroConfig = newAvroConfig(options.Avro...)
+		exp.ArrowConfig = newArrowConfig(options.Arrow...)
+		return exp, nil
+	case "sqlite":
+		exp := NewSQLiteExporter[E](outputPath)
+		exp.Feed = options.Feed
+		return exp, nil
+	case "postgres", "postgresql":
+		exp := NewPostgresExporter[E](outputPath)
+		exp.Feed = options.Feed
+		return exp, nil
+	case "kafka":
+		exp := NewKafkaExporter[E](outputPath, options.Kafka...)
+		exp.Provider = options.Provider
+		exp.Feed = options.Feed
+		return exp, nil
 	}
 	return nil, fmt.Errorf("%w: %q", ErrUnsupportedDestination, destination.Scheme)
 }
 
 // BuildAndWrite infers the destination from outputPath (no scheme or file:// → local, s3:// → S3),
-// writes records in the given format ("jsonl" or "parquet"), and returns
+// writes records in the given format ("jsonl", "parquet", "csv", "avro" or "arrow"), and returns
 // ErrUnsupportedDestination or ErrUnsupportedFormat if either is unrecognised.
-func BuildAndWrite[E any](ctx context.Context, outputPath, format string, s3cfg S3Config, records []E, parquetOpts ...ParquetConfigOption) error {
+func BuildAndWrite[E any](ctx context.Context, outputPath, format string, records []E, options Options) error {
 	if len(records) == 0 {
 		slog.Info("no data to write", "output_path", outputPath)
 		return nil
 	}
-	exp, err := Build[E](outputPath, s3cfg, parquetOpts...)
+	exp, err := Build[E](outputPath, options)
 	if err != nil {
 		return err
 	}
-	switch format {
-	case "jsonl":
-		return exp.WriteJSONL(ctx, records)
-	case "parquet":
-		return exp.WriteParquet(ctx, records)
-	default:
-		return fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
-	}
+	return exp.Write(ctx, format, records)
 }
@@ -0,0 +1,65 @@
+package exporters
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape/schema"
+)
+
+// columnFields returns the index of the struct field of E read for each column.
+func columnFields[E any](columns []schema.Column) [][]int {
+	t := reflect.TypeFor[E]()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	fields := make([][]int, len(columns))
+	for i, column := range columns {
+		field, _ := t.FieldByName(column.Field)
+		fields[i] = field.Index
+	}
+	return fields
+}
+
+// recordValue returns the struct value of record.
+func recordValue[E any](record E) reflect.Value {
+	v := reflect.ValueOf(record)
+	for v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	return v
+}
+
+// intValue returns the integer held by v, signed or not.
+func intValue(v reflect.Value) int64 {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint())
+	default:
+		return v.Int()
+	}
+}
+
+// timestampMillis returns the milliseconds since the Unix epoch of a
+// schema.Timestamp column value: a time.Time, or an int64 already in
+// milliseconds such as the *Parquet twin fields.
+func timestampMillis(v reflect.Value) int64 {
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.UnixMilli()
+	}
+	return v.Int()
+}
+
+// dateDays returns the days since the Unix epoch of a schema.Date column
+// value: a time.Time, or an int32 already in days.
+func dateDays(v reflect.Value) int32 {
+	if t, ok := v.Interface().(time.Time); ok {
+		seconds := t.Unix()
+		days := seconds / (24 * 60 * 60)
+		if seconds < 0 && seconds%(24*60*60) != 0 {
+			days--
+		}
+		return int32(days)
+	}
+	return int32(v.Int())
+}
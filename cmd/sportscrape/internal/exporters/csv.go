@@ -0,0 +1,189 @@
+package exporters
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type CSVConfig struct {
+	// Delimiter separates the fields of a row. Default ','.
+	Delimiter rune
+	// QuoteAll quotes every field. Default false = only fields containing the
+	// delimiter, quotes, line breaks or leading spaces are quoted.
+	QuoteAll bool
+}
+
+type CSVConfigOption func(*CSVConfig)
+
+func WithDelimiter(delimiter rune) CSVConfigOption {
+	return func(cfg *CSVConfig) { cfg.Delimiter = delimiter }
+}
+
+func WithQuoteAll(quoteAll bool) CSVConfigOption {
+	return func(cfg *CSVConfig) { cfg.QuoteAll = quoteAll }
+}
+
+func newCSVConfig(opts ...CSVConfigOption) CSVConfig {
+	cfg := CSVConfig{Delimiter: ','}
+	for _, o := range opts {
+		o(&cfg)
+	}
+	return cfg
+}
+
+// ValidateDelimiter checks that delimiter can separate CSV fields.
+func ValidateDelimiter(delimiter string) (rune, error) {
+	r, size := utf8.DecodeRuneInString(delimiter)
+	if size == 0 || size != len(delimiter) || r == '"' || r == '\r' || r == '\n' || r == utf8.RuneError {
+		return 0, fmt.Errorf("invalid csv delimiter %q: must be a single character other than a quote or line break", delimiter)
+	}
+	return r, nil
+}
+
+// csvColumn is a field of a record written as a CSV column.
+type csvColumn struct {
+	header string
+	index  int
+}
+
+// csvColumns returns the columns of the record type t, named after their json
+// keys. Unexported fields and fields tagged json:"-" are skipped.
+func csvColumns(t reflect.Type) []csvColumn {
+	var columns []csvColumn
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		columns = append(columns, csvColumn{header: name, index: i})
+	}
+	return columns
+}
+
+// csvCell renders v as a CSV field: nil pointers are empty, timestamps are RFC
+// 3339 and values other than strings, booleans and numbers are JSON.
+func csvCell(v reflect.Value) (string, error) {
+	if v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", nil
+		}
+		v = v.Elem()
+	}
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339Nano), nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32), nil
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	default:
+		b, err := json.Marshal(v.Interface())
+		return string(b), err
+	}
+}
+
+// encodeCSV renders records as CSV with a header row.
+func encodeCSV[E any](records []E, cfg CSVConfig) ([]byte, error) {
+	t := reflect.TypeFor[E]()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("csv records must be structs, got %s", t)
+	}
+	columns := csvColumns(t)
+	row := make([]string, len(columns))
+
+	var buf bytes.Buffer
+	w := newCSVRowWriter(&buf, cfg)
+	for i, column := range columns {
+		row[i] = column.header
+	}
+	if err := w.write(row); err != nil {
+		return nil, err
+	}
+	for i, record := range records {
+		v := reflect.ValueOf(record)
+		for v.Kind() == reflect.Pointer {
+			v = v.Elem()
+		}
+		for j, column := range columns {
+			cell, err := csvCell(v.Field(column.index))
+			if err != nil {
+				return nil, fmt.Errorf("encode record %d field %s: %w", i, column.header, err)
+			}
+			row[j] = cell
+		}
+		if err := w.write(row); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// csvRowWriter writes rows with encoding/csv, or quoting every field when
+// QuoteAll is set, which encoding/csv does not support.
+type csvRowWriter struct {
+	cfg    CSVConfig
+	buf    *bytes.Buffer
+	writer *csv.Writer
+}
+
+func newCSVRowWriter(buf *bytes.Buffer, cfg CSVConfig) *csvRowWriter {
+	w := &csvRowWriter{cfg: cfg, buf: buf}
+	if !cfg.QuoteAll {
+		w.writer = csv.NewWriter(buf)
+		w.writer.Comma = cfg.Delimiter
+	}
+	return w
+}
+
+func (w *csvRowWriter) write(row []string) error {
+	if w.writer != nil {
+		return w.writer.Write(row)
+	}
+	for i, field := range row {
+		if i > 0 {
+			w.buf.WriteRune(w.cfg.Delimiter)
+		}
+		w.buf.WriteByte('"')
+		w.buf.WriteString(strings.ReplaceAll(field, `"`, `""`))
+		w.buf.WriteByte('"')
+	}
+	w.buf.WriteByte('\n')
+	return nil
+}
+
+func (w *csvRowWriter) flush() error {
+	if w.writer == nil {
+		return nil
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
@@ -6,15 +6,26 @@ import (
 	"net/url"
 )
 
+// Exporter writes records to a destination, see Build.
 type Exporter[E any] interface {
-	WriteParquet(ctx context.Context, record []E) error
-	WriteJSONL(ctx context.Context, record []E) error
+	// Write writes records in format, one of the formats of ValidateFormat.
+	// Database destinations ignore format.
+	Write(ctx context.Context, format string, records []E) error
 }
 
 func SupportedDestination(destination *url.URL) error {
 	scheme := destination.Scheme
-	if scheme == "file" || scheme == "" || scheme == "s3" {
+	if scheme == "file" || scheme == "" || scheme == "s3" || scheme == "kafka" || databaseSchemes[scheme] {
 		return nil
 	}
-	return fmt.Errorf("unsupported destination type: %s. Only local and S3 supported", scheme)
+	return fmt.Errorf("unsupported destination type: %s. Only local, S3, SQLite, PostgreSQL and Kafka supported", scheme)
+}
+
+// ValidateDestinationFormat returns ErrUnsupportedFormat if outputPath cannot
+// take format: Kafka topics take jsonl or avro messages.
+func ValidateDestinationFormat(outputPath, format string) error {
+	if IsKafkaDestination(outputPath) && format != "jsonl" && format != "avro" {
+		return fmt.Errorf("%w %q for a kafka destination, valid options: jsonl, avro", ErrUnsupportedFormat, format)
+	}
+	return nil
 }
@@ -0,0 +1,478 @@
+package exporters
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hamba/avro/v2"
+	"github.com/hamba/avro/v2/pkg/crc64"
+	"github.com/lightning-dabbler/sportscrape"
+	"github.com/lightning-dabbler/sportscrape/schema"
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaAcks is the acknowledgement a publish waits for.
+type KafkaAcks string
+
+const (
+	KafkaAcksNone   KafkaAcks = "none"
+	KafkaAcksLeader KafkaAcks = "leader"
+	KafkaAcksAll    KafkaAcks = "all"
+)
+
+// ParseKafkaAcks returns the KafkaAcks named acks.
+func ParseKafkaAcks(acks string) (KafkaAcks, error) {
+	switch a := KafkaAcks(acks); a {
+	case KafkaAcksNone, KafkaAcksLeader, KafkaAcksAll:
+		return a, nil
+	default:
+		return "", fmt.Errorf("invalid kafka acks %q, valid options: none, leader, all", acks)
+	}
+}
+
+// KafkaCompression compresses the message batches published to Kafka.
+type KafkaCompression string
+
+const (
+	KafkaNone   KafkaCompression = "none"
+	KafkaGzip   KafkaCompression = "gzip"
+	KafkaSnappy KafkaCompression = "snappy"
+	KafkaLZ4    KafkaCompression = "lz4"
+	KafkaZSTD   KafkaCompression = "zstd"
+)
+
+// ParseKafkaCompression returns the KafkaCompression named compression.
+func ParseKafkaCompression(compression string) (KafkaCompression, error) {
+	switch c := KafkaCompression(compression); c {
+	case KafkaNone, KafkaGzip, KafkaSnappy, KafkaLZ4, KafkaZSTD:
+		return c, nil
+	default:
+		return "", fmt.Errorf("invalid kafka compression %q, valid options: none, gzip, snappy, lz4, zstd", compression)
+	}
+}
+
+type KafkaConfig struct {
+	// BatchSize is the number of messages buffered before a batch is published. Default 100.
+	BatchSize int
+	// BatchTimeout is how long an incomplete batch is buffered before it is published. Default 10ms.
+	BatchTimeout time.Duration
+	// Acks is the acknowledgement each batch waits for. Default KafkaAcksAll.
+	Acks KafkaAcks
+	// Compression compresses each batch. Default KafkaNone.
+	Compression KafkaCompression
+}
+
+type KafkaConfigOption func(*KafkaConfig)
+
+func WithKafkaBatchSize(size int) KafkaConfigOption {
+	return func(cfg *KafkaConfig) { cfg.BatchSize = size }
+}
+
+func WithKafkaBatchTimeout(timeout time.Duration) KafkaConfigOption {
+	return func(cfg *KafkaConfig) { cfg.BatchTimeout = timeout }
+}
+
+func WithKafkaAcks(acks KafkaAcks) KafkaConfigOption {
+	return func(cfg *KafkaConfig) { cfg.Acks = acks }
+}
+
+func WithKafkaCompression(compression KafkaCompression) KafkaConfigOption {
+	return func(cfg *KafkaConfig) { cfg.Compression = compression }
+}
+
+func newKafkaConfig(opts ...KafkaConfigOption) KafkaConfig {
+	cfg := KafkaConfig{
+		BatchSize:    100,
+		BatchTimeout: 10 * time.Millisecond,
+		Acks:         KafkaAcksAll,
+		Compression:  KafkaNone,
+	}
+	for _, o := range opts {
+		o(&cfg)
+	}
+	return cfg
+}
+
+// IsKafkaDestination reports whether outputPath is a Kafka topic, which every
+// write publishes to whatever the date.
+func IsKafkaDestination(outputPath string) bool {
+	destination, err := url.Parse(outputPath)
+	return err == nil && destination.Scheme == "kafka"
+}
+
+// kafkaPath is a parsed kafka://broker1:9092,broker2:9092/topic destination.
+type kafkaPath struct {
+	brokers []string
+	topic   string
+}
+
+func parseKafkaPath(raw string) (kafkaPath, error) {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme != "kafka" || u.Host == "" {
+		return kafkaPath{}, fmt.Errorf("invalid kafka path %q: must be kafka://broker:port/topic", raw)
+	}
+	topic := strings.Trim(u.Path, "/")
+	if topic == "" || strings.Contains(topic, "/") {
+		return kafkaPath{}, fmt.Errorf("invalid kafka path %q: must be kafka://broker:port/topic", raw)
+	}
+	return kafkaPath{brokers: strings.Split(u.Host, ","), topic: topic}, nil
+}
+
+// KafkaExporter publishes each record as a message to a Kafka topic, as JSON
+// (jsonl) or as an Avro single-object encoding (avro) whose writer schema is
+// derived from the struct tags of E.
+//
+// A message's key is its record's natural key (schema.Schema.Key), e.g.
+// "776543:a1" for event 776543 play a1, so the updates of a record land on the
+// same partition. Its provider, feed and pull_timestamp headers identify where
+// and when the record was scraped.
+type KafkaExporter[E any] struct {
+	Destination string
+	KafkaConfig KafkaConfig
+	// Provider and Feed are sent in the provider and feed headers of every message.
+	Provider sportscrape.Provider
+	Feed     sportscrape.Feed
+}
+
+func NewKafkaExporter[E any](destination string, opts ...KafkaConfigOption) *KafkaExporter[E] {
+	return &KafkaExporter[E]{
+		Destination: destination,
+		KafkaConfig: newKafkaConfig(opts...),
+	}
+}
+
+// Write publishes records as jsonl or avro messages.
+func (e *KafkaExporter[E]) Write(ctx context.Context, format string, records []E) error {
+	switch format {
+	case "jsonl":
+		return e.writeJSONL(ctx, records)
+	case "avro":
+		return e.writeAvro(ctx, records)
+	default:
+		return fmt.Errorf("%w %q for a kafka destination, valid options: jsonl, avro", ErrUnsupportedFormat, format)
+	}
+}
+
+func (e *KafkaExporter[E]) writeJSONL(ctx context.Context, records []E) error {
+	return e.publish(ctx, records, func(record E, _ reflect.Value) ([]byte, error) {
+		return json.Marshal(record)
+	})
+}
+
+func (e *KafkaExporter[E]) writeAvro(ctx context.Context, records []E) error {
+	s, err := schema.Of[E]()
+	if err != nil {
+		return fmt.Errorf("avro schema: %w", err)
+	}
+	encode, err := avroSingleObjectEncoder[E](s)
+	if err != nil {
+		return err
+	}
+	return e.publish(ctx, records, func(_ E, v reflect.Value) ([]byte, error) {
+		return encode(v)
+	})
+}
+
+// publish sends a message of every record, encoded by encode, and waits for
+// all of them to be acknowledged.
+func (e *KafkaExporter[E]) publish(ctx context.Context, records []E, encode func(record E, v reflect.Value) ([]byte, error)) error {
+	if len(records) == 0 {
+		return fmt.Errorf("no records supplied")
+	}
+	path, err := parseKafkaPath(e.Destination)
+	if err != nil {
+		return err
+	}
+	s, err := schema.Of[E]()
+	if err != nil {
+		return fmt.Errorf("kafka schema: %w", err)
+	}
+	fields := columnFields[E](s.Columns)
+	// The key columns in the order of the natural key, e.g. event_id then player_id
+	keyColumns := make([]int, len(s.Key))
+	for i, name := range s.Key {
+		keyColumns[i] = slices.IndexFunc(s.Columns, func(column schema.Column) bool { return column.Name == name })
+	}
+	pullTimestamp := slices.IndexFunc(s.Columns, func(column schema.Column) bool {
+		return column.Name == "pull_timestamp" && column.Type == schema.Timestamp
+	})
+
+	messages := make([]kafka.Message, len(records))
+	for i, record := range records {
+		v := recordValue(record)
+		value, err := encode(record, v)
+		if err != nil {
+			return fmt.Errorf("encode record %d: %w", i, err)
+		}
+		key := make([]string, len(keyColumns))
+		for j, column := range keyColumns {
+			key[j] = kafkaKeyPart(v.FieldByIndex(fields[column]))
+		}
+		headers := []kafka.Header{
+			{Key: "provider", Value: []byte(e.Provider)},
+			{Key: "feed", Value: []byte(e.Feed)},
+		}
+		if pullTimestamp >= 0 {
+			if field := v.FieldByIndex(fields[pullTimestamp]); field.Kind() != reflect.Pointer || !field.IsNil() {
+				pulled := time.UnixMilli(timestampMillis(reflect.Indirect(field))).UTC()
+				headers = append(headers, kafka.Header{Key: "pull_timestamp", Value: []byte(pulled.Format(time.RFC3339Nano))})
+			}
+		}
+		messages[i] = kafka.Message{Key: []byte(strings.Join(key, ":")), Value: value, Headers: headers}
+	}
+
+	cfg := e.KafkaConfig
+	w := &kafka.Writer{
+		Addr:  kafka.TCP(path.brokers...),
+		Topic: path.topic,
+		// The partitioner of the Java client, so every producer of the topic
+		// sends a key to the same partition
+		Balancer:               &kafka.Murmur2Balancer{},
+		BatchSize:              cfg.BatchSize,
+		BatchTimeout:           cfg.BatchTimeout,
+		RequiredAcks:           kafkaRequiredAcks(cfg.Acks),
+		Compression:            kafkaCompressionCodec(cfg.Compression),
+		AllowAutoTopicCreation: true,
+	}
+	if err := w.WriteMessages(ctx, messages...); err != nil {
+		w.Close()
+		return fmt.Errorf("publish to kafka topic %s: %w", path.topic, err)
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	slog.Info("Messages published", "destination", e.Destination, "messages", len(messages))
+	return nil
+}
+
+// kafkaKeyPart returns the message key text of a natural key column held by v.
+func kafkaKeyPart(v reflect.Value) string {
+	v = reflect.Indirect(v)
+	switch v.Kind() {
+	case reflect.Invalid:
+		return ""
+	case reflect.String:
+		return v.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatInt(intValue(v), 10)
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
+
+func kafkaRequiredAcks(acks KafkaAcks) kafka.RequiredAcks {
+	switch acks {
+	case KafkaAcksNone:
+		return kafka.RequireNone
+	case KafkaAcksLeader:
+		return kafka.RequireOne
+	default:
+		return kafka.RequireAll
+	}
+}
+
+func kafkaCompressionCodec(compression KafkaCompression) kafka.Compression {
+	switch compression {
+	case KafkaGzip:
+		return kafka.Gzip
+	case KafkaSnappy:
+		return kafka.Snappy
+	case KafkaLZ4:
+		return kafka.Lz4
+	case KafkaZSTD:
+		return kafka.Zstd
+	default:
+		return 0
+	}
+}
+
+// avroSingleObjectEncoder returns the function encoding the record held by v
+// as an Avro single-object encoding: the marker 0xC3 0x01, the little-endian
+// CRC-64-AVRO fingerprint of the Parsing Canonical Form of the writer schema,
+// by which consumers look it up, then the record encoded with it. The writer
+// schema is the Avro schema of s (see schema.Schema.Avro).
+func avroSingleObjectEncoder[E any](s schema.Schema) (func(v reflect.Value) ([]byte, error), error) {
+	writerSchema, err := s.Avro()
+	if err != nil {
+		return nil, fmt.Errorf("avro schema: %w", err)
+	}
+	avroSchema, err := avro.ParseBytes(writerSchema)
+	if err != nil {
+		return nil, fmt.Errorf("parse avro schema: %w", err)
+	}
+	canonical, err := avroCanonicalForm(writerSchema)
+	if err != nil {
+		return nil, err
+	}
+	fingerprint := crc64.SumWithByteOrder(canonical, crc64.LittleEndian)
+	header := append([]byte{0xc3, 0x01}, fingerprint[:]...)
+	fields := columnFields[E](s.Columns)
+	return func(v reflect.Value) ([]byte, error) {
+		body, err := avro.Marshal(avroSchema, avroDatum(s.Columns, fields, v))
+		if err != nil {
+			return nil, err
+		}
+		return append(slices.Clone(header), body...), nil
+	}, nil
+}
+
+// avroCanonicalAttributes are the attributes kept by the Parsing Canonical
+// Form, in its order.
+var avroCanonicalAttributes = []string{"name", "type", "fields", "symbols", "items", "values", "size"}
+
+// avroNamedTypes are the types whose name is qualified by their namespace.
+var avroNamedTypes = map[string]bool{"record": true, "error": true, "enum": true, "fixed": true}
+
+// avroCanonicalForm returns the Parsing Canonical Form of the Avro schema
+// avroSchema, as defined by the Avro specification: names are full names,
+// primitive types are plain strings, attributes other than
+// avroCanonicalAttributes (doc, default, logicalType, ...) are stripped and
+// there is no whitespace. Unlike hamba/avro's Schema.String, logical types are
+// stripped, as by the Java SchemaNormalization consumers fingerprint with.
+func avroCanonicalForm(avroSchema []byte) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(avroSchema))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, fmt.Errorf("decode avro schema: %w", err)
+	}
+	var b bytes.Buffer
+	if err := writeAvroCanonical(&b, v, ""); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// writeAvroCanonical writes the Parsing Canonical Form of the schema v,
+// enclosed in namespace, to b.
+func writeAvroCanonical(b *bytes.Buffer, v any, namespace string) error {
+	switch v := v.(type) {
+	case string:
+		b.Write(jsonString(avroFullName(v, namespace)))
+	case []any:
+		b.WriteByte('[')
+		for i, member := range v {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			if err := writeAvroCanonical(b, member, namespace); err != nil {
+				return err
+			}
+		}
+		b.WriteByte(']')
+	case map[string]any:
+		typ, ok := v["type"].(string)
+		if !ok {
+			// {"type": {...}} is the schema it holds
+			return writeAvroCanonical(b, v["type"], namespace)
+		}
+		if avroNamedTypes[typ] {
+			name, _ := v["name"].(string)
+			if ns, ok := v["namespace"].(string); ok && !strings.Contains(name, ".") {
+				namespace = ns
+			}
+			name = avroFullName(name, namespace)
+			if i := strings.LastIndex(name, "."); i >= 0 {
+				namespace = name[:i]
+			}
+			v["name"] = name
+		} else if typ != "array" && typ != "map" {
+			// A primitive type with attributes, e.g. a logical type
+			b.Write(jsonString(avroFullName(typ, namespace)))
+			return nil
+		}
+		b.WriteByte('{')
+		first := true
+		for _, attribute := range avroCanonicalAttributes {
+			value, ok := v[attribute]
+			if !ok {
+				continue
+			}
+			if !first {
+				b.WriteByte(',')
+			}
+			first = false
+			b.Write(jsonString(attribute))
+			b.WriteByte(':')
+			var err error
+			switch attribute {
+			case "name":
+				b.Write(jsonString(value.(string)))
+			case "type":
+				b.Write(jsonString(typ))
+			case "fields":
+				err = writeAvroCanonicalFields(b, value, namespace)
+			case "size":
+				b.WriteString(fmt.Sprint(value))
+			case "symbols":
+				symbols, _ := json.Marshal(value)
+				b.Write(symbols)
+			default:
+				err = writeAvroCanonical(b, value, namespace)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		b.WriteByte('}')
+	default:
+		return fmt.Errorf("invalid avro schema %v", v)
+	}
+	return nil
+}
+
+// writeAvroCanonicalFields writes the Parsing Canonical Form of the fields of a record to b.
+func writeAvroCanonicalFields(b *bytes.Buffer, fields any, namespace string) error {
+	list, ok := fields.([]any)
+	if !ok {
+		return fmt.Errorf("invalid avro record fields %v", fields)
+	}
+	b.WriteByte('[')
+	for i, f := range list {
+		field, ok := f.(map[string]any)
+		if !ok {
+			return fmt.Errorf("invalid avro record field %v", f)
+		}
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(`{"name":`)
+		b.Write(jsonString(fmt.Sprint(field["name"])))
+		b.WriteString(`,"type":`)
+		if err := writeAvroCanonical(b, field["type"], namespace); err != nil {
+			return err
+		}
+		b.WriteByte('}')
+	}
+	b.WriteByte(']')
+	return nil
+}
+
+// avroPrimitiveTypes are the type names never qualified by a namespace.
+var avroPrimitiveTypes = map[string]bool{
+	"null": true, "boolean": true, "int": true, "long": true,
+	"float": true, "double": true, "bytes": true, "string": true,
+}
+
+// avroFullName returns name qualified by namespace, unless primitive or already full.
+func avroFullName(name, namespace string) string {
+	if avroPrimitiveTypes[name] || namespace == "" || strings.Contains(name, ".") {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func jsonString(s string) []byte {
+	b, _ := json.Marshal(s)
+	return b
+}
@@ -0,0 +1,126 @@
+//go:build unit
+
+package exporters
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hamba/avro/v2"
+	"github.com/hamba/avro/v2/pkg/crc64"
+	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+	"github.com/lightning-dabbler/sportscrape/schema"
+)
+
+func TestAvroCanonicalForm(t *testing.T) {
+	tests := []struct {
+		name       string
+		avroSchema string
+		expected   string
+	}{
+		{name: "primitive", avroSchema: `"int"`, expected: `"int"`},
+		{name: "primitive object", avroSchema: `{"type": "int"}`, expected: `"int"`},
+		{name: "logical type", avroSchema: `{"type": "long", "logicalType": "timestamp-millis"}`, expected: `"long"`},
+		{
+			name: "record",
+			avroSchema: `{
+				"type": "record", "name": "Odds", "namespace": "sportscrape.test", "doc": "Odds of a game",
+				"fields": [
+					{"name": "team", "type": "string", "doc": "Team name"},
+					{"name": "odds", "type": ["null", "float"], "default": null},
+					{"name": "event_date", "type": {"type": "int", "logicalType": "date"}}
+				]
+			}`,
+			expected: `{"name":"sportscrape.test.Odds","type":"record","fields":[{"name":"team","type":"string"},{"name":"odds","type":["null","float"]},{"name":"event_date","type":"int"}]}`,
+		},
+		{
+			name: "nested named types",
+			avroSchema: `{
+				"type": "record", "name": "a.Game",
+				"fields": [
+					{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["pregame", "final"]}},
+					{"name": "id", "type": {"type": "fixed", "name": "ID", "namespace": "b", "size": 16}},
+					{"name": "scores", "type": {"type": "map", "values": {"type": "array", "items": "long"}}},
+					{"name": "previous", "type": ["null", "Status"]}
+				]
+			}`,
+			expected: `{"name":"a.Game","type":"record","fields":[{"name":"status","type":{"name":"a.Status","type":"enum","symbols":["pregame","final"]}},{"name":"id","type":{"name":"b.ID","type":"fixed","size":16}},{"name":"scores","type":{"type":"map","values":{"type":"array","items":"long"}}},{"name":"previous","type":["null","a.Status"]}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := avroCanonicalForm([]byte(tt.avroSchema))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.expected {
+				t.Errorf("avroCanonicalForm() = %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAvroSingleObjectEncoder(t *testing.T) {
+	s, err := schema.Of[model.PlayByPlay]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	encode, err := avroSingleObjectEncoder[model.PlayByPlay](s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pulled := time.Date(2025, 6, 12, 4, 0, 0, 123000000, time.UTC)
+	speed := float32(101.5)
+	message, err := encode(recordValue(model.PlayByPlay{PullTimestamp: pulled, EventID: 776543, PlayID: "a1", HitSpeed: &speed}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(message, []byte{0xc3, 0x01}) || len(message) < 10 {
+		t.Fatalf("message = %x, want the single-object marker and fingerprint", message)
+	}
+
+	// The writer schema, as written to Avro files and printed by sportscrape schema --format avro
+	b, err := s.Avro()
+	if err != nil {
+		t.Fatal(err)
+	}
+	writerSchema, err := avro.ParseBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// hamba/avro's canonical form only differs from the specification's by keeping logical types
+	canonical, err := avro.ParseBytes(stripLogicalTypes(t, writerSchema.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fingerprint := crc64.SumWithByteOrder([]byte(canonical.String()), crc64.LittleEndian); !bytes.Equal(message[2:10], fingerprint[:]) {
+		t.Errorf("fingerprint = %x, want %x of %s", message[2:10], fingerprint, canonical)
+	}
+
+	var record map[string]any
+	if err := avro.Unmarshal(writerSchema, message[10:], &record); err != nil {
+		t.Fatal(err)
+	}
+	if pullTimestamp, _ := record["pull_timestamp"].(time.Time); !pullTimestamp.Equal(pulled) {
+		t.Errorf("pull_timestamp = %v, want %v", record["pull_timestamp"], pulled)
+	}
+	if record["event_id"] != int64(776543) || record["play_id"] != "a1" || record["hit_speed"] != speed {
+		t.Errorf("record = %v", record)
+	}
+}
+
+// stripLogicalTypes replaces the logical types of a hamba/avro canonical form
+// by their underlying type.
+func stripLogicalTypes(t *testing.T, canonical string) []byte {
+	t.Helper()
+	stripped := strings.NewReplacer(
+		`{"type":"long","logicalType":"timestamp-millis"}`, `"long"`,
+		`{"type":"int","logicalType":"date"}`, `"int"`,
+	).Replace(canonical)
+	if strings.Contains(stripped, "logicalType") {
+		t.Fatalf("unexpected logical type in %s", canonical)
+	}
+	return []byte(stripped)
+}
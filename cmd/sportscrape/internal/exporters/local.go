@@ -16,16 +16,40 @@ import (
 type LocalExporter[E any] struct {
 	Destination   string
 	ParquetConfig ParquetConfig
+	CSVConfig     CSVConfig
+	AvroConfig    AvroConfig
+	ArrowConfig   ArrowConfig
 }
 
 func NewLocalExporter[E any](destination string, opts ...ParquetConfigOption) *LocalExporter[E] {
 	return &LocalExporter[E]{
 		Destination:   destination,
 		ParquetConfig: newParquetConfig(opts...),
+		CSVConfig:     newCSVConfig(),
+		AvroConfig:    newAvroConfig(),
+		ArrowConfig:   newArrowConfig(),
 	}
 }
 
-func (e *LocalExporter[E]) WriteParquet(ctx context.Context, records []E) error {
+// Write writes records to Destination in format, replacing its content.
+func (e *LocalExporter[E]) Write(ctx context.Context, format string, records []E) error {
+	switch format {
+	case "jsonl":
+		return e.writeJSONL(ctx, records)
+	case "parquet":
+		return e.writeParquet(ctx, records)
+	case "csv":
+		return e.writeCSV(ctx, records)
+	case "avro":
+		return e.writeAvro(ctx, records)
+	case "arrow":
+		return e.writeArrow(ctx, records)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
+	}
+}
+
+func (e *LocalExporter[E]) writeParquet(ctx context.Context, records []E) error {
 	if len(records) == 0 {
 		return fmt.Errorf("no records supplied")
 	}
@@ -70,7 +94,7 @@ func (e *LocalExporter[E]) WriteParquet(ctx context.Context, records []E) error
 	return nil
 }
 
-func (e *LocalExporter[E]) WriteJSONL(ctx context.Context, records []E) error {
+func (e *LocalExporter[E]) writeJSONL(ctx context.Context, records []E) error {
 	if len(records) == 0 {
 		return fmt.Errorf("no records supplied")
 	}
@@ -94,6 +118,60 @@ func (e *LocalExporter[E]) WriteJSONL(ctx context.Context, records []E) error {
 	return nil
 }
 
+func (e *LocalExporter[E]) writeCSV(ctx context.Context, records []E) error {
+	if len(records) == 0 {
+		return fmt.Errorf("no records supplied")
+	}
+	b, err := encodeCSV(records, e.CSVConfig)
+	if err != nil {
+		return err
+	}
+	if err := ensureDir(e.Destination); err != nil {
+		return err
+	}
+	if err := os.WriteFile(e.Destination, b, 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", e.Destination, err)
+	}
+	slog.Info("File written", "destination", e.Destination)
+	return nil
+}
+
+func (e *LocalExporter[E]) writeAvro(ctx context.Context, records []E) error {
+	if len(records) == 0 {
+		return fmt.Errorf("no records supplied")
+	}
+	b, err := encodeAvro(records, e.AvroConfig)
+	if err != nil {
+		return err
+	}
+	if err := ensureDir(e.Destination); err != nil {
+		return err
+	}
+	if err := os.WriteFile(e.Destination, b, 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", e.Destination, err)
+	}
+	slog.Info("File written", "destination", e.Destination)
+	return nil
+}
+
+func (e *LocalExporter[E]) writeArrow(ctx context.Context, records []E) error {
+	if len(records) == 0 {
+		return fmt.Errorf("no records supplied")
+	}
+	b, err := encodeArrow(records, e.ArrowConfig)
+	if err != nil {
+		return err
+	}
+	if err := ensureDir(e.Destination); err != nil {
+		return err
+	}
+	if err := os.WriteFile(e.Destination, b, 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", e.Destination, err)
+	}
+	slog.Info("File written", "destination", e.Destination)
+	return nil
+}
+
 func ensureDir(path string) error {
 	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
 		return fmt.Errorf("mkdir %s: %w", filepath.Dir(path), err)
@@ -0,0 +1,170 @@
+package exporters
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/lightning-dabbler/sportscrape/runner"
+)
+
+// RawSink is a runner.RawSink archiving every event under Destination, a
+// local directory (no scheme or file://) or an s3://bucket/prefix, at
+// <Destination>/<RawEvent.Key()>.json.gz.
+type RawSink struct {
+	Destination string
+	AWSConfig   aws.Config
+}
+
+// NewRawSink returns a RawSink archiving to destination, or
+// ErrUnsupportedDestination if its scheme is neither local nor s3.
+func NewRawSink(destination string, s3cfg S3Config) (*RawSink, error) {
+	destination, err := rawLocation(destination)
+	if err != nil {
+		return nil, err
+	}
+	return &RawSink{
+		Destination: destination,
+		AWSConfig:   newAWSConfig(WithEndpoint(s3cfg.Endpoint), WithRegion(s3cfg.Region)),
+	}, nil
+}
+
+// rawLocation strips the file:// scheme of a raw archive location and rejects
+// schemes other than local and s3.
+func rawLocation(location string) (string, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return "", fmt.Errorf("invalid raw location %q: %w", location, err)
+	}
+	switch u.Scheme {
+	case "file":
+		return u.Path, nil
+	case "", "s3":
+		return location, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedDestination, u.Scheme)
+	}
+}
+
+func (s *RawSink) WriteRaw(ctx context.Context, event runner.RawEvent) error {
+	var buf bytes.Buffer
+	if err := runner.EncodeRawEvent(&buf, event); err != nil {
+		return fmt.Errorf("encode raw event %s: %w", event.Key(), err)
+	}
+	if !strings.HasPrefix(s.Destination, "s3://") {
+		destination := filepath.Join(s.Destination, filepath.FromSlash(event.Key())+runner.RawExtension)
+		if err := ensureDir(destination); err != nil {
+			return err
+		}
+		if err := os.WriteFile(destination, buf.Bytes(), 0o644); err != nil {
+			return fmt.Errorf("write %s: %w", destination, err)
+		}
+		slog.Debug("Raw payload archived", "destination", destination)
+		return nil
+	}
+
+	prefix, err := parseS3Path(s.Destination)
+	if err != nil {
+		return err
+	}
+	key := path.Join(prefix.key, event.Key()) + runner.RawExtension
+	client := s3.NewFromConfig(s.AWSConfig)
+	_, err = client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(prefix.bucket),
+		Key:           aws.String(key),
+		Body:          bytes.NewReader(buf.Bytes()),
+		ContentLength: aws.Int64(int64(buf.Len())),
+		ContentType:   aws.String("application/gzip"),
+	})
+	if err != nil {
+		return fmt.Errorf("put object s3://%s/%s: %w", prefix.bucket, key, err)
+	}
+	slog.Debug("Raw payload archived", "destination", "s3://"+prefix.bucket+"/"+key)
+	return nil
+}
+
+// ReadRaw decodes every event archived by a RawSink under source, a local
+// directory or an s3://bucket/prefix, and calls fn with each in key order.
+// Files without the runner.RawExtension are skipped.
+func ReadRaw(ctx context.Context, source string, s3cfg S3Config, fn func(runner.RawEvent) error) error {
+	source, err := rawLocation(source)
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(source, "s3://") {
+		return filepath.WalkDir(source, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || !strings.HasSuffix(path, runner.RawExtension) {
+				return err
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			event, err := runner.DecodeRawEvent(f)
+			if err != nil {
+				return fmt.Errorf("decode %s: %w", path, err)
+			}
+			return fn(event)
+		})
+	}
+
+	prefix, err := parseS3Path(source)
+	if err != nil {
+		return err
+	}
+	client := s3.NewFromConfig(newAWSConfig(WithEndpoint(s3cfg.Endpoint), WithRegion(s3cfg.Region)))
+	paginator := s3.NewListObjectsV2Paginator(client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(prefix.bucket),
+		Prefix: aws.String(prefix.key),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("list objects s3://%s/%s: %w", prefix.bucket, prefix.key, err)
+		}
+		for _, object := range page.Contents {
+			key := aws.ToString(object.Key)
+			if !strings.HasSuffix(key, runner.RawExtension) {
+				continue
+			}
+			event, err := readRawObject(ctx, client, prefix.bucket, key)
+			if err != nil {
+				return err
+			}
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readRawObject downloads and decodes the archived event at s3://bucket/key.
+func readRawObject(ctx context.Context, client *s3.Client, bucket, key string) (runner.RawEvent, error) {
+	object, err := client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return runner.RawEvent{}, fmt.Errorf("get object s3://%s/%s: %w", bucket, key, err)
+	}
+	defer object.Body.Close()
+	event, err := runner.DecodeRawEvent(object.Body)
+	if err != nil {
+		return event, fmt.Errorf("decode s3://%s/%s: %w", bucket, key, err)
+	}
+	return event, nil
+}
@@ -63,6 +63,9 @@ func parseS3Path(raw string) (s3Path, error) {
 type S3Exporter[E any] struct {
 	Destination   string
 	ParquetConfig ParquetConfig
+	CSVConfig     CSVConfig
+	AvroConfig    AvroConfig
+	ArrowConfig   ArrowConfig
 	AWSConfig     aws.Config
 }
 
@@ -70,11 +73,32 @@ func NewS3Exporter[E any](destination string, parquetOpts []ParquetConfigOption,
 	return &S3Exporter[E]{
 		Destination:   destination,
 		ParquetConfig: newParquetConfig(parquetOpts...),
+		CSVConfig:     newCSVConfig(),
+		AvroConfig:    newAvroConfig(),
+		ArrowConfig:   newArrowConfig(),
 		AWSConfig:     newAWSConfig(awsOpts...),
 	}
 }
 
-func (e *S3Exporter[E]) WriteParquet(ctx context.Context, records []E) error {
+// Write writes records to Destination in format, replacing its content.
+func (e *S3Exporter[E]) Write(ctx context.Context, format string, records []E) error {
+	switch format {
+	case "jsonl":
+		return e.writeJSONL(ctx, records)
+	case "parquet":
+		return e.writeParquet(ctx, records)
+	case "csv":
+		return e.writeCSV(ctx, records)
+	case "avro":
+		return e.writeAvro(ctx, records)
+	case "arrow":
+		return e.writeArrow(ctx, records)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
+	}
+}
+
+func (e *S3Exporter[E]) writeParquet(ctx context.Context, records []E) error {
 	if len(records) == 0 {
 		return fmt.Errorf("no records supplied")
 	}
@@ -122,7 +146,7 @@ func (e *S3Exporter[E]) WriteParquet(ctx context.Context, records []E) error {
 	return nil
 }
 
-func (e *S3Exporter[E]) WriteJSONL(ctx context.Context, records []E) error {
+func (e *S3Exporter[E]) writeJSONL(ctx context.Context, records []E) error {
 	if len(records) == 0 {
 		return fmt.Errorf("no records supplied")
 	}
@@ -154,3 +178,87 @@ func (e *S3Exporter[E]) WriteJSONL(ctx context.Context, records []E) error {
 	slog.Info("File written", "destination", e.Destination)
 	return nil
 }
+
+func (e *S3Exporter[E]) writeCSV(ctx context.Context, records []E) error {
+	if len(records) == 0 {
+		return fmt.Errorf("no records supplied")
+	}
+	path, err := parseS3Path(e.Destination)
+	if err != nil {
+		return err
+	}
+	b, err := encodeCSV(records, e.CSVConfig)
+	if err != nil {
+		return err
+	}
+
+	client := s3.NewFromConfig(e.AWSConfig)
+	_, err = client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(path.bucket),
+		Key:           aws.String(path.key),
+		Body:          bytes.NewReader(b),
+		ContentLength: aws.Int64(int64(len(b))),
+		ContentType:   aws.String("text/csv"),
+	})
+	if err != nil {
+		return fmt.Errorf("put object %s: %w", e.Destination, err)
+	}
+	slog.Info("File written", "destination", e.Destination)
+	return nil
+}
+
+func (e *S3Exporter[E]) writeAvro(ctx context.Context, records []E) error {
+	if len(records) == 0 {
+		return fmt.Errorf("no records supplied")
+	}
+	path, err := parseS3Path(e.Destination)
+	if err != nil {
+		return err
+	}
+	b, err := encodeAvro(records, e.AvroConfig)
+	if err != nil {
+		return err
+	}
+
+	client := s3.NewFromConfig(e.AWSConfig)
+	_, err = client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(path.bucket),
+		Key:           aws.String(path.key),
+		Body:          bytes.NewReader(b),
+		ContentLength: aws.Int64(int64(len(b))),
+		ContentType:   aws.String("application/avro"),
+	})
+	if err != nil {
+		return fmt.Errorf("put object %s: %w", e.Destination, err)
+	}
+	slog.Info("File written", "destination", e.Destination)
+	return nil
+}
+
+func (e *S3Exporter[E]) writeArrow(ctx context.Context, records []E) error {
+	if len(records) == 0 {
+		return fmt.Errorf("no records supplied")
+	}
+	path, err := parseS3Path(e.Destination)
+	if err != nil {
+		return err
+	}
+	b, err := encodeArrow(records, e.ArrowConfig)
+	if err != nil {
+		return err
+	}
+
+	client := s3.NewFromConfig(e.AWSConfig)
+	_, err = client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(path.bucket),
+		Key:           aws.String(path.key),
+		Body:          bytes.NewReader(b),
+		ContentLength: aws.Int64(int64(len(b))),
+		ContentType:   aws.String("application/vnd.apache.arrow.file"),
+	})
+	if err != nil {
+		return fmt.Errorf("put object %s: %w", e.Destination, err)
+	}
+	slog.Info("File written", "destination", e.Destination)
+	return nil
+}
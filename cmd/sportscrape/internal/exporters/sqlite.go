@@ -0,0 +1,220 @@
+package exporters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape"
+	"github.com/lightning-dabbler/sportscrape/schema"
+	// Pure-Go SQLite driver registered as "sqlite", keeping the CLI cgo-free
+	_ "modernc.org/sqlite"
+)
+
+// sqliteBatchSize is the default number of records inserted per transaction.
+const sqliteBatchSize = 1000
+
+// sqliteTimestampLayout is the ISO 8601 layout of timestamp columns, which the
+// SQLite date and time functions understand.
+const sqliteTimestampLayout = "2006-01-02T15:04:05.000Z"
+
+// sqlitePath is a parsed sqlite://path/to/file.db?table=name&batch_size=n destination.
+type sqlitePath struct {
+	file      string
+	table     string
+	batchSize int
+}
+
+func parseSQLitePath(raw string) (sqlitePath, error) {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme != "sqlite" || u.Host+u.Path == "" {
+		return sqlitePath{}, fmt.Errorf("invalid sqlite path %q: must be sqlite://path/to/file.db?table=name", raw)
+	}
+	path := sqlitePath{
+		file:      u.Host + u.Path,
+		table:     u.Query().Get("table"),
+		batchSize: sqliteBatchSize,
+	}
+	if raw := u.Query().Get("batch_size"); raw != "" {
+		path.batchSize, err = strconv.Atoi(raw)
+		if err != nil || path.batchSize < 1 {
+			return sqlitePath{}, fmt.Errorf("invalid sqlite batch_size %q: must be a positive integer", raw)
+		}
+	}
+	return path, nil
+}
+
+// SQLiteExporter upserts records into a table of an SQLite database file,
+// creating the table from the model schema on first write. The table's primary
+// key is the model's natural key (schema.Schema.Key), so rewriting the same
+// records updates their rows.
+//
+// The file format does not apply: Write upserts rows.
+type SQLiteExporter[E any] struct {
+	Destination string
+	// Feed names the table when the destination has no table parameter.
+	Feed sportscrape.Feed
+}
+
+func NewSQLiteExporter[E any](destination string) *SQLiteExporter[E] {
+	return &SQLiteExporter[E]{Destination: destination}
+}
+
+// Write upserts records with WriteRows, whatever the format.
+func (e *SQLiteExporter[E]) Write(ctx context.Context, format string, records []E) error {
+	return e.WriteRows(ctx, records)
+}
+
+// WriteRows creates the table if it does not exist and upserts records in
+// transactions of the destination's batch_size records.
+func (e *SQLiteExporter[E]) WriteRows(ctx context.Context, records []E) error {
+	if len(records) == 0 {
+		return fmt.Errorf("no records supplied")
+	}
+	path, err := parseSQLitePath(e.Destination)
+	if err != nil {
+		return err
+	}
+	s, err := schema.Of[E]()
+	if err != nil {
+		return fmt.Errorf("sqlite schema: %w", err)
+	}
+	table := tableName(s, e.Feed, path.table)
+	fields := columnFields[E](s.Columns)
+
+	if err := ensureDir(path.file); err != nil {
+		return err
+	}
+	// Concurrent pipeline stages write to the same file: wait on its lock
+	// rather than failing with SQLITE_BUSY
+	db, err := sql.Open("sqlite", path.file+"?_pragma=busy_timeout(10000)&_pragma=journal_mode(WAL)")
+	if err != nil {
+		return fmt.Errorf("open sqlite %s: %w", path.file, err)
+	}
+	defer db.Close()
+
+	if _, err := db.ExecContext(ctx, sqliteCreateTable(table, s)); err != nil {
+		return fmt.Errorf("create table %s: %w", table, err)
+	}
+	upsert := sqliteUpsert(table, s)
+	for start := 0; start < len(records); start += path.batchSize {
+		batch := records[start:min(start+path.batchSize, len(records))]
+		if err := sqliteInsertBatch(ctx, db, upsert, s.Columns, fields, batch); err != nil {
+			return fmt.Errorf("insert into %s: %w", table, err)
+		}
+	}
+	slog.Info("Rows written", "destination", e.Destination, "table", table, "rows", len(records))
+	return nil
+}
+
+// sqliteInsertBatch runs the upsert statement for every record of batch in one transaction.
+func sqliteInsertBatch[E any](ctx context.Context, db *sql.DB, upsert string, columns []schema.Column, fields [][]int, batch []E) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	stmt, err := tx.PrepareContext(ctx, upsert)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	args := make([]any, len(columns))
+	for _, record := range batch {
+		v := recordValue(record)
+		for i, column := range columns {
+			args[i] = sqliteValue(column, v.FieldByIndex(fields[i]))
+		}
+		if _, err := stmt.ExecContext(ctx, args...); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// sqliteCreateTable returns the CREATE TABLE statement of the model schema s,
+// with its natural key as primary key.
+func sqliteCreateTable(table string, s schema.Schema) string {
+	var definitions []string
+	for _, column := range s.Columns {
+		definition := quoteIdent(column.Name) + " " + sqliteType(column.Type)
+		if !column.Nullable {
+			definition += " NOT NULL"
+		}
+		definitions = append(definitions, definition)
+	}
+	if len(s.Key) > 0 {
+		definitions = append(definitions, "PRIMARY KEY ("+quoteIdents(s.Key)+")")
+	}
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (\n\t%s\n)", quoteIdent(table), strings.Join(definitions, ",\n\t"))
+}
+
+// sqliteUpsert returns the INSERT statement of a row of s, updating the row
+// holding the same natural key instead when there is one.
+func sqliteUpsert(table string, s schema.Schema) string {
+	names := make([]string, len(s.Columns))
+	var updates []string
+	for i, column := range s.Columns {
+		names[i] = column.Name
+		if !slices.Contains(s.Key, column.Name) {
+			updates = append(updates, fmt.Sprintf("%s = excluded.%[1]s", quoteIdent(column.Name)))
+		}
+	}
+	statement := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", quoteIdent(table), quoteIdents(names), strings.TrimSuffix(strings.Repeat("?, ", len(names)), ", "))
+	switch {
+	case len(s.Key) == 0:
+		return statement
+	case len(updates) == 0:
+		return statement + " ON CONFLICT DO NOTHING"
+	default:
+		return statement + fmt.Sprintf(" ON CONFLICT (%s) DO UPDATE SET %s", quoteIdents(s.Key), strings.Join(updates, ", "))
+	}
+}
+
+// sqliteType returns the SQLite column type of t. SQLite has no timestamp or
+// date type: they are ISO 8601 text.
+func sqliteType(t schema.Type) string {
+	switch t {
+	case schema.Boolean, schema.Int32, schema.Int64:
+		return "INTEGER"
+	case schema.Float, schema.Double:
+		return "REAL"
+	default:
+		return "TEXT"
+	}
+}
+
+// sqliteValue returns the driver value of column held by v, nil for a nil pointer.
+func sqliteValue(column schema.Column, v reflect.Value) any {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	switch column.Type {
+	case schema.Boolean:
+		return v.Bool()
+	case schema.Int32, schema.Int64:
+		return intValue(v)
+	case schema.Float:
+		// The shortest decimal of the float32 rather than its float64 widening, e.g. 0.1 and not 0.10000000149
+		f, _ := strconv.ParseFloat(strconv.FormatFloat(v.Float(), 'g', -1, 32), 64)
+		return f
+	case schema.Double:
+		return v.Float()
+	case schema.Timestamp:
+		return time.UnixMilli(timestampMillis(v)).UTC().Format(sqliteTimestampLayout)
+	case schema.Date:
+		return time.Unix(int64(dateDays(v))*24*60*60, 0).UTC().Format(time.DateOnly)
+	default:
+		return v.String()
+	}
+}
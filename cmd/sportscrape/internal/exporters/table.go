@@ -0,0 +1,63 @@
+package exporters
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+
+	"github.com/lightning-dabbler/sportscrape"
+	"github.com/lightning-dabbler/sportscrape/schema"
+)
+
+// databaseSchemes are the destination schemes of the exporters writing table
+// rows rather than files.
+var databaseSchemes = map[string]bool{
+	"sqlite":     true,
+	"postgres":   true,
+	"postgresql": true,
+}
+
+// IsDatabaseDestination reports whether outputPath is a database table rather
+// than a file, so every write goes to the same table whatever the date.
+func IsDatabaseDestination(outputPath string) bool {
+	destination, err := url.Parse(outputPath)
+	return err == nil && databaseSchemes[destination.Scheme]
+}
+
+// tableName returns table or, when empty, the snake_case name of feed, e.g.
+// "nba q1 traditional box score" → nba_q1_traditional_box_score, so the period
+// feeds sharing a model write to their own tables. Without a feed it is the
+// snake_case name of the model of s, e.g. BoxScoreTraditional → box_score_traditional.
+func tableName(s schema.Schema, feed sportscrape.Feed, table string) string {
+	if table != "" {
+		return table
+	}
+	if feed != "" {
+		return strings.Join(strings.FieldsFunc(strings.ToLower(string(feed)), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}), "_")
+	}
+	var b strings.Builder
+	runes := []rune(s.Name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// quoteIdent quotes a table or column name for SQL.
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// quoteIdents quotes every name and joins them with commas.
+func quoteIdents(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = quoteIdent(name)
+	}
+	return strings.Join(quoted, ", ")
+}
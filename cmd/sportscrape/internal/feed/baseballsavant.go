@@ -10,6 +10,8 @@ import (
 	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
 	"github.com/lightning-dabbler/sportscrape/runner"
+	"github.com/lightning-dabbler/sportscrape/scraper"
+	"github.com/lightning-dabbler/sportscrape/validate"
 )
 
 var (
@@ -25,13 +27,23 @@ var (
 )
 
 type BaseballSavantExtractor struct {
-	Feed           string
-	Date           string
-	Concurrency    int
-	OutputPath     string
-	Format         string
-	S3Config       exporters.S3Config
-	ParquetOptions []exporters.ParquetConfigOption
+	Feed        string
+	Date        string
+	Concurrency int
+	OutputPath  string
+	Format      string
+	// Export configures the exporter of OutputPath.
+	Export exporters.Options
+	// Observer, when set, is notified of every runner of the scrape.
+	Observer runner.Observer
+	// Raw, when set, archives the payloads fetched for every event.
+	Raw runner.RawSink
+	// Validation applies the feed's validation rules to every scraped event.
+	Validation validate.Mode
+	// Backfill, when enabled, scrapes a date range instead of Date.
+	Backfill Backfill
+	// Checkpoint, when set, skips events it recorded as scraped and records the final events scraped.
+	Checkpoint runner.CheckpointStore
 }
 
 func (e *BaseballSavantExtractor) ValidateFeed() error {
@@ -47,114 +59,75 @@ func (e *BaseballSavantExtractor) ValidateFeed() error {
 }
 
 func (e *BaseballSavantExtractor) Scrape(ctx context.Context) error {
+	return scrapeDates(ctx, e.Date, e.OutputPath, e.Backfill, e.Checkpoint, e.Observer, func(date, outputPath string) (runner.PipelineConfig[model.Matchup], error) {
+		return e.on(date, outputPath).pipelineConfig()
+	})
+}
+
+// on returns a copy of the extractor scraping date into outputPath.
+func (e *BaseballSavantExtractor) on(date, outputPath string) *BaseballSavantExtractor {
+	day := *e
+	day.Date = date
+	day.OutputPath = outputPath
+	return &day
+}
+
+// pipelineConfig builds the matchup pipeline for the extractor's feed: the
+// matchups themselves for 'matchup', otherwise a single event data stage.
+func (e *BaseballSavantExtractor) pipelineConfig() (runner.PipelineConfig[model.Matchup], error) {
+	config := runner.PipelineConfig[model.Matchup]{
+		Scraper: baseballsavantmlb.NewMatchupScraper(
+			baseballsavantmlb.MatchupScraperDate(e.Date),
+		),
+	}
+	var stage runner.Stage[model.Matchup]
 	switch e.Feed {
 	case "matchup":
-		return e.scrapeMatchup(ctx)
+		config.MatchupSink = exportSink[model.Matchup](e.OutputPath, e.Format, exportSource(e.Export, config.Scraper))
+		return config, nil
 	case "pitching-box-score":
-		return e.scrapePitchingBoxScore(ctx)
+		stage = savantStage(e, baseballsavantmlb.NewPitchingBoxScoreScraper())
 	case "batting-box-score":
-		return e.scrapeBattingBoxScore(ctx)
+		stage = savantStage(e, baseballsavantmlb.NewBattingBoxScoreScraper())
 	case "fielding-box-score":
-		return e.scrapeFieldingBoxScore(ctx)
+		stage = savantStage(e, baseballsavantmlb.NewFieldingBoxScoreScraper())
 	case "play-by-play":
-		return e.scrapePlayByPlay(ctx)
+		stage = savantStage(e, baseballsavantmlb.NewPlayByPlayScraper())
 	default:
-		return fmt.Errorf("unsupported feed %q for baseball savant. %w", e.Feed, ErrBaseballSavant)
-	}
-}
-
-func (e *BaseballSavantExtractor) retrieveMatchup() ([]model.Matchup, error) {
-	matchuprunner := runner.NewMatchupRunner(
-		runner.MatchupRunnerConfig[model.Matchup]{
-			Scraper: baseballsavantmlb.NewMatchupScraper(
-				baseballsavantmlb.MatchupScraperDate(e.Date),
-			),
-		},
-	)
-
-	return matchuprunner.Run()
-}
-
-func (e *BaseballSavantExtractor) scrapeMatchup(ctx context.Context) error {
-	m, err := e.retrieveMatchup()
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, m, e.ParquetOptions...)
-}
-
-func (e *BaseballSavantExtractor) scrapePitchingBoxScore(ctx context.Context) error {
-	m, err := e.retrieveMatchup()
-	if err != nil {
-		return err
-	}
-	eventdatascraper := baseballsavantmlb.NewPitchingBoxScoreScraper()
-	eventrunner := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.PitchingBoxScore]{
-			Concurrency: e.Concurrency,
-			Scraper:     eventdatascraper,
-		},
-	)
-	records, err := eventrunner.Run(m)
-	if err != nil {
-		return err
+		return config, fmt.Errorf("unsupported feed %q for baseball savant. %w", e.Feed, ErrBaseballSavant)
 	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
+	config.Stages = []runner.Stage[model.Matchup]{stage}
+	return config, nil
 }
 
-func (e *BaseballSavantExtractor) scrapeBattingBoxScore(ctx context.Context) error {
-	m, err := e.retrieveMatchup()
-	if err != nil {
-		return err
-	}
-	eventdatascraper := baseballsavantmlb.NewBattingBoxScoreScraper()
-	eventrunner := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.BattingBoxScore]{
+// savantStage wraps a baseball savant event data scraper in a pipeline stage exporting to the extractor's destination.
+func savantStage[E any](e *BaseballSavantExtractor, s scraper.EventDataScraper[model.Matchup, E]) runner.Stage[model.Matchup] {
+	return runner.NewStage(
+		runner.EventDataRunnerConfig[model.Matchup, E]{
 			Concurrency: e.Concurrency,
-			Scraper:     eventdatascraper,
+			Scraper:     s,
+			Checkpoint:  e.checkpoint(),
+			RawSink:     e.Raw,
+			Validation:  e.Validation,
 		},
+		exportSink[E](e.OutputPath, e.Format, exportSource(e.Export, s)),
 	)
-	records, err := eventrunner.Run(m)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
 }
 
-func (e *BaseballSavantExtractor) scrapeFieldingBoxScore(ctx context.Context) error {
-	m, err := e.retrieveMatchup()
-	if err != nil {
-		return err
-	}
-	eventdatascraper := baseballsavantmlb.NewFieldingBoxScoreScraper()
-	eventrunner := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.FieldingBoxScore]{
-			Concurrency: e.Concurrency,
-			Scraper:     eventdatascraper,
-		},
-	)
-	records, err := eventrunner.Run(m)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
-}
-
-func (e *BaseballSavantExtractor) scrapePlayByPlay(ctx context.Context) error {
-	m, err := e.retrieveMatchup()
-	if err != nil {
-		return err
+// checkpoint returns the runner checkpoint of the extractor's feed, nil without a Checkpoint store.
+func (e *BaseballSavantExtractor) checkpoint() *runner.Checkpoint[model.Matchup] {
+	if e.Checkpoint == nil {
+		return nil
 	}
-	eventdatascraper := baseballsavantmlb.NewPlayByPlayScraper()
-	eventrunner := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.PlayByPlay]{
-			Concurrency: e.Concurrency,
-			Scraper:     eventdatascraper,
+	return &runner.Checkpoint[model.Matchup]{
+		Store: e.Checkpoint,
+		Event: func(matchup model.Matchup) (any, bool) {
+			switch matchup.Status {
+			case "Final", "Game Over", "Completed Early":
+				return matchup.EventID, true
+			default:
+				return matchup.EventID, false
+			}
 		},
-	)
-	records, err := eventrunner.Run(m)
-	if err != nil {
-		return err
 	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
 }
@@ -23,7 +23,8 @@ func TestBaseballSavantExtractorValidateFeed(t *testing.T) {
 		// invalid feed
 		{name: "unsupported feed", feed: "invalid-feed", format: "jsonl", wantErr: true},
 		// invalid format
-		{name: "unsupported format", feed: "matchup", format: "csv", wantErr: true},
+		{name: "csv format", feed: "matchup", format: "csv"},
+		{name: "unsupported format", feed: "matchup", format: "xml", wantErr: true},
 		// empty
 		{name: "empty feed", feed: "", format: "jsonl", wantErr: true},
 		{name: "empty format", feed: "matchup", format: "", wantErr: true},
@@ -11,6 +11,7 @@ import (
 	"github.com/lightning-dabbler/sportscrape/dataprovider/espn/mma"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/espn/mma/model"
 	"github.com/lightning-dabbler/sportscrape/runner"
+	"github.com/lightning-dabbler/sportscrape/validate"
 )
 
 var (
@@ -20,15 +21,22 @@ var (
 )
 
 type ESPNMMAExtractor struct {
-	Feed           string
-	Year           string
-	Timeout        time.Duration
-	Concurrency    int
-	OutputPath     string
-	Format         string
-	S3Config       exporters.S3Config
-	ParquetOptions []exporters.ParquetConfigOption
-	matchupScraper *mma.ESPNMMAMatchupScraper
+	Feed        string
+	Year        string
+	Timeout     time.Duration
+	Concurrency int
+	OutputPath  string
+	Format      string
+	// Export configures the exporter of OutputPath.
+	Export exporters.Options
+	// Observer, when set, is notified of every runner of the scrape.
+	Observer runner.Observer
+	// Raw, when set, archives the payloads fetched for every event.
+	Raw runner.RawSink
+	// Validation applies the feed's validation rules to every scraped event.
+	Validation validate.Mode
+	// Browser configures the headless Chrome of the scrape.
+	Browser Browser
 }
 
 func (e *ESPNMMAExtractor) ValidateFeed() error {
@@ -48,64 +56,50 @@ func (e *ESPNMMAExtractor) ValidateFeed() error {
 }
 
 func (e *ESPNMMAExtractor) Scrape(ctx context.Context) error {
+	config, err := e.pipelineConfig()
+	if err != nil {
+		return err
+	}
+	config.Observer = e.Observer
+	return runner.NewPipeline(config).Run(ctx)
+}
+
+// pipelineConfig builds the matchup pipeline for the extractor's feed: the
+// matchups themselves for '*-matchups' feeds, otherwise a single event data stage.
+func (e *ESPNMMAExtractor) pipelineConfig() (runner.PipelineConfig[model.Matchup], error) {
+	var config runner.PipelineConfig[model.Matchup]
 	switch e.Feed {
 	case "ufc-matchups":
-		return e.scrapeMatchup(ctx, "ufc")
+		config.Scraper = e.matchupScraper("ufc")
+		config.MatchupSink = exportSink[model.Matchup](e.OutputPath, e.Format, exportSource(e.Export, config.Scraper))
 	case "ufc-fight-details":
-		return e.scrapeFightDetails(ctx, "ufc")
+		config.Scraper = e.matchupScraper("ufc")
+		fightdetailsscraper := &mma.ESPNMMAFightDetailsScraper{}
+		fightdetailsscraper.Timeout = e.Timeout
+		fightdetailsscraper.League = "ufc"
+		config.Stages = []runner.Stage[model.Matchup]{
+			runner.NewStage(
+				runner.EventDataRunnerConfig[model.Matchup, model.FightDetails]{
+					Concurrency: e.Concurrency,
+					Scraper:     fightdetailsscraper,
+					RawSink:     e.Raw,
+					Validation:  e.Validation,
+				},
+				exportSink[model.FightDetails](e.OutputPath, e.Format, exportSource(e.Export, fightdetailsscraper)),
+			),
+		}
 	default:
-		return fmt.Errorf("%w: %q", ErrUnsupportedFeed, e.Feed)
+		return config, fmt.Errorf("%w: %q", ErrUnsupportedFeed, e.Feed)
 	}
+	return config, nil
 }
 
-func (e *ESPNMMAExtractor) retrieveMatchup(league string, keepAlive bool) ([]model.Matchup, error) {
+func (e *ESPNMMAExtractor) matchupScraper(league string) *mma.ESPNMMAMatchupScraper {
 	matchupscraper := &mma.ESPNMMAMatchupScraper{}
 	matchupscraper.Timeout = e.Timeout
 	matchupscraper.League = league
 	matchupscraper.Year = e.Year
 	matchupscraper.NetworkHeaders = mma.NetworkHeaders
-
-	matchuprunner := runner.NewMatchupRunner(
-		runner.MatchupRunnerConfig[model.Matchup]{
-			Scraper:   matchupscraper,
-			KeepAlive: keepAlive,
-		},
-	)
-	m, err := matchuprunner.Run()
-	if err != nil {
-		matchupscraper.Close()
-		return m, err
-	}
-	e.matchupScraper = matchupscraper
-	return m, err
-}
-
-func (e *ESPNMMAExtractor) scrapeMatchup(ctx context.Context, league string) error {
-	m, err := e.retrieveMatchup(league, false)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, m, e.ParquetOptions...)
-}
-
-func (e *ESPNMMAExtractor) scrapeFightDetails(ctx context.Context, league string) error {
-	m, err := e.retrieveMatchup(league, true)
-	if err != nil {
-		return err
-	}
-	fightdetailsscraper := &mma.ESPNMMAFightDetailsScraper{}
-	fightdetailsscraper.Timeout = e.Timeout
-	fightdetailsscraper.League = league
-	fightdetailsscraper.DocumentRetriever = e.matchupScraper.DocumentRetriever
-	eventrunner := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.FightDetails]{
-			Concurrency: e.Concurrency,
-			Scraper:     fightdetailsscraper,
-		},
-	)
-	records, err := eventrunner.Run(m)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
+	e.Browser.apply(&matchupscraper.BaseDocumentScraper)
+	return matchupscraper
 }
@@ -23,7 +23,8 @@ func TestESPNMMAExtractorValidateFeed(t *testing.T) {
 		// unsupported feed prefix
 		{name: "unsupported feed", feed: "invalid-feed", format: "jsonl", wantErr: true},
 		// invalid format
-		{name: "unsupported format", feed: "ufc-matchups", format: "csv", wantErr: true},
+		{name: "csv format", feed: "ufc-matchups", format: "csv"},
+		{name: "unsupported format", feed: "ufc-matchups", format: "xml", wantErr: true},
 		// empty
 		{name: "empty feed", feed: "", format: "jsonl", wantErr: true},
 		{name: "empty format", feed: "ufc-matchups", format: "", wantErr: true},
@@ -3,6 +3,15 @@ package feed
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape"
+	"github.com/lightning-dabbler/sportscrape/cmd/sportscrape/internal/exporters"
+	"github.com/lightning-dabbler/sportscrape/runner"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 )
 
 type ProviderExtractor interface {
@@ -11,3 +20,111 @@ type ProviderExtractor interface {
 }
 
 var ErrUnsupportedFeed error = fmt.Errorf("unsupported data feed")
+
+// exportSink returns a runner.Sink that writes records to outputPath in format.
+func exportSink[E any](outputPath string, format string, options exporters.Options) runner.Sink[E] {
+	return func(ctx context.Context, records []E) error {
+		return exporters.BuildAndWrite(ctx, outputPath, format, records, options)
+	}
+}
+
+// exportSource returns options exporting the records of s.
+func exportSource(options exporters.Options, s interface {
+	Provider() sportscrape.Provider
+	Feed() sportscrape.Feed
+}) exporters.Options {
+	options.Provider = s.Provider()
+	options.Feed = s.Feed()
+	return options
+}
+
+// Backfill is the date range scraped instead of a single date when StartDate is set.
+type Backfill struct {
+	StartDate string
+	EndDate   string
+	// Concurrency is the number of days scraped at once.
+	Concurrency int
+}
+
+// Enabled reports whether a date range was requested.
+func (b Backfill) Enabled() bool {
+	return b.StartDate != ""
+}
+
+// Browser configures the headless Chrome launched by document scrapers.
+type Browser struct {
+	Proxy       *url.URL
+	ChromePath  string
+	ChromeFlags map[string]any
+	NoSandbox   bool
+	MaxTabs     int
+	ReuseTabs   bool
+}
+
+// apply sets the browser launch fields of s. Pipeline stages share the browser
+// of the matchup scraper, so applying it there is enough.
+func (b Browser) apply(s *scraper.BaseDocumentScraper) {
+	s.Proxy = b.Proxy
+	s.ChromePath = b.ChromePath
+	s.ChromeFlags = b.ChromeFlags
+	s.NoSandbox = b.NoSandbox
+	s.MaxTabs = b.MaxTabs
+	s.ReuseTabs = b.ReuseTabs
+}
+
+// scrapeDates runs the pipeline built for date, or a runner.BackfillRunner over
+// every day of b when it is enabled. Each backfilled day is written to its own
+// destination (see datedPath), and a run resuming from checkpoint to a part
+// file (see resumedPath).
+func scrapeDates[M any](ctx context.Context, date, outputPath string, b Backfill, checkpoint runner.CheckpointStore, observer runner.Observer, pipeline func(date, outputPath string) (runner.PipelineConfig[M], error)) error {
+	outputPath = resumedPath(outputPath, checkpoint, time.Now())
+	if !b.Enabled() {
+		config, err := pipeline(date, outputPath)
+		if err != nil {
+			return err
+		}
+		config.Observer = observer
+		return runner.NewPipeline(config).Run(ctx)
+	}
+	return runner.NewBackfillRunner(
+		runner.BackfillRunnerConfig[M]{
+			StartDate:   b.StartDate,
+			EndDate:     b.EndDate,
+			Concurrency: b.Concurrency,
+			Observer:    observer,
+			Pipeline: func(date string) (runner.PipelineConfig[M], error) {
+				return pipeline(date, datedPath(outputPath, date))
+			},
+		},
+	).Run(ctx)
+}
+
+// resumedPath returns the destination of a run resuming from checkpoint, i.e.
+// skipping the events a previous run recorded there. Files and S3 objects are
+// replaced by every write, so the records of the rescraped events go to a part
+// file next to the previous run's, e.g. out/nba.jsonl → out/nba-part-20250612T040000Z.jsonl.
+// Database and Kafka destinations add to what is there and are kept.
+func resumedPath(outputPath string, checkpoint runner.CheckpointStore, now time.Time) string {
+	store, ok := checkpoint.(interface{ Len() int })
+	if !ok || store.Len() == 0 || exporters.IsDatabaseDestination(outputPath) || exporters.IsKafkaDestination(outputPath) {
+		return outputPath
+	}
+	dir, file := path.Split(outputPath)
+	ext := path.Ext(file)
+	return dir + strings.TrimSuffix(file, ext) + "-part-" + now.UTC().Format("20060102T150405Z") + ext
+}
+
+// datedPath substitutes date for every '{date}' in outputPath, or inserts it
+// before the file extension when there is none, e.g. out/nba.jsonl → out/nba-2025-04-01.jsonl.
+// Database and Kafka destinations without '{date}' are shared by every date.
+func datedPath(outputPath, date string) string {
+	if strings.Contains(outputPath, "{date}") {
+		return strings.ReplaceAll(outputPath, "{date}", date)
+	}
+	if exporters.IsDatabaseDestination(outputPath) || exporters.IsKafkaDestination(outputPath) {
+		return outputPath
+	}
+	dir, file := path.Split(outputPath)
+	ext := path.Ext(file)
+	return dir + strings.TrimSuffix(file, ext) + "-" + date + ext
+}
@@ -0,0 +1,452 @@
+//go:build unit
+
+package feed
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/hamba/avro/v2"
+	"github.com/hamba/avro/v2/ocf"
+	"github.com/lightning-dabbler/sportscrape"
+	"github.com/lightning-dabbler/sportscrape/cmd/sportscrape/internal/exporters"
+	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+	nbamodel "github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/internal/mocks/scraper"
+	"github.com/lightning-dabbler/sportscrape/runner"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDatedPath(t *testing.T) {
+	tests := []struct {
+		name       string
+		outputPath string
+		expected   string
+	}{
+		{name: "extension", outputPath: "out/nba.jsonl", expected: "out/nba-2025-04-01.jsonl"},
+		{name: "no extension", outputPath: "nba", expected: "nba-2025-04-01"},
+		{name: "placeholder", outputPath: "out/{date}/nba.parquet", expected: "out/2025-04-01/nba.parquet"},
+		{name: "s3", outputPath: "s3://bucket/nba/box.parquet", expected: "s3://bucket/nba/box-2025-04-01.parquet"},
+		{name: "dotted directory", outputPath: "out.d/nba", expected: "out.d/nba-2025-04-01"},
+		{name: "sqlite", outputPath: "sqlite://out/nba.db?table=box", expected: "sqlite://out/nba.db?table=box"},
+		{name: "sqlite placeholder", outputPath: "sqlite://out/{date}.db", expected: "sqlite://out/2025-04-01.db"},
+		{name: "kafka", outputPath: "kafka://localhost:9092/nba.box", expected: "kafka://localhost:9092/nba.box"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := datedPath(tt.outputPath, "2025-04-01"); got != tt.expected {
+				t.Errorf("datedPath(%q) = %q, want %q", tt.outputPath, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestExportSinkCSV(t *testing.T) {
+	type record struct {
+		PullTimestamp        time.Time `json:"pull_timestamp"`
+		PullTimestampParquet int64     `json:"-"`
+		Team                 string    `json:"team"`
+		Points               int32     `json:"points"`
+		Odds                 *float32  `json:"odds"`
+		Starter              bool
+	}
+	odds := float32(-110.5)
+	pulled := time.Date(2025, 6, 12, 4, 0, 0, 123000000, time.UTC)
+	records := []record{
+		{PullTimestamp: pulled, PullTimestampParquet: 1, Team: "Oklahoma City Thunder", Points: 103, Odds: &odds, Starter: true},
+		{PullTimestamp: pulled, Team: `Indiana "Pacers"; IND`, Points: 91},
+	}
+	tests := []struct {
+		name     string
+		options  []exporters.CSVConfigOption
+		expected string
+	}{
+		{
+			name: "defaults",
+			expected: "pull_timestamp,team,points,odds,Starter\n" +
+				"2025-06-12T04:00:00.123Z,Oklahoma City Thunder,103,-110.5,true\n" +
+				"2025-06-12T04:00:00.123Z,\"Indiana \"\"Pacers\"\"; IND\",91,,false\n",
+		},
+		{
+			name:    "semicolon delimiter quoting all fields",
+			options: []exporters.CSVConfigOption{exporters.WithDelimiter(';'), exporters.WithQuoteAll(true)},
+			expected: `"pull_timestamp";"team";"points";"odds";"Starter"` + "\n" +
+				`"2025-06-12T04:00:00.123Z";"Oklahoma City Thunder";"103";"-110.5";"true"` + "\n" +
+				`"2025-06-12T04:00:00.123Z";"Indiana ""Pacers""; IND";"91";"";"false"` + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			destination := filepath.Join(t.TempDir(), "records.csv")
+			sink := exportSink[record](destination, "csv", exporters.Options{CSV: tt.options})
+			if err := sink(context.Background(), records); err != nil {
+				t.Fatal(err)
+			}
+			got, err := os.ReadFile(destination)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.expected {
+				t.Errorf("csv =\n%s\nwant\n%s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestExportSinkAvro(t *testing.T) {
+	pulled := time.Date(2025, 6, 12, 4, 0, 0, 123000000, time.UTC)
+	speed := float32(101.5)
+	records := []model.PlayByPlay{
+		{PullTimestamp: pulled, EventID: 776543, PlayID: "a1", HitSpeed: &speed},
+		{PullTimestamp: pulled, EventID: 776543, PlayID: "b2"},
+	}
+	for _, codec := range []exporters.AvroCodec{exporters.AvroNull, exporters.AvroDeflate, exporters.AvroSnappy} {
+		t.Run(string(codec), func(t *testing.T) {
+			destination := filepath.Join(t.TempDir(), "pbp.avro")
+			sink := exportSink[model.PlayByPlay](destination, "avro", exporters.Options{Avro: []exporters.AvroConfigOption{exporters.WithAvroCodec(codec)}})
+			if err := sink(context.Background(), records); err != nil {
+				t.Fatal(err)
+			}
+			f, err := os.Open(destination)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+			dec, err := ocf.NewDecoder(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(dec.Metadata()["avro.codec"]); got != string(codec) {
+				t.Errorf("avro.codec = %q, want %q", got, codec)
+			}
+			if got := dec.Schema().(*avro.RecordSchema); got.Name() != "PlayByPlay" || got.Fields()[0].Name() != "pull_timestamp" {
+				t.Errorf("avro.schema = %s", dec.Metadata()["avro.schema"])
+			}
+
+			var got []map[string]any
+			for dec.HasNext() {
+				var record map[string]any
+				if err := dec.Decode(&record); err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, record)
+			}
+			if err := dec.Error(); err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != 2 {
+				t.Fatalf("records = %d, want 2", len(got))
+			}
+			if pullTimestamp, _ := got[0]["pull_timestamp"].(time.Time); !pullTimestamp.Equal(pulled) {
+				t.Errorf("pull_timestamp = %v, want %v", got[0]["pull_timestamp"], pulled)
+			}
+			if got[0]["event_id"] != int64(776543) || got[0]["play_id"] != "a1" || got[1]["play_id"] != "b2" {
+				t.Errorf("records = %v", got)
+			}
+			// Nullable columns are ["null", T] unions
+			if got[0]["hit_speed"] != speed || got[1]["hit_speed"] != nil {
+				t.Errorf("hit_speed = %v, %v, want %v, nil", got[0]["hit_speed"], got[1]["hit_speed"], speed)
+			}
+		})
+	}
+}
+
+func TestExportSinkArrow(t *testing.T) {
+	pulled := time.Date(2025, 6, 12, 4, 0, 0, 123000000, time.UTC)
+	speed := float32(101.5)
+	records := []model.PlayByPlay{
+		{PullTimestamp: pulled, EventID: 776543, PlayID: "a1", HitSpeed: &speed},
+		{PullTimestamp: pulled, EventID: 776543, PlayID: "b2"},
+	}
+	write := func(t *testing.T, compression exporters.ArrowCompression) []byte {
+		destination := filepath.Join(t.TempDir(), "pbp.arrow")
+		sink := exportSink[model.PlayByPlay](destination, "arrow", exporters.Options{Arrow: []exporters.ArrowConfigOption{exporters.WithArrowCompression(compression)}})
+		if err := sink(context.Background(), records); err != nil {
+			t.Fatal(err)
+		}
+		b, err := os.ReadFile(destination)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	// Compressed files are decompressed by the reader
+	for _, compression := range []exporters.ArrowCompression{exporters.ArrowUncompressed, exporters.ArrowLZ4, exporters.ArrowZstd} {
+		t.Run(string(compression), func(t *testing.T) {
+			r, err := ipc.NewFileReader(bytes.NewReader(write(t, compression)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+			if r.NumRecords() != 1 {
+				t.Fatalf("record batches = %d, want 1", r.NumRecords())
+			}
+			rec, err := r.RecordBatch(0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rec.NumRows() != 2 {
+				t.Fatalf("rows = %d, want 2", rec.NumRows())
+			}
+			columns := map[string]arrow.Array{}
+			for i, field := range r.Schema().Fields() {
+				columns[field.Name] = rec.Column(i)
+			}
+			if got := columns["pull_timestamp"].(*array.Timestamp).Value(0); int64(got) != pulled.UnixMilli() {
+				t.Errorf("pull_timestamp = %d, want %d", got, pulled.UnixMilli())
+			}
+			if got := columns["event_id"].(*array.Int64).Value(1); got != 776543 {
+				t.Errorf("event_id = %d", got)
+			}
+			if got := columns["play_id"].(*array.String).Value(1); got != "b2" {
+				t.Errorf("play_id = %q", got)
+			}
+			hitSpeed := columns["hit_speed"].(*array.Float32)
+			if hitSpeed.Value(0) != speed || !hitSpeed.IsNull(1) {
+				t.Errorf("hit_speed = %v, %v null, want %v, null", hitSpeed.Value(0), hitSpeed.IsNull(1), speed)
+			}
+		})
+	}
+}
+
+func TestExportSinkSQLite(t *testing.T) {
+	pulled := time.Date(2025, 6, 12, 4, 0, 0, 123000000, time.UTC)
+	speed, faster := float32(101.5), float32(104.2)
+	first := []model.PlayByPlay{
+		{PullTimestamp: pulled, EventID: 776543, PlayID: "a1", HitSpeed: &speed},
+		{PullTimestamp: pulled, EventID: 776543, PlayID: "b2"},
+	}
+	// A rerun updates a1 by its natural key (event_id, play_id) and adds c3
+	rerun := []model.PlayByPlay{
+		{PullTimestamp: pulled, EventID: 776543, PlayID: "a1", HitSpeed: &faster},
+		{PullTimestamp: pulled, EventID: 776543, PlayID: "c3"},
+	}
+	tests := []struct {
+		name  string
+		query string
+		table string
+	}{
+		{name: "default table", table: "play_by_play"},
+		{name: "table and batch size", query: "?table=savant_pbp&batch_size=1", table: "savant_pbp"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "data", "sportscrape.db")
+			sink := exportSink[model.PlayByPlay]("sqlite://"+file+tt.query, "jsonl", exporters.Options{})
+			for _, records := range [][]model.PlayByPlay{first, rerun} {
+				if err := sink(context.Background(), records); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			db, err := sql.Open("sqlite", file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+			rows, err := db.Query(`SELECT play_id, pull_timestamp, hit_speed FROM "` + tt.table + `" ORDER BY play_id`)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rows.Close()
+			var got []string
+			for rows.Next() {
+				var playID, pullTimestamp string
+				var hitSpeed sql.NullFloat64
+				if err := rows.Scan(&playID, &pullTimestamp, &hitSpeed); err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, fmt.Sprintf("%s %s %v", playID, pullTimestamp, hitSpeed))
+			}
+			if err := rows.Err(); err != nil {
+				t.Fatal(err)
+			}
+			expected := []string{
+				"a1 2025-06-12T04:00:00.123Z {104.2 true}",
+				"b2 2025-06-12T04:00:00.123Z {0 false}",
+				"c3 2025-06-12T04:00:00.123Z {0 false}",
+			}
+			if !slices.Equal(got, expected) {
+				t.Errorf("rows = %q, want %q", got, expected)
+			}
+		})
+	}
+}
+
+func TestExportSinkSQLitePeriodFeeds(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "nba.db")
+	// The full game and Q1 feeds share the model and its natural key (event_id, player_id)
+	batches := []struct {
+		feed   sportscrape.Feed
+		points int32
+	}{
+		{feed: sportscrape.NBATraditionalBoxScore, points: 31},
+		{feed: sportscrape.NBATraditionalBoxScoreQ1, points: 9},
+	}
+	for _, batch := range batches {
+		records := []nbamodel.BoxScoreTraditional{{EventID: "0042400403", PlayerID: 1628983, Points: batch.points}}
+		sink := exportSink[nbamodel.BoxScoreTraditional]("sqlite://"+file, "jsonl", exporters.Options{Feed: batch.feed})
+		if err := sink(context.Background(), records); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	db, err := sql.Open("sqlite", file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	for table, expected := range map[string]int32{"nba_traditional_box_score": 31, "nba_q1_traditional_box_score": 9} {
+		var points int32
+		if err := db.QueryRow(`SELECT points FROM "` + table + `" WHERE event_id = '0042400403' AND player_id = 1628983`).Scan(&points); err != nil {
+			t.Fatalf("%s: %v", table, err)
+		}
+		if points != expected {
+			t.Errorf("%s points = %d, want %d", table, points, expected)
+		}
+	}
+}
+
+func TestResumedPath(t *testing.T) {
+	store, err := runner.OpenFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	now := time.Date(2025, 6, 12, 4, 0, 0, 0, time.FixedZone("EDT", -4*60*60))
+	if got := resumedPath("out/nba.jsonl", store, now); got != "out/nba.jsonl" {
+		t.Errorf("resumedPath() of an empty checkpoint = %q, want the destination", got)
+	}
+	if got := resumedPath("out/nba.jsonl", nil, now); got != "out/nba.jsonl" {
+		t.Errorf("resumedPath() without a checkpoint = %q, want the destination", got)
+	}
+	if err := store.Complete(runner.NewCheckpointKey(sportscrape.DummyFeed, 1, "")); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		outputPath string
+		expected   string
+	}{
+		{outputPath: "out/nba.jsonl", expected: "out/nba-part-20250612T080000Z.jsonl"},
+		{outputPath: "out.d/nba", expected: "out.d/nba-part-20250612T080000Z"},
+		{outputPath: "s3://bucket/nba/box.parquet", expected: "s3://bucket/nba/box-part-20250612T080000Z.parquet"},
+		{outputPath: "out/{date}/nba.parquet", expected: "out/{date}/nba-part-20250612T080000Z.parquet"},
+		{outputPath: "sqlite://out/nba.db", expected: "sqlite://out/nba.db"},
+		{outputPath: "kafka://localhost:9092/nba.box", expected: "kafka://localhost:9092/nba.box"},
+	}
+	for _, tt := range tests {
+		if got := resumedPath(tt.outputPath, store, now); got != tt.expected {
+			t.Errorf("resumedPath(%q) = %q, want %q", tt.outputPath, got, tt.expected)
+		}
+	}
+}
+
+func TestScrapeDatesResumeKeepsPreviousRun(t *testing.T) {
+	type matchup struct {
+		ID    int64
+		Final bool
+	}
+	type record struct {
+		EventID int64 `json:"event_id"`
+	}
+	dir := t.TempDir()
+	outputPath := filepath.Join(dir, "events.jsonl")
+	checkpointPath := filepath.Join(dir, "checkpoint.jsonl")
+
+	run := func(matchups []matchup) {
+		store, err := runner.OpenFileCheckpointStore(checkpointPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer store.Close()
+
+		matchupScraper := scraper.NewMockMatchupScraper[matchup](t)
+		matchupScraper.EXPECT().Init().Return(nil).Maybe()
+		matchupScraper.EXPECT().Scrape(mock.Anything).Return(sportscrape.MatchupOutput[matchup]{Output: matchups})
+		matchupScraper.EXPECT().Feed().Return(sportscrape.DummyFeed).Maybe()
+		matchupScraper.EXPECT().Provider().Return(sportscrape.DummyProvider).Maybe()
+		matchupScraper.EXPECT().Close().Maybe()
+
+		eventScraper := scraper.NewMockEventDataScraper[matchup, record](t)
+		eventScraper.EXPECT().Init().Return(nil).Maybe()
+		eventScraper.EXPECT().Scrape(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, m matchup) sportscrape.EventDataOutput[record] {
+				return sportscrape.EventDataOutput[record]{
+					Context: sportscrape.EventDataContext{EventID: m.ID},
+					Output:  []record{{EventID: m.ID}},
+				}
+			},
+		).Maybe()
+		eventScraper.EXPECT().Feed().Return(sportscrape.DummyFeed).Maybe()
+		eventScraper.EXPECT().Provider().Return(sportscrape.DummyProvider).Maybe()
+		eventScraper.EXPECT().Close().Maybe()
+
+		err = scrapeDates(context.Background(), "2025-06-12", outputPath, Backfill{}, store, nil,
+			func(date, outputPath string) (runner.PipelineConfig[matchup], error) {
+				return runner.PipelineConfig[matchup]{
+					Scraper: matchupScraper,
+					Stages: []runner.Stage[matchup]{
+						runner.NewStage(
+							runner.EventDataRunnerConfig[matchup, record]{
+								Scraper: eventScraper,
+								Checkpoint: &runner.Checkpoint[matchup]{
+									Store: store,
+									Event: func(m matchup) (any, bool) { return m.ID, m.Final },
+								},
+							},
+							exportSink[record](outputPath, "jsonl", exporters.Options{}),
+						),
+					},
+				}, nil
+			},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	read := func(path string) []int64 {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []int64
+		for _, line := range bytes.Split(bytes.TrimSpace(b), []byte("\n")) {
+			var r record
+			if err := json.Unmarshal(line, &r); err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, r.EventID)
+		}
+		slices.Sort(ids)
+		return ids
+	}
+
+	run([]matchup{{ID: 1, Final: true}, {ID: 2, Final: true}})
+	run([]matchup{{ID: 1, Final: true}, {ID: 2, Final: true}, {ID: 3, Final: true}})
+
+	if got := read(outputPath); !slices.Equal(got, []int64{1, 2}) {
+		t.Errorf("first run's output holds events %v, want [1 2]", got)
+	}
+	parts, err := filepath.Glob(filepath.Join(dir, "events-part-*.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parts) != 1 {
+		t.Fatalf("part files = %v, want one", parts)
+	}
+	if got := read(parts[0]); !slices.Equal(got, []int64{3}) {
+		t.Errorf("resumed run's part file holds events %v, want [3]", got)
+	}
+}
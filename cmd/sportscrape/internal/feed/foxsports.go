@@ -10,7 +10,9 @@ import (
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model"
 	"github.com/lightning-dabbler/sportscrape/runner"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
+	"github.com/lightning-dabbler/sportscrape/validate"
 )
 
 var (
@@ -22,22 +24,36 @@ var (
 	ErrFSNBA                error  = fmt.Errorf("invalid nba feed, valid options: %s", FSNBAOptions)
 	ErrFSWNBA               error  = fmt.Errorf("invalid wnba feed, valid options: %s", FSNBAOptions)
 	ErrFSNFLDateFmt         error  = fmt.Errorf("date for NFL feed should be of the form '{year}-{week}-{seasontype}' e.g. 2024-4-2")
+	ErrFSNFLBackfill        error  = fmt.Errorf("nfl feed is scraped by week and does not support --start-date/--end-date")
 )
 
 type FoxSportsExtractor struct {
-	Feed           string
-	Date           string
-	Concurrency    int
-	OutputPath     string
-	Format         string
-	S3Config       exporters.S3Config
-	ParquetOptions []exporters.ParquetConfigOption
+	Feed        string
+	Date        string
+	Concurrency int
+	OutputPath  string
+	Format      string
+	// Export configures the exporter of OutputPath.
+	Export exporters.Options
+	// Observer, when set, is notified of every runner of the scrape.
+	Observer runner.Observer
+	// Raw, when set, archives the payloads fetched for every event.
+	Raw runner.RawSink
+	// Validation applies the feed's validation rules to every scraped event.
+	Validation validate.Mode
+	// Backfill, when enabled, scrapes a date range instead of Date.
+	Backfill Backfill
+	// Checkpoint, when set, skips events it recorded as scraped and records the final events scraped.
+	Checkpoint runner.CheckpointStore
 }
 
 func (e *FoxSportsExtractor) ValidateFeed() error {
 	if err := exporters.ValidateFormat(e.Format); err != nil {
 		return err
 	}
+	if e.Feed == "nfl-matchup" && e.Backfill.Enabled() {
+		return ErrFSNFLBackfill
+	}
 	switch e.Feed {
 	case "mlb-matchup", "mlb-pitching-box-score", "mlb-batting-box-score",
 		"mlb-probable-starting-pitcher", "mlb-odds-total", "mlb-odds-money-line",
@@ -58,188 +74,129 @@ func (e *FoxSportsExtractor) ValidateFeed() error {
 }
 
 func (e *FoxSportsExtractor) Scrape(ctx context.Context) error {
+	return scrapeDates(ctx, e.Date, e.OutputPath, e.Backfill, e.Checkpoint, e.Observer, func(date, outputPath string) (runner.PipelineConfig[model.Matchup], error) {
+		return e.on(date, outputPath).pipelineConfig()
+	})
+}
+
+// on returns a copy of the extractor scraping date into outputPath.
+func (e *FoxSportsExtractor) on(date, outputPath string) *FoxSportsExtractor {
+	day := *e
+	day.Date = date
+	day.OutputPath = outputPath
+	return &day
+}
+
+// pipelineConfig builds the matchup pipeline for the extractor's feed: the
+// matchups themselves for '*-matchup' feeds, otherwise a single event data stage.
+func (e *FoxSportsExtractor) pipelineConfig() (runner.PipelineConfig[model.Matchup], error) {
+	var config runner.PipelineConfig[model.Matchup]
+	var stage runner.Stage[model.Matchup]
 	switch e.Feed {
 	case "mlb-matchup":
-		return e.scrapeMatchup(ctx, foxsports.MLB)
+		config.Scraper = e.matchupScraper(foxsports.MLB)
 	case "nba-matchup":
-		return e.scrapeMatchup(ctx, foxsports.NBA)
+		config.Scraper = e.matchupScraper(foxsports.NBA)
 	case "wnba-matchup":
-		return e.scrapeMatchup(ctx, foxsports.WNBA)
+		config.Scraper = e.matchupScraper(foxsports.WNBA)
 	case "ncaab-matchup":
-		return e.scrapeMatchup(ctx, foxsports.NCAAB)
+		config.Scraper = e.matchupScraper(foxsports.NCAAB)
 	case "nfl-matchup":
-		return e.scrapeNFLMatchup(ctx)
+		scraper, err := e.nflMatchupScraper()
+		if err != nil {
+			return config, err
+		}
+		config.Scraper = scraper
 	case "nba-box-score-stats":
-		return e.scrapeNBABoxScore(ctx, foxsports.NBA)
+		config.Scraper = e.matchupScraper(foxsports.NBA)
+		stage = fsStage(e, foxsports.NewNBABoxScoreScraper(foxsports.NBABoxScoreScraperLeague(foxsports.NBA)))
 	case "wnba-box-score-stats":
-		return e.scrapeNBABoxScore(ctx, foxsports.WNBA)
+		config.Scraper = e.matchupScraper(foxsports.WNBA)
+		stage = fsStage(e, foxsports.NewNBABoxScoreScraper(foxsports.NBABoxScoreScraperLeague(foxsports.WNBA)))
 	case "mlb-batting-box-score":
-		return e.scrapeMLBBattingBoxScore(ctx)
+		config.Scraper = e.matchupScraper(foxsports.MLB)
+		stage = fsStage(e, foxsports.NewMLBBattingBoxScoreScraper())
 	case "mlb-pitching-box-score":
-		return e.scrapeMLBPitchingBoxScore(ctx)
+		config.Scraper = e.matchupScraper(foxsports.MLB)
+		stage = fsStage(e, foxsports.NewMLBPitchingBoxScoreScraper())
 	case "mlb-probable-starting-pitcher":
-		return e.scrapeMLBProbableStartingPitcher(ctx)
+		config.Scraper = e.matchupScraper(foxsports.MLB)
+		stage = fsStage(e, foxsports.NewMLBProbableStartingPitcherScraper())
 	case "mlb-odds-total":
-		return e.scrapeMLBOddsTotal(ctx)
+		config.Scraper = e.matchupScraper(foxsports.MLB)
+		stage = fsStage(e, foxsports.NewMLBOddsTotalScraper())
 	case "mlb-odds-money-line":
-		return e.scrapeMLBOddsMoneyLine(ctx)
+		config.Scraper = e.matchupScraper(foxsports.MLB)
+		stage = fsStage(e, foxsports.NewMLBOddsMoneyLineScraper())
 	default:
-		return fmt.Errorf("unsupported feed %q. %w", e.Feed, ErrUnsupportedFeed)
+		return config, fmt.Errorf("unsupported feed %q. %w", e.Feed, ErrUnsupportedFeed)
 	}
+	if stage == nil {
+		config.MatchupSink = exportSink[model.Matchup](e.OutputPath, e.Format, exportSource(e.Export, config.Scraper))
+	} else {
+		config.Stages = []runner.Stage[model.Matchup]{stage}
+	}
+	return config, nil
 }
 
-func (e *FoxSportsExtractor) retrieveMatchup(league foxsports.League) ([]model.Matchup, error) {
-	return runner.NewMatchupRunner(
-		runner.MatchupRunnerConfig[model.Matchup]{
-			Scraper: &foxsports.MatchupScraper{
-				League:    league,
-				Segmenter: &foxsports.GeneralSegmenter{Date: e.Date},
-			},
-		},
-	).Run()
-}
-
-func (e *FoxSportsExtractor) scrapeMatchup(ctx context.Context, league foxsports.League) error {
-	matchups, err := e.retrieveMatchup(league)
-	if err != nil {
-		return err
+func (e *FoxSportsExtractor) matchupScraper(league foxsports.League) *foxsports.MatchupScraper {
+	return &foxsports.MatchupScraper{
+		League:    league,
+		Segmenter: &foxsports.GeneralSegmenter{Date: e.Date},
 	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, matchups, e.ParquetOptions...)
 }
 
-func (e *FoxSportsExtractor) scrapeNFLMatchup(ctx context.Context) error {
+func (e *FoxSportsExtractor) nflMatchupScraper() (*foxsports.MatchupScraper, error) {
 	parts := strings.Split(e.Date, "-")
 	if len(parts) != 3 {
-		return ErrFSNFLDateFmt
+		return nil, ErrFSNFLDateFmt
 	}
 	year, err := util.TextToInt32(parts[0])
 	if err != nil {
-		return err
+		return nil, err
 	}
 	week, err := util.TextToInt32(parts[1])
 	if err != nil {
-		return err
+		return nil, err
 	}
 	seasontype, err := util.TextToInt(parts[2])
 	if err != nil {
-		return err
-	}
-	matchups, err := runner.NewMatchupRunner(
-		runner.MatchupRunnerConfig[model.Matchup]{
-			Scraper: &foxsports.MatchupScraper{
-				League: foxsports.NFL,
-				Segmenter: &foxsports.NFLSegmenter{
-					Season: foxsports.SeasonType(seasontype),
-					Week:   week,
-					Year:   year,
-				},
-			},
-		},
-	).Run()
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, matchups, e.ParquetOptions...)
-}
-
-func (e *FoxSportsExtractor) scrapeNBABoxScore(ctx context.Context, league foxsports.League) error {
-	matchups, err := e.retrieveMatchup(league)
-	if err != nil {
-		return err
-	}
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.NBABoxScoreStats]{
-			Concurrency: e.Concurrency,
-			Scraper:     foxsports.NewNBABoxScoreScraper(foxsports.NBABoxScoreScraperLeague(league)),
-		},
-	).Run(matchups)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
-}
-
-func (e *FoxSportsExtractor) scrapeMLBBattingBoxScore(ctx context.Context) error {
-	matchups, err := e.retrieveMatchup(foxsports.MLB)
-	if err != nil {
-		return err
-	}
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.MLBBattingBoxScoreStats]{
-			Concurrency: e.Concurrency,
-			Scraper:     foxsports.NewMLBBattingBoxScoreScraper(),
-		},
-	).Run(matchups)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
-}
-
-func (e *FoxSportsExtractor) scrapeMLBPitchingBoxScore(ctx context.Context) error {
-	matchups, err := e.retrieveMatchup(foxsports.MLB)
-	if err != nil {
-		return err
+		return nil, err
 	}
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.MLBPitchingBoxScoreStats]{
-			Concurrency: e.Concurrency,
-			Scraper:     foxsports.NewMLBPitchingBoxScoreScraper(),
+	return &foxsports.MatchupScraper{
+		League: foxsports.NFL,
+		Segmenter: &foxsports.NFLSegmenter{
+			Season: foxsports.SeasonType(seasontype),
+			Week:   week,
+			Year:   year,
 		},
-	).Run(matchups)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
+	}, nil
 }
 
-func (e *FoxSportsExtractor) scrapeMLBProbableStartingPitcher(ctx context.Context) error {
-	matchups, err := e.retrieveMatchup(foxsports.MLB)
-	if err != nil {
-		return err
-	}
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.MLBProbableStartingPitcher]{
+// fsStage wraps a foxsports event data scraper in a pipeline stage exporting to the extractor's destination.
+func fsStage[E any](e *FoxSportsExtractor, s scraper.EventDataScraper[model.Matchup, E]) runner.Stage[model.Matchup] {
+	return runner.NewStage(
+		runner.EventDataRunnerConfig[model.Matchup, E]{
 			Concurrency: e.Concurrency,
-			Scraper:     foxsports.NewMLBProbableStartingPitcherScraper(),
+			Scraper:     s,
+			Checkpoint:  e.checkpoint(),
+			RawSink:     e.Raw,
+			Validation:  e.Validation,
 		},
-	).Run(matchups)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
+		exportSink[E](e.OutputPath, e.Format, exportSource(e.Export, s)),
+	)
 }
 
-func (e *FoxSportsExtractor) scrapeMLBOddsTotal(ctx context.Context) error {
-	matchups, err := e.retrieveMatchup(foxsports.MLB)
-	if err != nil {
-		return err
-	}
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.MLBOddsTotal]{
-			Concurrency: e.Concurrency,
-			Scraper:     foxsports.NewMLBOddsTotalScraper(),
-		},
-	).Run(matchups)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
-}
-
-func (e *FoxSportsExtractor) scrapeMLBOddsMoneyLine(ctx context.Context) error {
-	matchups, err := e.retrieveMatchup(foxsports.MLB)
-	if err != nil {
-		return err
+// checkpoint returns the runner checkpoint of the extractor's feed, nil without a Checkpoint store.
+func (e *FoxSportsExtractor) checkpoint() *runner.Checkpoint[model.Matchup] {
+	if e.Checkpoint == nil {
+		return nil
 	}
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.MLBOddsMoneyLine]{
-			Concurrency: e.Concurrency,
-			Scraper:     foxsports.NewMLBOddsMoneyLineScraper(),
+	return &runner.Checkpoint[model.Matchup]{
+		Store: e.Checkpoint,
+		Event: func(matchup model.Matchup) (any, bool) {
+			// e.g. FINAL, FINAL/OT
+			return matchup.EventID, strings.HasPrefix(strings.ToUpper(matchup.StatusLine), "FINAL")
 		},
-	).Run(matchups)
-	if err != nil {
-		return err
 	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
 }
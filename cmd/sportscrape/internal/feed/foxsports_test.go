@@ -8,10 +8,11 @@ import (
 
 func TestFoxSportsExtractorValidateFeed(t *testing.T) {
 	tests := []struct {
-		name    string
-		feed    string
-		format  string
-		wantErr bool
+		name     string
+		feed     string
+		format   string
+		backfill Backfill
+		wantErr  bool
 	}{
 		// valid mlb feeds
 		{name: "mlb-matchup jsonl", feed: "mlb-matchup", format: "jsonl"},
@@ -30,6 +31,9 @@ func TestFoxSportsExtractorValidateFeed(t *testing.T) {
 		// valid ncaab/nfl feeds
 		{name: "ncaab-matchup", feed: "ncaab-matchup", format: "jsonl"},
 		{name: "nfl-matchup", feed: "nfl-matchup", format: "jsonl"},
+		// date range
+		{name: "mlb-matchup backfill", feed: "mlb-matchup", format: "jsonl", backfill: Backfill{StartDate: "2025-04-01", EndDate: "2025-04-07"}},
+		{name: "nfl-matchup backfill", feed: "nfl-matchup", format: "jsonl", backfill: Backfill{StartDate: "2025-04-01", EndDate: "2025-04-07"}, wantErr: true},
 		// invalid mlb feed
 		{name: "unsupported mlb feed", feed: "mlb-invalid", format: "jsonl", wantErr: true},
 		// invalid wnba feed
@@ -37,7 +41,8 @@ func TestFoxSportsExtractorValidateFeed(t *testing.T) {
 		// invalid nba feed (default branch)
 		{name: "unsupported nba feed", feed: "nba-invalid", format: "jsonl", wantErr: true},
 		// invalid format
-		{name: "unsupported format", feed: "mlb-matchup", format: "csv", wantErr: true},
+		{name: "csv format", feed: "mlb-matchup", format: "csv"},
+		{name: "unsupported format", feed: "mlb-matchup", format: "xml", wantErr: true},
 		// empty
 		{name: "empty feed", feed: "", format: "jsonl", wantErr: true},
 		{name: "empty format", feed: "mlb-matchup", format: "", wantErr: true},
@@ -46,8 +51,9 @@ func TestFoxSportsExtractorValidateFeed(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			e := &FoxSportsExtractor{
-				Feed:   tt.feed,
-				Format: tt.format,
+				Feed:     tt.feed,
+				Format:   tt.format,
+				Backfill: tt.backfill,
 			}
 			err := e.ValidateFeed()
 			if (err != nil) != tt.wantErr {
@@ -0,0 +1,115 @@
+//go:build integration
+
+package feed
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape"
+	"github.com/lightning-dabbler/sportscrape/cmd/sportscrape/internal/exporters"
+	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+	"github.com/segmentio/kafka-go"
+)
+
+// TestExportSinkKafka publishes to the broker of SPORTSCRAPE_KAFKA_BROKER,
+// e.g. the kafka service of docker-compose.yml: localhost:9092
+func TestExportSinkKafka(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+	broker := os.Getenv("SPORTSCRAPE_KAFKA_BROKER")
+	if broker == "" {
+		t.Skip("SPORTSCRAPE_KAFKA_BROKER is not set")
+	}
+	pulled := time.Date(2025, 6, 12, 4, 0, 0, 123000000, time.UTC)
+	speed := float32(101.5)
+	records := []model.PlayByPlay{
+		{PullTimestamp: pulled, EventID: 776543, PlayID: "a1", HitSpeed: &speed},
+		{PullTimestamp: pulled, EventID: 776543, PlayID: "b2"},
+	}
+	options := exporters.Options{
+		Kafka:    []exporters.KafkaConfigOption{exporters.WithKafkaCompression(exporters.KafkaZSTD)},
+		Provider: sportscrape.BaseballSavant,
+		Feed:     sportscrape.BaseballSavantMLBPlayByPlay,
+	}
+	for _, format := range []string{"jsonl", "avro"} {
+		t.Run(format, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			topic := fmt.Sprintf("sportscrape-test-pbp-%s-%d", format, time.Now().UnixNano())
+			sink := exportSink[model.PlayByPlay]("kafka://"+broker+"/"+topic, format, options)
+			if err := sink(ctx, records); err != nil {
+				t.Fatal(err)
+			}
+
+			r := kafka.NewReader(kafka.ReaderConfig{Brokers: []string{broker}, Topic: topic})
+			defer r.Close()
+			for _, record := range records {
+				message, err := r.ReadMessage(ctx)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if key := fmt.Sprintf("%d:%s", record.EventID, record.PlayID); string(message.Key) != key {
+					t.Errorf("key = %q, want %q", message.Key, key)
+				}
+				headers := map[string]string{}
+				for _, header := range message.Headers {
+					headers[header.Key] = string(header.Value)
+				}
+				expected := map[string]string{
+					"provider":       "baseball savant",
+					"feed":           "baseball savant mlb play by play",
+					"pull_timestamp": "2025-06-12T04:00:00.123Z",
+				}
+				for key, value := range expected {
+					if headers[key] != value {
+						t.Errorf("header %s = %q, want %q", key, headers[key], value)
+					}
+				}
+
+				switch format {
+				case "jsonl":
+					var got model.PlayByPlay
+					if err := json.Unmarshal(message.Value, &got); err != nil {
+						t.Fatal(err)
+					}
+					if got.EventID != record.EventID || got.PlayID != record.PlayID || !got.PullTimestamp.Equal(pulled) {
+						t.Errorf("value = %s", message.Value)
+					}
+				case "avro":
+					// Single-object encoding: marker, schema fingerprint, then
+					// the record: pull_timestamp, event_id, event_time, play_id ...
+					if !bytes.HasPrefix(message.Value, []byte{0xc3, 0x01}) || len(message.Value) < 10 {
+						t.Fatalf("value = %x, want the avro single-object marker", message.Value)
+					}
+					body := message.Value[10:]
+					next := func() int64 {
+						n, size := binary.Varint(body)
+						if size <= 0 {
+							t.Fatalf("truncated avro record %x", message.Value)
+						}
+						body = body[size:]
+						return n
+					}
+					if got := next(); got != pulled.UnixMilli() {
+						t.Errorf("pull_timestamp = %d, want %d", got, pulled.UnixMilli())
+					}
+					if got := next(); got != record.EventID {
+						t.Errorf("event_id = %d, want %d", got, record.EventID)
+					}
+					next()
+					if n := next(); string(body[:n]) != record.PlayID {
+						t.Errorf("play_id = %q, want %q", body[:n], record.PlayID)
+					}
+				}
+			}
+		})
+	}
+}
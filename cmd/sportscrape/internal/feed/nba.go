@@ -11,6 +11,8 @@ import (
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
 	"github.com/lightning-dabbler/sportscrape/runner"
+	"github.com/lightning-dabbler/sportscrape/scraper"
+	"github.com/lightning-dabbler/sportscrape/validate"
 )
 
 var (
@@ -33,15 +35,28 @@ var (
 )
 
 type NBAExtractor struct {
-	Feed           string
-	Date           string
-	Timeout        time.Duration
-	Concurrency    int
-	OutputPath     string
-	Format         string
-	S3Config       exporters.S3Config
-	ParquetOptions []exporters.ParquetConfigOption
-	matchupScraper *nba.MatchupScraper
+	Feed        string
+	Date        string
+	Timeout     time.Duration
+	Concurrency int
+	OutputPath  string
+	Format      string
+	// Export configures the exporter of OutputPath.
+	Export exporters.Options
+	// Observer, when set, is notified of every runner of the scrape.
+	Observer runner.Observer
+	// Raw, when set, archives the payloads fetched for every event.
+	Raw runner.RawSink
+	// Validation applies the feed's validation rules to every scraped event.
+	Validation validate.Mode
+	// Backfill, when enabled, scrapes a date range instead of Date.
+	Backfill Backfill
+	// Checkpoint, when set, skips events it recorded as scraped and records the final events scraped.
+	Checkpoint runner.CheckpointStore
+	// Browser configures the headless Chrome of the scrape.
+	Browser Browser
+	// HTTPFirst fetches pages with plain HTTP, launching Chrome only for pages missing their server-rendered data.
+	HTTPFirst bool
 }
 
 func (e *NBAExtractor) ValidateFeed() error {
@@ -66,38 +81,108 @@ func (e *NBAExtractor) ValidateFeed() error {
 }
 
 func (e *NBAExtractor) Scrape(ctx context.Context) error {
+	if e.Feed == "matchup-periods" {
+		return scrapeDates(ctx, e.Date, e.OutputPath, e.Backfill, e.Checkpoint, e.Observer, func(date, outputPath string) (runner.PipelineConfig[model.MatchupPeriods], error) {
+			return e.on(date, outputPath).matchupPeriodsPipelineConfig(), nil
+		})
+	}
+	return scrapeDates(ctx, e.Date, e.OutputPath, e.Backfill, e.Checkpoint, e.Observer, func(date, outputPath string) (runner.PipelineConfig[model.Matchup], error) {
+		return e.on(date, outputPath).pipelineConfig()
+	})
+}
+
+// on returns a copy of the extractor scraping date into outputPath.
+func (e *NBAExtractor) on(date, outputPath string) *NBAExtractor {
+	day := *e
+	day.Date = date
+	day.OutputPath = outputPath
+	return &day
+}
+
+// pipelineConfig builds the matchup pipeline for the extractor's feed: the
+// matchups themselves for 'matchup', otherwise a single event data stage.
+func (e *NBAExtractor) pipelineConfig() (runner.PipelineConfig[model.Matchup], error) {
+	matchupScraper := nba.NewMatchupScraper(
+		nba.WithMatchupDate(e.Date),
+		nba.WithMatchupTimeout(e.Timeout),
+		nba.WithMatchupHTTPFirst(e.HTTPFirst),
+	)
+	matchupScraper.NetworkHeaders = nba.NetworkHeaders
+	e.Browser.apply(&matchupScraper.BaseDocumentScraper)
+	config := runner.PipelineConfig[model.Matchup]{Scraper: matchupScraper}
+
+	var stage runner.Stage[model.Matchup]
 	switch e.Feed {
 	case "matchup":
-		return e.scrapeMatchup(ctx)
-	case "matchup-periods":
-		return e.scrapeMatchupPeriods(ctx)
+		config.MatchupSink = exportSink[model.Matchup](e.OutputPath, e.Format, exportSource(e.Export, config.Scraper))
+		return config, nil
 	case "live-box-score":
-		return e.scrapeLiveBoxScore(ctx)
+		stage = nbaStage(e, nba.NewBoxScoreLiveScraper(nba.WithBoxScoreLiveTimeout(e.Timeout), nba.WithBoxScoreLiveHTTPFirst(e.HTTPFirst)))
 	case "hustle-box-score":
-		return e.scrapeHustleBoxScore(ctx)
+		stage = nbaStage(e, nba.NewBoxScoreHustleScraper(nba.WithBoxScoreHustleTimeout(e.Timeout), nba.WithBoxScoreHustleHTTPFirst(e.HTTPFirst)))
 	case "matchups-box-score":
-		return e.scrapeMatchupsBoxScore(ctx)
+		stage = nbaStage(e, nba.NewBoxScoreMatchupsScraper(nba.WithBoxScoreMatchupsTimeout(e.Timeout), nba.WithBoxScoreMatchupsHTTPFirst(e.HTTPFirst)))
 	case "defense-box-score":
-		return e.scrapeDefenseBoxScore(ctx)
+		stage = nbaStage(e, nba.NewBoxScoreDefenseScraper(nba.WithBoxScoreDefenseTimeout(e.Timeout), nba.WithBoxScoreDefenseHTTPFirst(e.HTTPFirst)))
 	case "tracking-box-score":
-		return e.scrapeTrackingBoxScore(ctx)
+		stage = nbaStage(e, nba.NewBoxScoreTrackingScraper(nba.WithBoxScoreTrackingTimeout(e.Timeout), nba.WithBoxScoreTrackingHTTPFirst(e.HTTPFirst)))
 	case "play-by-play":
-		return e.scrapePlayByPlay(ctx)
+		stage = nbaStage(e, nba.NewPlayByPlayScraper(nba.WithPlayByPlayTimeout(e.Timeout), nba.WithPlayByPlayHTTPFirst(e.HTTPFirst)))
 	case "advanced-box-score", "advanced-box-score-q1", "advanced-box-score-q2", "advanced-box-score-q3", "advanced-box-score-q4", "advanced-box-score-h1", "advanced-box-score-h2", "advanced-box-score-ot":
-		return e.scrapeAdvancedBoxScore(ctx, e.period())
+		stage = nbaStage(e, nba.NewBoxScoreAdvancedScraper(
+			nba.WithBoxScoreAdvancedPeriod(e.period()),
+			nba.WithBoxScoreAdvancedTimeout(e.Timeout),
+			nba.WithBoxScoreAdvancedHTTPFirst(e.HTTPFirst),
+		))
 	case "traditional-box-score", "traditional-box-score-q1", "traditional-box-score-q2", "traditional-box-score-q3", "traditional-box-score-q4", "traditional-box-score-h1", "traditional-box-score-h2", "traditional-box-score-ot":
-		return e.scrapeTraditionalBoxScore(ctx, e.period())
+		stage = nbaStage(e, nba.NewBoxScoreTraditionalScraper(
+			nba.WithBoxScoreTraditionalPeriod(e.period()),
+			nba.WithBoxScoreTraditionalTimeout(e.Timeout),
+			nba.WithBoxScoreTraditionalHTTPFirst(e.HTTPFirst),
+		))
 	case "scoring-box-score", "scoring-box-score-q1", "scoring-box-score-q2", "scoring-box-score-q3", "scoring-box-score-q4", "scoring-box-score-h1", "scoring-box-score-h2", "scoring-box-score-ot":
-		return e.scrapeScoringBoxScore(ctx, e.period())
+		stage = nbaStage(e, nba.NewBoxScoreScoringScraper(
+			nba.WithBoxScoreScoringPeriod(e.period()),
+			nba.WithBoxScoreScoringTimeout(e.Timeout),
+			nba.WithBoxScoreScoringHTTPFirst(e.HTTPFirst),
+		))
 	case "usage-box-score", "usage-box-score-q1", "usage-box-score-q2", "usage-box-score-q3", "usage-box-score-q4", "usage-box-score-h1", "usage-box-score-h2", "usage-box-score-ot":
-		return e.scrapeUsageBoxScore(ctx, e.period())
+		stage = nbaStage(e, nba.NewBoxScoreUsageScraper(
+			nba.WithBoxScoreUsagePeriod(e.period()),
+			nba.WithBoxScoreUsageTimeout(e.Timeout),
+			nba.WithBoxScoreUsageHTTPFirst(e.HTTPFirst),
+		))
 	case "misc-box-score", "misc-box-score-q1", "misc-box-score-q2", "misc-box-score-q3", "misc-box-score-q4", "misc-box-score-h1", "misc-box-score-h2", "misc-box-score-ot":
-		return e.scrapeMiscBoxScore(ctx, e.period())
+		stage = nbaStage(e, nba.NewBoxScoreMiscScraper(
+			nba.WithBoxScoreMiscPeriod(e.period()),
+			nba.WithBoxScoreMiscTimeout(e.Timeout),
+			nba.WithBoxScoreMiscHTTPFirst(e.HTTPFirst),
+		))
 	case "four-factors-box-score", "four-factors-box-score-q1", "four-factors-box-score-q2", "four-factors-box-score-q3", "four-factors-box-score-q4", "four-factors-box-score-h1", "four-factors-box-score-h2", "four-factors-box-score-ot":
-		return e.scrapeFourFactorsBoxScore(ctx, e.period())
+		stage = nbaStage(e, nba.NewBoxScoreFourFactorsScraper(
+			nba.WithBoxScoreFourFactorsPeriod(e.period()),
+			nba.WithBoxScoreFourFactorsTimeout(e.Timeout),
+			nba.WithBoxScoreFourFactorsHTTPFirst(e.HTTPFirst),
+		))
 	default:
-		return fmt.Errorf("%w: %q", ErrUnsupportedFeed, e.Feed)
+		return config, fmt.Errorf("%w: %q", ErrUnsupportedFeed, e.Feed)
 	}
+	config.Stages = []runner.Stage[model.Matchup]{stage}
+	return config, nil
+}
+
+// nbaStage wraps an nba.com event data scraper in a pipeline stage exporting to the extractor's destination.
+func nbaStage[E any](e *NBAExtractor, s scraper.EventDataScraper[model.Matchup, E]) runner.Stage[model.Matchup] {
+	return runner.NewStage(
+		runner.EventDataRunnerConfig[model.Matchup, E]{
+			Concurrency: e.Concurrency,
+			Scraper:     s,
+			Checkpoint:  e.checkpoint(),
+			RawSink:     e.Raw,
+			Validation:  e.Validation,
+		},
+		exportSink[E](e.OutputPath, e.Format, exportSource(e.Export, s)),
+	)
 }
 
 // period derives the nba.Period from the feed suffix.
@@ -122,293 +207,32 @@ func (e *NBAExtractor) period() nba.Period {
 	}
 }
 
-func (e *NBAExtractor) retrieveMatchup(keepAlive bool) ([]model.Matchup, error) {
-	scraper := nba.NewMatchupScraper(
-		nba.WithMatchupDate(e.Date),
-		nba.WithMatchupTimeout(e.Timeout),
-	)
-	scraper.NetworkHeaders = nba.NetworkHeaders
-	m, err := runner.NewMatchupRunner(
-		runner.MatchupRunnerConfig[model.Matchup]{
-			Scraper:   scraper,
-			KeepAlive: keepAlive,
-		},
-	).Run()
-	if err != nil {
-		scraper.Close()
-		return m, err
-	}
-	e.matchupScraper = scraper
-	return m, err
-}
-
-func (e *NBAExtractor) scrapeMatchup(ctx context.Context) error {
-	matchups, err := e.retrieveMatchup(false)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, matchups, e.ParquetOptions...)
-}
-
-func (e *NBAExtractor) scrapeMatchupPeriods(ctx context.Context) error {
+// matchupPeriodsPipelineConfig builds the pipeline exporting the 'matchup-periods' feed.
+func (e *NBAExtractor) matchupPeriodsPipelineConfig() runner.PipelineConfig[model.MatchupPeriods] {
 	scraper := nba.NewMatchupPeriodsScraper(
 		nba.WithMatchupPeriodsDate(e.Date),
 		nba.WithMatchupPeriodsTimeout(e.Timeout),
+		nba.WithMatchupPeriodsHTTPFirst(e.HTTPFirst),
 	)
 	scraper.NetworkHeaders = nba.NetworkHeaders
-	matchups, err := runner.NewMatchupRunner(
-		runner.MatchupRunnerConfig[model.MatchupPeriods]{
-			Scraper: scraper,
-		},
-	).Run()
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, matchups, e.ParquetOptions...)
-}
-
-func (e *NBAExtractor) scrapeLiveBoxScore(ctx context.Context) error {
-	matchups, err := e.retrieveMatchup(true)
-	if err != nil {
-		return err
-	}
-	scraper := nba.NewBoxScoreLiveScraper(nba.WithBoxScoreLiveTimeout(e.Timeout))
-	scraper.DocumentRetriever = e.matchupScraper.DocumentRetriever
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.BoxScoreLive]{
-			Concurrency: e.Concurrency,
-			Scraper:     scraper,
-		},
-	).Run(matchups)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
-}
-
-func (e *NBAExtractor) scrapeAdvancedBoxScore(ctx context.Context, period nba.Period) error {
-	matchups, err := e.retrieveMatchup(true)
-	if err != nil {
-		return err
-	}
-	scraper := nba.NewBoxScoreAdvancedScraper(
-		nba.WithBoxScoreAdvancedPeriod(period),
-		nba.WithBoxScoreAdvancedTimeout(e.Timeout),
-	)
-	scraper.DocumentRetriever = e.matchupScraper.DocumentRetriever
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.BoxScoreAdvanced]{
-			Concurrency: e.Concurrency,
-			Scraper:     scraper,
-		},
-	).Run(matchups)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
-}
-
-func (e *NBAExtractor) scrapeTraditionalBoxScore(ctx context.Context, period nba.Period) error {
-	matchups, err := e.retrieveMatchup(true)
-	if err != nil {
-		return err
-	}
-	scraper := nba.NewBoxScoreTraditionalScraper(
-		nba.WithBoxScoreTraditionalPeriod(period),
-		nba.WithBoxScoreTraditionalTimeout(e.Timeout),
-	)
-	scraper.DocumentRetriever = e.matchupScraper.DocumentRetriever
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.BoxScoreTraditional]{
-			Concurrency: e.Concurrency,
-			Scraper:     scraper,
-		},
-	).Run(matchups)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
-}
-
-func (e *NBAExtractor) scrapeScoringBoxScore(ctx context.Context, period nba.Period) error {
-	matchups, err := e.retrieveMatchup(true)
-	if err != nil {
-		return err
-	}
-	scraper := nba.NewBoxScoreScoringScraper(
-		nba.WithBoxScoreScoringPeriod(period),
-		nba.WithBoxScoreScoringTimeout(e.Timeout),
-	)
-	scraper.DocumentRetriever = e.matchupScraper.DocumentRetriever
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.BoxScoreScoring]{
-			Concurrency: e.Concurrency,
-			Scraper:     scraper,
-		},
-	).Run(matchups)
-	if err != nil {
-		return err
+	e.Browser.apply(&scraper.BaseDocumentScraper)
+	return runner.PipelineConfig[model.MatchupPeriods]{
+		Scraper:     scraper,
+		MatchupSink: exportSink[model.MatchupPeriods](e.OutputPath, e.Format, exportSource(e.Export, scraper)),
 	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
 }
 
-func (e *NBAExtractor) scrapeUsageBoxScore(ctx context.Context, period nba.Period) error {
-	matchups, err := e.retrieveMatchup(true)
-	if err != nil {
-		return err
-	}
-	scraper := nba.NewBoxScoreUsageScraper(
-		nba.WithBoxScoreUsagePeriod(period),
-		nba.WithBoxScoreUsageTimeout(e.Timeout),
-	)
-	scraper.DocumentRetriever = e.matchupScraper.DocumentRetriever
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.BoxScoreUsage]{
-			Concurrency: e.Concurrency,
-			Scraper:     scraper,
-		},
-	).Run(matchups)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
-}
-
-func (e *NBAExtractor) scrapeMiscBoxScore(ctx context.Context, period nba.Period) error {
-	matchups, err := e.retrieveMatchup(true)
-	if err != nil {
-		return err
-	}
-	scraper := nba.NewBoxScoreMiscScraper(
-		nba.WithBoxScoreMiscPeriod(period),
-		nba.WithBoxScoreMiscTimeout(e.Timeout),
-	)
-	scraper.DocumentRetriever = e.matchupScraper.DocumentRetriever
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.BoxScoreMisc]{
-			Concurrency: e.Concurrency,
-			Scraper:     scraper,
-		},
-	).Run(matchups)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
-}
-
-func (e *NBAExtractor) scrapeFourFactorsBoxScore(ctx context.Context, period nba.Period) error {
-	matchups, err := e.retrieveMatchup(true)
-	if err != nil {
-		return err
-	}
-	scraper := nba.NewBoxScoreFourFactorsScraper(
-		nba.WithBoxScoreFourFactorsPeriod(period),
-		nba.WithBoxScoreFourFactorsTimeout(e.Timeout),
-	)
-	scraper.DocumentRetriever = e.matchupScraper.DocumentRetriever
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.BoxScoreFourFactors]{
-			Concurrency: e.Concurrency,
-			Scraper:     scraper,
-		},
-	).Run(matchups)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
-}
-
-func (e *NBAExtractor) scrapeHustleBoxScore(ctx context.Context) error {
-	matchups, err := e.retrieveMatchup(true)
-	if err != nil {
-		return err
-	}
-	scraper := nba.NewBoxScoreHustleScraper(nba.WithBoxScoreHustleTimeout(e.Timeout))
-	scraper.DocumentRetriever = e.matchupScraper.DocumentRetriever
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.BoxScoreHustle]{
-			Concurrency: e.Concurrency,
-			Scraper:     scraper,
-		},
-	).Run(matchups)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
-}
-
-func (e *NBAExtractor) scrapeMatchupsBoxScore(ctx context.Context) error {
-	matchups, err := e.retrieveMatchup(true)
-	if err != nil {
-		return err
-	}
-	scraper := nba.NewBoxScoreMatchupsScraper(nba.WithBoxScoreMatchupsTimeout(e.Timeout))
-	scraper.DocumentRetriever = e.matchupScraper.DocumentRetriever
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.BoxScoreMatchups]{
-			Concurrency: e.Concurrency,
-			Scraper:     scraper,
-		},
-	).Run(matchups)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
-}
-
-func (e *NBAExtractor) scrapeDefenseBoxScore(ctx context.Context) error {
-	matchups, err := e.retrieveMatchup(true)
-	if err != nil {
-		return err
-	}
-	scraper := nba.NewBoxScoreDefenseScraper(nba.WithBoxScoreDefenseTimeout(e.Timeout))
-	scraper.DocumentRetriever = e.matchupScraper.DocumentRetriever
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.BoxScoreDefense]{
-			Concurrency: e.Concurrency,
-			Scraper:     scraper,
-		},
-	).Run(matchups)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
-}
-
-func (e *NBAExtractor) scrapeTrackingBoxScore(ctx context.Context) error {
-	matchups, err := e.retrieveMatchup(true)
-	if err != nil {
-		return err
-	}
-	scraper := nba.NewBoxScoreTrackingScraper(nba.WithBoxScoreTrackingTimeout(e.Timeout))
-	scraper.DocumentRetriever = e.matchupScraper.DocumentRetriever
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.BoxScoreTracking]{
-			Concurrency: e.Concurrency,
-			Scraper:     scraper,
-		},
-	).Run(matchups)
-	if err != nil {
-		return err
-	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
-}
-
-func (e *NBAExtractor) scrapePlayByPlay(ctx context.Context) error {
-	matchups, err := e.retrieveMatchup(true)
-	if err != nil {
-		return err
+// checkpoint returns the runner checkpoint of the extractor's feed, nil without a Checkpoint store.
+func (e *NBAExtractor) checkpoint() *runner.Checkpoint[model.Matchup] {
+	if e.Checkpoint == nil {
+		return nil
 	}
-	scraper := nba.NewPlayByPlayScraper(nba.WithPlayByPlayTimeout(e.Timeout))
-	scraper.DocumentRetriever = e.matchupScraper.DocumentRetriever
-	records, err := runner.NewEventDataRunner(
-		runner.EventDataRunnerConfig[model.Matchup, model.PlayByPlay]{
-			Concurrency: e.Concurrency,
-			Scraper:     scraper,
+	return &runner.Checkpoint[model.Matchup]{
+		Store: e.Checkpoint,
+		Event: func(matchup model.Matchup) (any, bool) {
+			// 1=pregame, 2=in progress, 3=final
+			return matchup.EventID, matchup.EventStatus == 3
 		},
-	).Run(matchups)
-	if err != nil {
-		return err
+		Period: e.period().Period(),
 	}
-	return exporters.BuildAndWrite(ctx, e.OutputPath, e.Format, e.S3Config, records, e.ParquetOptions...)
 }
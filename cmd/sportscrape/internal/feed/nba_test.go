@@ -3,7 +3,12 @@
 package feed
 
 import (
+	"path/filepath"
 	"testing"
+
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba"
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/runner"
 )
 
 func TestNBAExtractorValidateFeed(t *testing.T) {
@@ -45,7 +50,8 @@ func TestNBAExtractorValidateFeed(t *testing.T) {
 		// invalid feed
 		{name: "unsupported feed", feed: "invalid-feed", format: "jsonl", wantErr: true},
 		// invalid format
-		{name: "unsupported format", feed: "matchup", format: "csv", wantErr: true},
+		{name: "csv format", feed: "matchup", format: "csv"},
+		{name: "unsupported format", feed: "matchup", format: "xml", wantErr: true},
 		// empty
 		{name: "empty feed", feed: "", format: "jsonl", wantErr: true},
 		{name: "empty format", feed: "matchup", format: "", wantErr: true},
@@ -64,3 +70,41 @@ func TestNBAExtractorValidateFeed(t *testing.T) {
 		})
 	}
 }
+
+func TestNBAExtractorCheckpoint(t *testing.T) {
+	if (&NBAExtractor{Feed: "advanced-box-score-q1"}).checkpoint() != nil {
+		t.Error("checkpoint() without a Checkpoint store should be nil")
+	}
+	store, err := runner.OpenFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	checkpoint := (&NBAExtractor{Feed: "advanced-box-score-q1", Checkpoint: store}).checkpoint()
+	if checkpoint.Period != "Q1" {
+		t.Errorf("Period = %q, want %q", checkpoint.Period, "Q1")
+	}
+	for status, wantFinal := range map[int32]bool{1: false, 2: false, 3: true} {
+		eventID, final := checkpoint.Event(model.Matchup{EventID: "0022500249", EventStatus: status})
+		if eventID != "0022500249" || final != wantFinal {
+			t.Errorf("Event() with status %d = (%v, %v), want (0022500249, %v)", status, eventID, final, wantFinal)
+		}
+	}
+}
+
+func TestNBAExtractorHTTPFirst(t *testing.T) {
+	for _, httpFirst := range []bool{false, true} {
+		e := &NBAExtractor{Feed: "play-by-play", Date: "2025-11-19", HTTPFirst: httpFirst}
+		config, err := e.pipelineConfig()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := config.Scraper.(*nba.MatchupScraper).HTTPFirst; got != httpFirst {
+			t.Errorf("matchup scraper HTTPFirst = %v, want %v", got, httpFirst)
+		}
+		periods := e.matchupPeriodsPipelineConfig()
+		if got := periods.Scraper.(*nba.MatchupPeriodsScraper).HTTPFirst; got != httpFirst {
+			t.Errorf("matchup periods scraper HTTPFirst = %v, want %v", got, httpFirst)
+		}
+	}
+}
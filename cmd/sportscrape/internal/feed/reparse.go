@@ -0,0 +1,175 @@
+package feed
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/lightning-dabbler/sportscrape"
+	"github.com/lightning-dabbler/sportscrape/cmd/sportscrape/internal/exporters"
+
+	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb"
+	"github.com/lightning-dabbler/sportscrape/dataprovider/espn/mma"
+	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports"
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba"
+	"github.com/lightning-dabbler/sportscrape/runner"
+	"github.com/lightning-dabbler/sportscrape/scraper"
+)
+
+// ReparseExtractor re-derives the records of a feed from the payloads archived
+// by --raw-destination, without fetching anything.
+type ReparseExtractor struct {
+	// Feed is the archived feed to reparse, as named in the archive keys
+	// (e.g. nba-play-by-play) or as a sportscrape.Feed (e.g. "nba play by play").
+	Feed string
+	// Source is the archive: a local directory or an s3://bucket/prefix.
+	Source     string
+	OutputPath string
+	Format     string
+	// Export configures the exporter of OutputPath.
+	Export exporters.Options
+}
+
+func (e *ReparseExtractor) ValidateFeed() error {
+	if err := exporters.ValidateFormat(e.Format); err != nil {
+		return err
+	}
+	if _, ok := e.reparser(); !ok {
+		return fmt.Errorf("%w %q for reparse, valid options: %s", ErrUnsupportedFeed, e.Feed, ReparseOptions())
+	}
+	return nil
+}
+
+func (e *ReparseExtractor) Scrape(ctx context.Context) error {
+	r, ok := e.reparser()
+	if !ok {
+		return fmt.Errorf("%w %q for reparse, valid options: %s", ErrUnsupportedFeed, e.Feed, ReparseOptions())
+	}
+	return r.run(ctx, e)
+}
+
+// reparser returns the reparser of the extractor's feed.
+func (e *ReparseExtractor) reparser() (reparser, bool) {
+	for _, r := range reparsers() {
+		if e.Feed == string(r.feed) || e.Feed == runner.RawKeySegment(string(r.feed)) {
+			return r, true
+		}
+	}
+	return reparser{}, false
+}
+
+// ReparseOptions lists the feeds that can be reparsed, as named in the archive keys.
+func ReparseOptions() string {
+	var options []string
+	for _, r := range reparsers() {
+		options = append(options, "'"+runner.RawKeySegment(string(r.feed))+"'")
+	}
+	slices.Sort(options)
+	return strings.Join(options, ", ")
+}
+
+// reparser re-derives the records of feed from an archive and exports them.
+type reparser struct {
+	feed sportscrape.Feed
+	run  func(ctx context.Context, e *ReparseExtractor) error
+}
+
+// feedParser is an event data parser of a single feed.
+type feedParser[M, E any] interface {
+	scraper.EventDataParser[M, E]
+	Feed() sportscrape.Feed
+	Provider() sportscrape.Provider
+}
+
+// newReparser returns the reparser of p's feed. Each archived event is parsed
+// from the last payload of its latest pull, the one its current records were
+// built from, so events archived by several runs are exported once; events
+// failing to parse are reported once the rest are exported.
+func newReparser[M, E any](p feedParser[M, E]) reparser {
+	feed := p.Feed()
+	return reparser{
+		feed: feed,
+		run: func(ctx context.Context, e *ReparseExtractor) error {
+			latest := map[string]runner.RawEvent{}
+			pulls := 0
+			err := exporters.ReadRaw(ctx, e.Source, e.Export.S3, func(event runner.RawEvent) error {
+				if event.Feed != feed || len(event.Payloads) == 0 {
+					return nil
+				}
+				pulls++
+				if previous, ok := latest[event.EventID]; !ok || event.PullTimestamp.After(previous.PullTimestamp) {
+					latest[event.EventID] = event
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			var records []E
+			var errs []error
+			for _, eventID := range slices.Sorted(maps.Keys(latest)) {
+				event := latest[eventID]
+				var matchup M
+				if err := json.Unmarshal(event.Matchup, &matchup); err != nil {
+					errs = append(errs, fmt.Errorf("decode matchup of %s: %w", event.Key(), err))
+					continue
+				}
+				payload := event.Payloads[len(event.Payloads)-1]
+				parsed, err := p.Parse(matchup, scraper.Payload{URL: payload.URL, PullTimestamp: event.PullTimestamp, Body: []byte(payload.Body)})
+				if err != nil {
+					errs = append(errs, fmt.Errorf("parse %s: %w", event.Key(), err))
+					continue
+				}
+				records = append(records, parsed...)
+			}
+			events := len(latest)
+			slog.Info("Archived events reparsed", "feed", feed, "events", events, "superseded", pulls-events, "failed", len(errs), "records", len(records))
+			if err := exportSink[E](e.OutputPath, e.Format, exportSource(e.Export, p))(ctx, records); err != nil {
+				return err
+			}
+			return errors.Join(errs...)
+		},
+	}
+}
+
+// reparsers returns a reparser for every event data feed.
+func reparsers() []reparser {
+	r := []reparser{
+		newReparser(nba.NewBoxScoreLiveScraper()),
+		newReparser(nba.NewBoxScoreHustleScraper()),
+		newReparser(nba.NewBoxScoreMatchupsScraper()),
+		newReparser(nba.NewBoxScoreDefenseScraper()),
+		newReparser(nba.NewBoxScoreTrackingScraper()),
+		newReparser(nba.NewPlayByPlayScraper()),
+		newReparser(foxsports.NewMLBBattingBoxScoreScraper()),
+		newReparser(foxsports.NewMLBPitchingBoxScoreScraper()),
+		newReparser(foxsports.NewMLBProbableStartingPitcherScraper()),
+		newReparser(foxsports.NewMLBOddsTotalScraper()),
+		newReparser(foxsports.NewMLBOddsMoneyLineScraper()),
+		newReparser(foxsports.NewNBABoxScoreScraper(foxsports.NBABoxScoreScraperLeague(foxsports.NBA))),
+		newReparser(foxsports.NewNBABoxScoreScraper(foxsports.NBABoxScoreScraperLeague(foxsports.WNBA))),
+		newReparser(baseballsavantmlb.NewPitchingBoxScoreScraper()),
+		newReparser(baseballsavantmlb.NewBattingBoxScoreScraper()),
+		newReparser(baseballsavantmlb.NewFieldingBoxScoreScraper()),
+		newReparser(baseballsavantmlb.NewPlayByPlayScraper()),
+		newReparser(&mma.ESPNMMAFightDetailsScraper{League: "ufc"}),
+		newReparser(&mma.ESPNMMAFightDetailsScraper{League: "pfl"}),
+	}
+	for _, period := range []nba.Period{nba.Full, nba.Q1, nba.Q2, nba.Q3, nba.Q4, nba.H1, nba.H2, nba.AllOT} {
+		r = append(r,
+			newReparser(nba.NewBoxScoreAdvancedScraper(nba.WithBoxScoreAdvancedPeriod(period))),
+			newReparser(nba.NewBoxScoreTraditionalScraper(nba.WithBoxScoreTraditionalPeriod(period))),
+			newReparser(nba.NewBoxScoreScoringScraper(nba.WithBoxScoreScoringPeriod(period))),
+			newReparser(nba.NewBoxScoreUsageScraper(nba.WithBoxScoreUsagePeriod(period))),
+			newReparser(nba.NewBoxScoreMiscScraper(nba.WithBoxScoreMiscPeriod(period))),
+			newReparser(nba.NewBoxScoreFourFactorsScraper(nba.WithBoxScoreFourFactorsPeriod(period))),
+		)
+	}
+	return r
+}
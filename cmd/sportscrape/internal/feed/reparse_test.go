@@ -0,0 +1,109 @@
+//go:build unit
+
+package feed
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape"
+	"github.com/lightning-dabbler/sportscrape/cmd/sportscrape/internal/exporters"
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba"
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/runner"
+)
+
+func TestReparseExtractorValidateFeed(t *testing.T) {
+	tests := []struct {
+		name    string
+		feed    string
+		format  string
+		wantErr bool
+	}{
+		{name: "key segment", feed: "nba-play-by-play", format: "jsonl"},
+		{name: "sportscrape feed", feed: string(sportscrape.NBAPlayByPlay), format: "parquet"},
+		{name: "period feed", feed: "nba-q1-advanced-box-score", format: "jsonl"},
+		{name: "fox sports", feed: "fox-sports-wnba-box-score", format: "jsonl"},
+		{name: "baseball savant", feed: "baseball-savant-mlb-play-by-play", format: "jsonl"},
+		{name: "espn", feed: "espn-mma-ufc-fight-details", format: "jsonl"},
+		{name: "matchup feed", feed: "nba-matchup", format: "jsonl", wantErr: true},
+		{name: "empty feed", feed: "", format: "jsonl", wantErr: true},
+		{name: "unsupported format", feed: "nba-play-by-play", format: "xml", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &ReparseExtractor{Feed: tt.feed, Format: tt.format}
+			err := e.ValidateFeed()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateFeed() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReparseExtractorScrape(t *testing.T) {
+	ctx := context.Background()
+	source := t.TempDir()
+	sink, err := exporters.NewRawSink(source, exporters.S3Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pulled := time.Date(2025, 6, 12, 4, 0, 0, 0, time.UTC)
+	archive := func(feed sportscrape.Feed, eventID string, pulled time.Time, bodies ...string) {
+		matchup, err := json.Marshal(model.Matchup{EventID: eventID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		event := runner.RawEvent{Provider: sportscrape.NBA, Feed: feed, EventID: eventID, PullTimestamp: pulled, Matchup: matchup}
+		for _, body := range bodies {
+			event.Payloads = append(event.Payloads, runner.RawPayload{URL: "https://www.nba.com/game/" + eventID, Body: body})
+		}
+		if err := sink.WriteRaw(ctx, event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	page := `<script id="__NEXT_DATA__">{"props":{"pageProps":{"playByPlay":{"actions":[{"actionNumber":1,"clock":"PT12M00.00S"},{"actionNumber":2,"clock":"PT11M39.00S"}]}}}}</script>`
+	// The first payload of an HTTP-first scrape that fell back to the browser holds no data
+	archive(sportscrape.NBAPlayByPlay, "0042400403", pulled, `<div id="__next"></div>`, page)
+	archive(sportscrape.NBAPlayByPlay, "0042400404", pulled, `<div id="__next"></div>`)
+	archive(sportscrape.NBALiveBoxScore, "0042400403", pulled, page)
+	// An earlier run pulled the game in progress; only the latest pull is reparsed
+	inProgress := `<script id="__NEXT_DATA__">{"props":{"pageProps":{"playByPlay":{"actions":[{"actionNumber":1,"clock":"PT12M00.00S"}]}}}}</script>`
+	archive(sportscrape.NBAPlayByPlay, "0042400403", pulled.Add(-time.Hour), inProgress)
+
+	destination := filepath.Join(t.TempDir(), "pbp.jsonl")
+	e := &ReparseExtractor{Feed: "nba-play-by-play", Source: source, OutputPath: destination, Format: "jsonl"}
+	err = e.Scrape(ctx)
+	if !errors.Is(err, nba.ErrMissingNextData) {
+		t.Errorf("Scrape() error = %v, want %v for event 0042400404", err, nba.ErrMissingNextData)
+	}
+
+	f, err := os.Open(destination)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var records []model.PlayByPlay
+	lines := bufio.NewScanner(f)
+	for lines.Scan() {
+		var record model.PlayByPlay
+		if err := json.Unmarshal(lines.Bytes(), &record); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, record)
+	}
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want 2", len(records))
+	}
+	for i, record := range records {
+		if record.EventID != "0042400403" || record.ActionNumber != int32(i+1) || !record.PullTimestamp.Equal(pulled) {
+			t.Errorf("record %d = %+v", i, record)
+		}
+	}
+}
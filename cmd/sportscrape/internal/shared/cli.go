@@ -1,15 +1,24 @@
 package shared
 
 import (
-	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 
+	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/cmd/sportscrape/internal/exporters"
 
 	"github.com/lightning-dabbler/sportscrape/cmd/sportscrape/internal/feed"
+	"github.com/lightning-dabbler/sportscrape/runner"
+	"github.com/lightning-dabbler/sportscrape/util/ratelimit"
+	"github.com/lightning-dabbler/sportscrape/util/request"
+	"github.com/lightning-dabbler/sportscrape/validate"
 
 	"github.com/spf13/cobra"
 	"github.com/xitongsys/parquet-go/parquet"
@@ -18,18 +27,7 @@ import (
 func Run(cmd *cobra.Command, provider, league string) error {
 	start := time.Now().UTC()
 	// --destination
-	destination, err := cmd.Flags().GetString("destination")
-	if err != nil {
-		return err
-	}
-	if destination == "" {
-		return fmt.Errorf("--destination is required and cannot be empty")
-	}
-	parsedDestination, err := url.Parse(destination)
-	if err != nil {
-		return err
-	}
-	err = exporters.SupportedDestination(parsedDestination)
+	destination, err := destinationFlag(cmd)
 	if err != nil {
 		return err
 	}
@@ -39,62 +37,79 @@ func Run(cmd *cobra.Command, provider, league string) error {
 	if err != nil {
 		return err
 	}
+	if err := exporters.ValidateDestinationFormat(destination, fileFormat); err != nil {
+		return err
+	}
 
-	// --parquet-compression
-	parquetCompression, err := cmd.Flags().GetString("parquet-compression")
+	// --parquet-* / --csv-* / --avro-codec / --arrow-compression / --kafka-* / --aws-region / --aws-endpoint
+	exportOptions, err := exportFlags(cmd, fileFormat)
 	if err != nil {
 		return err
 	}
-	compression, err := parquet.CompressionCodecFromString(parquetCompression)
+
+	// --feed
+	rawFeed, err := cmd.Flags().GetString("feed")
 	if err != nil {
 		return err
 	}
 
-	// --parquet-row-group-size
-	parquetRowGroupSize, err := cmd.Flags().GetInt64("parquet-row-group-size")
+	// --concurrency
+	concurrency, err := cmd.Flags().GetInt("concurrency")
 	if err != nil {
 		return err
 	}
 
-	// --parquet-page-size
-	parquetPageSize, err := cmd.Flags().GetInt64("parquet-page-size")
-	if err != nil {
+	// --rate-limit / --rate-burst
+	if err := applyRateLimit(cmd, provider); err != nil {
 		return err
 	}
 
-	// --parquet-write-parallelism
-	parquetWriteParallelism, err := cmd.Flags().GetInt64("parquet-write-parallelism")
+	// --proxy
+	proxy, err := proxyFlag(cmd)
 	if err != nil {
 		return err
 	}
+	if proxy != nil {
+		// Keep the defaults of request.DefaultClient: Go's User-Agent and no timeout
+		client := request.NewClient(request.WithProxy(proxy), request.WithClientTimeout(0))
+		client.Header.Del("User-Agent")
+		request.DefaultClient = client
+	}
 
-	// --feed
-	rawFeed, err := cmd.Flags().GetString("feed")
+	// --metrics-file
+	metricsFile, err := cmd.Flags().GetString("metrics-file")
 	if err != nil {
 		return err
 	}
+	var metrics *runner.Metrics
+	var observer runner.Observer
+	if metricsFile != "" {
+		metrics = runner.NewMetrics()
+		observer = metrics
+	}
 
-	// --concurrency
-	concurrency, err := cmd.Flags().GetInt("concurrency")
+	// --raw-destination
+	rawDestination, err := cmd.Flags().GetString("raw-destination")
 	if err != nil {
 		return err
 	}
 
-	// s3 arguments
-
-	// aws-region
-	awsRegion, err := cmd.Flags().GetString("aws-region")
+	// --validate
+	rawValidate, err := cmd.Flags().GetString("validate")
 	if err != nil {
 		return err
 	}
-	// aws-endpoint
-	awsEndpoint, err := cmd.Flags().GetString("aws-endpoint")
+	validation, err := validate.ParseMode(rawValidate)
 	if err != nil {
 		return err
 	}
 
 	var date, year, feedstring string
 	var timeoutDuration time.Duration
+	var backfill feed.Backfill
+	var browser feed.Browser
+	var httpFirst bool
+	var checkpoint *runner.FileCheckpointStore
 
 	switch provider {
 	case "espn":
@@ -112,6 +127,19 @@ func Run(cmd *cobra.Command, provider, league string) error {
 		if err != nil {
 			return err
 		}
+		// --start-date / --end-date / --backfill-concurrency
+		backfill, err = backfillFlags(cmd, date)
+		if err != nil {
+			return err
+		}
+		// --resume / --checkpoint-file
+		checkpoint, err = resumeFlags(cmd)
+		if err != nil {
+			return err
+		}
+		if checkpoint != nil {
+			defer checkpoint.Close()
+		}
 	}
 
 	switch provider {
@@ -122,6 +150,19 @@ func Run(cmd *cobra.Command, provider, league string) error {
 			return err
 		}
 		timeoutDuration = time.Duration(timeout) * time.Second
+		// --chrome-path / --chrome-flag / --no-sandbox
+		browser, err = browserFlags(cmd)
+		if err != nil {
+			return err
+		}
+		browser.Proxy = proxy
+	}
+	if provider == "nba" {
+		// --http-first
+		httpFirst, err = cmd.Flags().GetBool("http-first")
+		if err != nil {
+			return err
+		}
 	}
 
 	switch league {
@@ -137,66 +178,74 @@ func Run(cmd *cobra.Command, provider, league string) error {
 		feedstring = rawFeed
 	}
 
-	// parquet options
-
-	parquetOptions := []exporters.ParquetConfigOption{
-		exporters.WithCompressionType(compression),
-		exporters.WithRowGroupSize(parquetRowGroupSize),
-		exporters.WithPageSize(parquetPageSize),
-		exporters.WithParallelism(parquetWriteParallelism),
-	}
-
-	if fileFormat == "parquet" {
-		slog.Debug("Parquet config", "compression_type", parquetCompression, "row_group_size", parquetRowGroupSize, "page_size", parquetPageSize, "write_parallelism", parquetWriteParallelism)
-	}
-
-	s3config := exporters.S3Config{
-		Endpoint: awsEndpoint,
-		Region:   awsRegion,
+	var rawSink runner.RawSink
+	if rawDestination != "" {
+		sink, err := exporters.NewRawSink(rawDestination, exportOptions.S3)
+		if err != nil {
+			return err
+		}
+		rawSink = sink
 	}
 	var e feed.ProviderExtractor
 	switch provider {
 	case "foxsports":
 		e = &feed.FoxSportsExtractor{
-			Feed:           feedstring,
-			Date:           date,
-			Concurrency:    concurrency,
-			OutputPath:     destination,
-			Format:         fileFormat,
-			S3Config:       s3config,
-			ParquetOptions: parquetOptions,
+			Feed:        feedstring,
+			Date:        date,
+			Concurrency: concurrency,
+			OutputPath:  destination,
+			Format:      fileFormat,
+			Export:      exportOptions,
+			Observer:    observer,
+			Raw:         rawSink,
+			Validation:  validation,
+			Backfill:    backfill,
+			Checkpoint:  checkpointStore(checkpoint),
 		}
 	case "baseballsavant":
 		e = &feed.BaseballSavantExtractor{
-			Feed:           feedstring,
-			Date:           date,
-			Concurrency:    concurrency,
-			OutputPath:     destination,
-			Format:         fileFormat,
-			S3Config:       s3config,
-			ParquetOptions: parquetOptions,
+			Feed:        feedstring,
+			Date:        date,
+			Concurrency: concurrency,
+			OutputPath:  destination,
+			Format:      fileFormat,
+			Export:      exportOptions,
+			Observer:    observer,
+			Raw:         rawSink,
+			Validation:  validation,
+			Backfill:    backfill,
+			Checkpoint:  checkpointStore(checkpoint),
 		}
 	case "espn":
 		e = &feed.ESPNMMAExtractor{
-			Feed:           feedstring,
-			Year:           year,
-			Timeout:        timeoutDuration,
-			Concurrency:    concurrency,
-			OutputPath:     destination,
-			Format:         fileFormat,
-			S3Config:       s3config,
-			ParquetOptions: parquetOptions,
+			Feed:        feedstring,
+			Year:        year,
+			Timeout:     timeoutDuration,
+			Concurrency: concurrency,
+			OutputPath:  destination,
+			Format:      fileFormat,
+			Export:      exportOptions,
+			Observer:    observer,
+			Raw:         rawSink,
+			Validation:  validation,
+			Browser:     browser,
 		}
 	case "nba":
 		e = &feed.NBAExtractor{
-			Feed:           feedstring,
-			Date:           date,
-			Timeout:        timeoutDuration,
-			Concurrency:    concurrency,
-			OutputPath:     destination,
-			Format:         fileFormat,
-			S3Config:       s3config,
-			ParquetOptions: parquetOptions,
+			Feed:        feedstring,
+			Date:        date,
+			Timeout:     timeoutDuration,
+			Concurrency: concurrency,
+			OutputPath:  destination,
+			Format:      fileFormat,
+			Export:      exportOptions,
+			Observer:    observer,
+			Raw:         rawSink,
+			Validation:  validation,
+			Browser:     browser,
+			HTTPFirst:   httpFirst,
+			Backfill:    backfill,
+			Checkpoint:  checkpointStore(checkpoint),
 		}
 	default:
 		return fmt.Errorf("unsupported provider %s", provider)
@@ -206,7 +255,16 @@ func Run(cmd *cobra.Command, provider, league string) error {
 		return err
 	}
 
-	err = e.Scrape(context.TODO())
+	// Cancel in-flight requests and browser tabs on SIGINT/SIGTERM
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	err = e.Scrape(ctx)
+	if metrics != nil {
+		// Written even when the scrape failed so failures are exported too
+		if werr := metrics.WriteFile(metricsFile); werr != nil {
+			err = errors.Join(err, fmt.Errorf("writing metrics to %s: %w", metricsFile, werr))
+		}
+	}
 	if err != nil {
 		return err
 	}
@@ -215,3 +273,435 @@ func Run(cmd *cobra.Command, provider, league string) error {
 	slog.Info("Data extraction complete", "duration", diff)
 	return nil
 }
+
+// Reparse rebuilds the records of --feed from the payloads archived under
+// --source and exports them like Run.
+func Reparse(cmd *cobra.Command) error {
+	start := time.Now().UTC()
+	// --destination
+	destination, err := destinationFlag(cmd)
+	if err != nil {
+		return err
+	}
+
+	// --file-format
+	fileFormat, err := cmd.Flags().GetString("file-format")
+	if err != nil {
+		return err
+	}
+	if err := exporters.ValidateDestinationFormat(destination, fileFormat); err != nil {
+		return err
+	}
+
+	// --parquet-* / --csv-* / --avro-codec / --arrow-compression / --kafka-* / --aws-region / --aws-endpoint
+	exportOptions, err := exportFlags(cmd, fileFormat)
+	if err != nil {
+		return err
+	}
+
+	// --feed
+	rawFeed, err := cmd.Flags().GetString("feed")
+	if err != nil {
+		return err
+	}
+
+	// --source
+	source, err := cmd.Flags().GetString("source")
+	if err != nil {
+		return err
+	}
+	if source == "" {
+		return fmt.Errorf("--source is required and cannot be empty")
+	}
+
+	e := &feed.ReparseExtractor{
+		Feed:       rawFeed,
+		Source:     source,
+		OutputPath: destination,
+		Format:     fileFormat,
+		Export:     exportOptions,
+	}
+	if err := e.ValidateFeed(); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err := e.Scrape(ctx); err != nil {
+		return err
+	}
+
+	diff := time.Now().UTC().Sub(start)
+	slog.Info("Reparse complete", "duration", diff)
+	return nil
+}
+
+// destinationFlag reads --destination, which must be a supported destination.
+func destinationFlag(cmd *cobra.Command) (string, error) {
+	destination, err := cmd.Flags().GetString("destination")
+	if err != nil {
+		return "", err
+	}
+	if destination == "" {
+		return "", fmt.Errorf("--destination is required and cannot be empty")
+	}
+	parsedDestination, err := url.Parse(destination)
+	if err != nil {
+		return "", err
+	}
+	return destination, exporters.SupportedDestination(parsedDestination)
+}
+
+// exportFlags reads the flags configuring the exporter of the destination.
+func exportFlags(cmd *cobra.Command, fileFormat string) (exporters.Options, error) {
+	parquetOptions, err := parquetFlags(cmd, fileFormat)
+	if err != nil {
+		return exporters.Options{}, err
+	}
+	csvOptions, err := csvFlags(cmd)
+	if err != nil {
+		return exporters.Options{}, err
+	}
+	avroOptions, err := avroFlags(cmd)
+	if err != nil {
+		return exporters.Options{}, err
+	}
+	arrowOptions, err := arrowFlags(cmd)
+	if err != nil {
+		return exporters.Options{}, err
+	}
+	kafkaOptions, err := kafkaFlags(cmd)
+	if err != nil {
+		return exporters.Options{}, err
+	}
+	s3config, err := s3Flags(cmd)
+	if err != nil {
+		return exporters.Options{}, err
+	}
+	return exporters.Options{
+		S3:      s3config,
+		Parquet: parquetOptions,
+		CSV:     csvOptions,
+		Avro:    avroOptions,
+		Arrow:   arrowOptions,
+		Kafka:   kafkaOptions,
+	}, nil
+}
+
+// parquetFlags reads the parquet writer flags.
+func parquetFlags(cmd *cobra.Command, fileFormat string) ([]exporters.ParquetConfigOption, error) {
+	parquetCompression, err := cmd.Flags().GetString("parquet-compression")
+	if err != nil {
+		return nil, err
+	}
+	compression, err := parquet.CompressionCodecFromString(parquetCompression)
+	if err != nil {
+		return nil, err
+	}
+	parquetRowGroupSize, err := cmd.Flags().GetInt64("parquet-row-group-size")
+	if err != nil {
+		return nil, err
+	}
+	parquetPageSize, err := cmd.Flags().GetInt64("parquet-page-size")
+	if err != nil {
+		return nil, err
+	}
+	parquetWriteParallelism, err := cmd.Flags().GetInt64("parquet-write-parallelism")
+	if err != nil {
+		return nil, err
+	}
+	if fileFormat == "parquet" {
+		slog.Debug("Parquet config", "compression_type", parquetCompression, "row_group_size", parquetRowGroupSize, "page_size", parquetPageSize, "write_parallelism", parquetWriteParallelism)
+	}
+	return []exporters.ParquetConfigOption{
+		exporters.WithCompressionType(compression),
+		exporters.WithRowGroupSize(parquetRowGroupSize),
+		exporters.WithPageSize(parquetPageSize),
+		exporters.WithParallelism(parquetWriteParallelism),
+	}, nil
+}
+
+// csvFlags reads the csv writer flags.
+func csvFlags(cmd *cobra.Command) ([]exporters.CSVConfigOption, error) {
+	rawDelimiter, err := cmd.Flags().GetString("csv-delimiter")
+	if err != nil {
+		return nil, err
+	}
+	// A literal tab is awkward to pass in most shells
+	if rawDelimiter == `\t` {
+		rawDelimiter = "\t"
+	}
+	delimiter, err := exporters.ValidateDelimiter(rawDelimiter)
+	if err != nil {
+		return nil, err
+	}
+	quoteAll, err := cmd.Flags().GetBool("csv-quote-all")
+	if err != nil {
+		return nil, err
+	}
+	return []exporters.CSVConfigOption{
+		exporters.WithDelimiter(delimiter),
+		exporters.WithQuoteAll(quoteAll),
+	}, nil
+}
+
+// avroFlags reads the avro writer flags.
+func avroFlags(cmd *cobra.Command) ([]exporters.AvroConfigOption, error) {
+	rawCodec, err := cmd.Flags().GetString("avro-codec")
+	if err != nil {
+		return nil, err
+	}
+	codec, err := exporters.ParseAvroCodec(rawCodec)
+	if err != nil {
+		return nil, err
+	}
+	return []exporters.AvroConfigOption{exporters.WithAvroCodec(codec)}, nil
+}
+
+// kafkaFlags reads the kafka publisher flags.
+func kafkaFlags(cmd *cobra.Command) ([]exporters.KafkaConfigOption, error) {
+	batchSize, err := cmd.Flags().GetInt("kafka-batch-size")
+	if err != nil {
+		return nil, err
+	}
+	if batchSize < 1 {
+		return nil, fmt.Errorf("--kafka-batch-size must be at least 1, got %d", batchSize)
+	}
+	batchTimeout, err := cmd.Flags().GetDuration("kafka-batch-timeout")
+	if err != nil {
+		return nil, err
+	}
+	rawAcks, err := cmd.Flags().GetString("kafka-acks")
+	if err != nil {
+		return nil, err
+	}
+	acks, err := exporters.ParseKafkaAcks(rawAcks)
+	if err != nil {
+		return nil, err
+	}
+	rawCompression, err := cmd.Flags().GetString("kafka-compression")
+	if err != nil {
+		return nil, err
+	}
+	compression, err := exporters.ParseKafkaCompression(rawCompression)
+	if err != nil {
+		return nil, err
+	}
+	return []exporters.KafkaConfigOption{
+		exporters.WithKafkaBatchSize(batchSize),
+		exporters.WithKafkaBatchTimeout(batchTimeout),
+		exporters.WithKafkaAcks(acks),
+		exporters.WithKafkaCompression(compression),
+	}, nil
+}
+
+// arrowFlags reads the arrow writer flags.
+func arrowFlags(cmd *cobra.Command) ([]exporters.ArrowConfigOption, error) {
+	rawCompression, err := cmd.Flags().GetString("arrow-compression")
+	if err != nil {
+		return nil, err
+	}
+	compression, err := exporters.ParseArrowCompression(rawCompression)
+	if err != nil {
+		return nil, err
+	}
+	return []exporters.ArrowConfigOption{exporters.WithArrowCompression(compression)}, nil
+}
+
+// s3Flags reads the AWS flags.
+func s3Flags(cmd *cobra.Command) (exporters.S3Config, error) {
+	awsRegion, err := cmd.Flags().GetString("aws-region")
+	if err != nil {
+		return exporters.S3Config{}, err
+	}
+	awsEndpoint, err := cmd.Flags().GetString("aws-endpoint")
+	if err != nil {
+		return exporters.S3Config{}, err
+	}
+	return exporters.S3Config{
+		Endpoint: awsEndpoint,
+		Region:   awsRegion,
+	}, nil
+}
+
+// backfillFlags reads the --start-date/--end-date range, which replaces --date.
+func backfillFlags(cmd *cobra.Command, date string) (feed.Backfill, error) {
+	var backfill feed.Backfill
+	if cmd.Flags().Lookup("start-date") == nil {
+		return backfill, nil
+	}
+	startDate, err := cmd.Flags().GetString("start-date")
+	if err != nil {
+		return backfill, err
+	}
+	endDate, err := cmd.Flags().GetString("end-date")
+	if err != nil {
+		return backfill, err
+	}
+	concurrency, err := cmd.Flags().GetInt("backfill-concurrency")
+	if err != nil {
+		return backfill, err
+	}
+	if startDate == "" && endDate == "" {
+		return backfill, nil
+	}
+	if startDate == "" || endDate == "" {
+		return backfill, fmt.Errorf("--start-date and --end-date must be set together")
+	}
+	if date != "" {
+		return backfill, fmt.Errorf("--date cannot be combined with --start-date/--end-date")
+	}
+	if _, err := runner.DateRange(startDate, endDate); err != nil {
+		return backfill, err
+	}
+	backfill = feed.Backfill{StartDate: startDate, EndDate: endDate, Concurrency: concurrency}
+	slog.Debug("Backfill", "start_date", startDate, "end_date", endDate, "concurrency", concurrency)
+	return backfill, nil
+}
+
+// resumeFlags opens the --checkpoint-file store when --resume is set.
+func resumeFlags(cmd *cobra.Command) (*runner.FileCheckpointStore, error) {
+	if cmd.Flags().Lookup("resume") == nil {
+		return nil, nil
+	}
+	resume, err := cmd.Flags().GetBool("resume")
+	if err != nil || !resume {
+		return nil, err
+	}
+	checkpointFile, err := cmd.Flags().GetString("checkpoint-file")
+	if err != nil {
+		return nil, err
+	}
+	if checkpointFile == "" {
+		return nil, fmt.Errorf("--checkpoint-file is required with --resume")
+	}
+	slog.Debug("Resume", "checkpoint_file", checkpointFile)
+	return runner.OpenFileCheckpointStore(checkpointFile)
+}
+
+// checkpointStore keeps a nil *runner.FileCheckpointStore from becoming a non-nil runner.CheckpointStore.
+func checkpointStore(store *runner.FileCheckpointStore) runner.CheckpointStore {
+	if store == nil {
+		return nil
+	}
+	return store
+}
+
+// proxyFlag parses --proxy, nil when unset.
+func proxyFlag(cmd *cobra.Command) (*url.URL, error) {
+	if cmd.Flags().Lookup("proxy") == nil {
+		return nil, nil
+	}
+	rawProxy, err := cmd.Flags().GetString("proxy")
+	if err != nil || rawProxy == "" {
+		return nil, err
+	}
+	proxy, err := request.ParseProxy(rawProxy)
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("Proxy", "proxy", proxy.Redacted())
+	return proxy, nil
+}
+
+// browserFlags reads the Chrome launch flags.
+func browserFlags(cmd *cobra.Command) (feed.Browser, error) {
+	var browser feed.Browser
+	if cmd.Flags().Lookup("chrome-path") == nil {
+		return browser, nil
+	}
+	chromePath, err := cmd.Flags().GetString("chrome-path")
+	if err != nil {
+		return browser, err
+	}
+	chromeFlags, err := cmd.Flags().GetStringArray("chrome-flag")
+	if err != nil {
+		return browser, err
+	}
+	noSandbox, err := cmd.Flags().GetBool("no-sandbox")
+	if err != nil {
+		return browser, err
+	}
+	maxTabs, err := cmd.Flags().GetInt("max-tabs")
+	if err != nil {
+		return browser, err
+	}
+	reuseTabs, err := cmd.Flags().GetBool("reuse-tabs")
+	if err != nil {
+		return browser, err
+	}
+	browser.MaxTabs = maxTabs
+	browser.ReuseTabs = reuseTabs
+	browser.ChromePath = chromePath
+	browser.NoSandbox = noSandbox
+	browser.ChromeFlags = parseChromeFlags(chromeFlags)
+	return browser, nil
+}
+
+// parseChromeFlags parses name[=value] flags: a missing value, true and false
+// are booleans, any other value is passed as is.
+func parseChromeFlags(flags []string) map[string]any {
+	if len(flags) == 0 {
+		return nil
+	}
+	parsed := map[string]any{}
+	for _, flag := range flags {
+		name, value, found := strings.Cut(strings.TrimLeft(flag, "-"), "=")
+		switch {
+		case !found:
+			parsed[name] = true
+		case value == "true" || value == "false":
+			parsed[name] = value == "true"
+		default:
+			parsed[name] = value
+		}
+	}
+	return parsed
+}
+
+// providers maps CLI provider names to the sportscrape.Provider they scrape.
+var providers = map[string]sportscrape.Provider{
+	"foxsports":      sportscrape.FS,
+	"baseballsavant": sportscrape.BaseballSavant,
+	"espn":           sportscrape.ESPNMMA,
+	"nba":            sportscrape.NBA,
+}
+
+// applyRateLimit overrides the provider's default rate limit with --rate-limit
+// and --rate-burst when either was set. A negative --rate-limit disables
+// throttling.
+func applyRateLimit(cmd *cobra.Command, provider string) error {
+	if !cmd.Flags().Changed("rate-limit") && !cmd.Flags().Changed("rate-burst") {
+		return nil
+	}
+	p, ok := providers[provider]
+	if !ok {
+		return fmt.Errorf("unsupported provider %s", provider)
+	}
+	limit := ratelimit.ForProvider(p).Limit()
+	if cmd.Flags().Changed("rate-limit") {
+		rps, err := cmd.Flags().GetFloat64("rate-limit")
+		if err != nil {
+			return err
+		}
+		switch {
+		case rps < 0:
+			// A Limit of 0 requests per second is unlimited
+			limit.RequestsPerSecond = 0
+		case rps > 0:
+			limit.RequestsPerSecond = rps
+		}
+	}
+	if cmd.Flags().Changed("rate-burst") {
+		burst, err := cmd.Flags().GetInt("rate-burst")
+		if err != nil {
+			return err
+		}
+		if burst > 0 {
+			limit.Burst = burst
+		}
+	}
+	slog.Debug("Rate limit", "provider", p, "requests_per_second", limit.RequestsPerSecond, "burst", limit.Burst)
+	ratelimit.Set(p, limit)
+	return nil
+}
@@ -0,0 +1,69 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lightning-dabbler/sportscrape/runner"
+	"github.com/lightning-dabbler/sportscrape/schema"
+
+	"github.com/spf13/cobra"
+)
+
+// Schema prints the schema of the records of --feed in --format.
+func Schema(cmd *cobra.Command) error {
+	// --feed
+	rawFeed, err := cmd.Flags().GetString("feed")
+	if err != nil {
+		return err
+	}
+	// --format
+	format, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return err
+	}
+
+	var s schema.Schema
+	found := false
+	for _, feed := range schema.Feeds() {
+		if rawFeed == string(feed) || rawFeed == runner.RawKeySegment(string(feed)) {
+			s, err = schema.ForFeed(feed)
+			if err != nil {
+				return err
+			}
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("%w: %q, valid options: %s", schema.ErrUnknownFeed, rawFeed, SchemaFeedOptions())
+	}
+
+	var out []byte
+	switch format {
+	case "json-schema":
+		out, err = s.JSONSchema()
+		out = append(out, '\n')
+	case "parquet":
+		out = []byte(s.Parquet())
+	case "avro":
+		out, err = s.Avro()
+		out = append(out, '\n')
+	default:
+		return fmt.Errorf("unsupported schema format %q, valid options: json-schema, parquet, avro", format)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = cmd.OutOrStdout().Write(out)
+	return err
+}
+
+// SchemaFeedOptions lists the feeds with a schema, as named in the archive keys.
+func SchemaFeedOptions() string {
+	var options []string
+	for _, feed := range schema.Feeds() {
+		options = append(options, "'"+runner.RawKeySegment(string(feed))+"'")
+	}
+	return strings.Join(options, ", ")
+}
@@ -54,12 +54,14 @@ func main() {
 		},
 	}
 	embedLoggerFlag(rootCmd)
-	// Store subcommands (foxsports, baseballsavant, espn, nba)
+	// Store subcommands (foxsports, baseballsavant, espn, nba, reparse, schema)
 	rootCmd.AddCommand(
 		cli.CreateFSCmd(),
 		cli.CreateBaseballSavantCmd(),
 		cli.CreateESPNCmd(),
 		cli.CreateNBACmd(),
+		cli.CreateReparseCmd(),
+		cli.CreateSchemaCmd(),
 	)
 	// Execute the root command
 	if err := rootCmd.Execute(); err != nil {
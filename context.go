@@ -16,4 +16,10 @@ type EventDataContext struct {
 	AwayTeam      string
 	HomeID        any
 	HomeTeam      string
+	// Duration is the wall-clock time spent scraping the event. It is set by
+	// the runner, not the scraper.
+	Duration time.Duration
+	// Attempts is the number of times the event was scraped, including
+	// retries. It is set by the runner, not the scraper.
+	Attempts int
 }
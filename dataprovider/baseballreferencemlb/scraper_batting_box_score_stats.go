@@ -1,6 +1,7 @@
 package baseballreferencemlb
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -66,7 +67,6 @@ func NewBattingBoxScoreScraper(options ...BattingBoxScoreOption) *BattingBoxScor
 	for _, option := range options {
 		option(bsr)
 	}
-	bsr.Init()
 
 	return bsr
 }
@@ -82,7 +82,7 @@ func (s BattingBoxScoreScraper) Feed() sportscrape.Feed {
 }
 
 // Scrape retrieves MLB batting box score statistics for a single matchup.
-func (s *BattingBoxScoreScraper) Scrape(matchup model.MLBMatchup) sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats] {
+func (s *BattingBoxScoreScraper) Scrape(ctx context.Context, matchup model.MLBMatchup) sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats] {
 	context := s.ConstructContext(matchup)
 	output := sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats]{
 		Context: context,
@@ -1,6 +1,7 @@
 package baseballreferencemlb
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -61,7 +62,6 @@ func NewMatchupScraper(options ...MatchupOption) *MatchupScraper {
 	for _, option := range options {
 		option(mr)
 	}
-	mr.Init()
 
 	return mr
 }
@@ -76,11 +76,14 @@ func (ms MatchupScraper) Provider() sportscrape.Provider {
 	return sportscrape.BaseballReference
 }
 
-func (ms MatchupScraper) Init() {
-	ms.BaseScraper.Init()
+func (ms MatchupScraper) Init() error {
+	if err := ms.BaseScraper.Init(); err != nil {
+		return err
+	}
 	if ms.Date == "" {
-		log.Fatalln("Date is a required argument")
+		return fmt.Errorf("baseball reference mlb MatchupScraper: %w", scraper.ErrMissingDate)
 	}
+	return nil
 }
 
 func (ms MatchupScraper) Feed() sportscrape.Feed {
@@ -88,7 +91,7 @@ func (ms MatchupScraper) Feed() sportscrape.Feed {
 }
 
 // Scrape retrieves MLB matchups for the specified date.
-func (ms *MatchupScraper) Scrape() sportscrape.MatchupOutput[model.MLBMatchup] {
+func (ms *MatchupScraper) Scrape(ctx context.Context) sportscrape.MatchupOutput[model.MLBMatchup] {
 	var matchups []model.MLBMatchup
 	output := sportscrape.MatchupOutput[model.MLBMatchup]{}
 	var skips int
@@ -1,6 +1,7 @@
 package baseballreferencemlb
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -69,7 +70,6 @@ func NewPitchingBoxScoreScraper(options ...PitchingBoxScoreOption) *PitchingBoxS
 	for _, option := range options {
 		option(bsr)
 	}
-	bsr.Init()
 
 	return bsr
 }
@@ -85,7 +85,7 @@ func (s PitchingBoxScoreScraper) Feed() sportscrape.Feed {
 }
 
 // Scrape retrieves MLB pitching box score statistics for a single matchup.
-func (s *PitchingBoxScoreScraper) Scrape(matchup model.MLBMatchup) sportscrape.EventDataOutput[model.MLBPitchingBoxScoreStats] {
+func (s *PitchingBoxScoreScraper) Scrape(ctx context.Context, matchup model.MLBMatchup) sportscrape.EventDataOutput[model.MLBPitchingBoxScoreStats] {
 	context := s.ConstructContext(matchup)
 	output := sportscrape.EventDataOutput[model.MLBPitchingBoxScoreStats]{
 		Context: context,
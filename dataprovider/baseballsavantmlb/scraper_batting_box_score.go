@@ -1,19 +1,29 @@
 package baseballsavantmlb
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
+	"github.com/lightning-dabbler/sportscrape/util/request"
 	"github.com/xitongsys/parquet-go/types"
 )
 
 // BattingBoxScoreScraperOption defines a configuration option for the scraper
 type BattingBoxScoreScraperOption func(*BattingBoxScoreScraper)
 
+// BattingBoxScoreScraperClient sets the Client option
+func BattingBoxScoreScraperClient(client *request.Client) BattingBoxScoreScraperOption {
+	return func(s *BattingBoxScoreScraper) {
+		s.Client = client
+	}
+}
+
 // NewBattingBoxScoreScraper creates a new BattingBoxScoreScraper with the provided options
 func NewBattingBoxScoreScraper(options ...BattingBoxScoreScraperOption) *BattingBoxScoreScraper {
 	s := &BattingBoxScoreScraper{}
@@ -34,22 +44,38 @@ func (s BattingBoxScoreScraper) Feed() sportscrape.Feed {
 	return sportscrape.BaseballSavantMLBBattingBoxScore
 }
 
-func (s BattingBoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.BattingBoxScore] {
+func (s BattingBoxScoreScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.BattingBoxScore] {
 	context := s.ConstructContext(matchup)
 	url := ConstructEventDataURL(matchup.EventID)
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
-	gf, err := s.FetchGameFeed(url)
+	responseBody, err := s.FetchData(ctx, url)
 	if err != nil {
 		log.Println("Issue fetching event data")
 		return sportscrape.EventDataOutput[model.BattingBoxScore]{Error: err, Context: context}
 	}
 	context.PullTimestamp = pullTimestamp
+	data, err := s.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: responseBody})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.BattingBoxScore]{Error: err, Context: context}
+	}
+	return sportscrape.EventDataOutput[model.BattingBoxScore]{Context: context, Output: data}
+}
+
+// Parse builds the batting box score of matchup from payload, the game feed JSON returned by baseballsavant.
+func (s BattingBoxScoreScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.BattingBoxScore, error) {
+	context := s.ConstructContext(matchup)
+	context.URL = payload.URL
+	context.PullTimestamp = payload.PullTimestamp
+	gf, err := ParseGameFeed(payload.Body)
+	if err != nil {
+		return nil, err
+	}
 	var data []model.BattingBoxScore
 	// home batters
 	res, err := s.constructBatting("home", gf.HomeBatters, gf, context)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.BattingBoxScore]{Error: err, Context: context}
+		return nil, err
 	}
 	if res != nil {
 		data = append(data, res...)
@@ -57,12 +83,12 @@ func (s BattingBoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.EventD
 	// away batters
 	res, err = s.constructBatting("away", gf.AwayBatters, gf, context)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.BattingBoxScore]{Error: err, Context: context}
+		return nil, err
 	}
 	if res != nil {
 		data = append(data, res...)
 	}
-	return sportscrape.EventDataOutput[model.BattingBoxScore]{Error: err, Context: context, Output: data}
+	return data, nil
 }
 
 func (s BattingBoxScoreScraper) constructBatting(team string, plays *jsonresponse.Plays, gf jsonresponse.GameFeed, context sportscrape.EventDataContext) ([]model.BattingBoxScore, error) {
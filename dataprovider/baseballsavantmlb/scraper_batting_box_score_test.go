@@ -3,17 +3,18 @@
 package baseballsavantmlb
 
 import (
+	"context"
 	"testing"
 
 	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+	"github.com/lightning-dabbler/sportscrape/internal/cassettetest"
 	"github.com/lightning-dabbler/sportscrape/runner"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestMLBBattingBoxScoreScraper(t *testing.T) {
-	if testing.Short() {
-		t.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(t)
+	ctx := cassette.Context(context.Background())
 	matchupscraper := NewMatchupScraper(
 		MatchupScraperDate("2024-10-30"),
 	)
@@ -22,7 +23,7 @@ func TestMLBBattingBoxScoreScraper(t *testing.T) {
 			Scraper: matchupscraper,
 		},
 	)
-	matchups, err := matchuprunner.Run()
+	matchups, err := matchuprunner.RunContext(ctx)
 	assert.NoError(t, err)
 
 	boxscorescraper := NewBattingBoxScoreScraper()
@@ -33,7 +34,7 @@ func TestMLBBattingBoxScoreScraper(t *testing.T) {
 			Concurrency: 1,
 		},
 	)
-	boxScoreStats, err := boxscorerunner.Run(matchups)
+	boxScoreStats, err := boxscorerunner.RunContext(ctx, matchups)
 	assert.NoError(t, err)
 	assert.Equal(t, 18, len(boxScoreStats), "18 statlines")
 	GavinLuxTested := false
@@ -1,6 +1,7 @@
 package baseballsavantmlb
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 
@@ -10,9 +11,12 @@ import (
 	"github.com/lightning-dabbler/sportscrape/util/request"
 )
 
-type EventDataScraper struct{}
+type EventDataScraper struct {
+	// Client - The HTTP client fetching game feeds. Default nil = request.DefaultClient
+	Client *request.Client
+}
 
-func (e EventDataScraper) Init() {}
+func (e EventDataScraper) Init() error { return nil }
 
 func (e EventDataScraper) ConstructContext(matchup model.Matchup) sportscrape.EventDataContext {
 	return sportscrape.EventDataContext{
@@ -25,22 +29,30 @@ func (e EventDataScraper) ConstructContext(matchup model.Matchup) sportscrape.Ev
 	}
 }
 
-func (e EventDataScraper) FetchGameFeed(url string) (jsonresponse.GameFeed, error) {
-	var responsePayload jsonresponse.GameFeed
-	response, err := request.Get(url)
-	if err != nil {
-		return responsePayload, err
-	}
-	defer response.Body.Close()
-	responseBody, err := io.ReadAll(response.Body)
+// FetchGameFeed fetches and parses the game feed at url.
+func (e EventDataScraper) FetchGameFeed(ctx context.Context, url string) (jsonresponse.GameFeed, error) {
+	responseBody, err := e.FetchData(ctx, url)
 	if err != nil {
-		return responsePayload, err
+		return jsonresponse.GameFeed{}, err
 	}
-	err = json.Unmarshal(responseBody, &responsePayload)
+	return ParseGameFeed(responseBody)
+}
+
+// FetchData returns the response body of url.
+func (e EventDataScraper) FetchData(ctx context.Context, url string) ([]byte, error) {
+	response, err := e.Client.GetContext(ctx, url)
 	if err != nil {
-		return responsePayload, err
+		return nil, err
 	}
-	return responsePayload, nil
+	defer response.Body.Close()
+	return io.ReadAll(response.Body)
+}
+
+// ParseGameFeed parses a game feed JSON payload.
+func ParseGameFeed(payload []byte) (jsonresponse.GameFeed, error) {
+	var responsePayload jsonresponse.GameFeed
+	err := json.Unmarshal(payload, &responsePayload)
+	return responsePayload, err
 }
 
 func (e EventDataScraper) Provider() sportscrape.Provider {
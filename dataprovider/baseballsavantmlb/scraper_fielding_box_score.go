@@ -1,18 +1,28 @@
 package baseballsavantmlb
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
+	"github.com/lightning-dabbler/sportscrape/util/request"
 	"github.com/xitongsys/parquet-go/types"
 )
 
 // FieldingBoxScoreScraperOption defines a configuration option for the scraper
 type FieldingBoxScoreScraperOption func(*FieldingBoxScoreScraper)
 
+// FieldingBoxScoreScraperClient sets the Client option
+func FieldingBoxScoreScraperClient(client *request.Client) FieldingBoxScoreScraperOption {
+	return func(s *FieldingBoxScoreScraper) {
+		s.Client = client
+	}
+}
+
 // NewFieldingBoxScoreScraper creates a new FieldingBoxScoreScraper with the provided options
 func NewFieldingBoxScoreScraper(options ...FieldingBoxScoreScraperOption) *FieldingBoxScoreScraper {
 	s := &FieldingBoxScoreScraper{}
@@ -33,22 +43,38 @@ func (s FieldingBoxScoreScraper) Feed() sportscrape.Feed {
 	return sportscrape.BaseballSavantMLBFieldingBoxScore
 }
 
-func (s FieldingBoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.FieldingBoxScore] {
+func (s FieldingBoxScoreScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.FieldingBoxScore] {
 	context := s.ConstructContext(matchup)
 	url := ConstructEventDataURL(matchup.EventID)
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
-	gf, err := s.FetchGameFeed(url)
+	responseBody, err := s.FetchData(ctx, url)
 	if err != nil {
 		log.Println("Issue fetching event data")
 		return sportscrape.EventDataOutput[model.FieldingBoxScore]{Error: err, Context: context}
 	}
 	context.PullTimestamp = pullTimestamp
+	data, err := s.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: responseBody})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.FieldingBoxScore]{Error: err, Context: context}
+	}
+	return sportscrape.EventDataOutput[model.FieldingBoxScore]{Context: context, Output: data}
+}
+
+// Parse builds the fielding box score of matchup from payload, the game feed JSON returned by baseballsavant.
+func (s FieldingBoxScoreScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.FieldingBoxScore, error) {
+	context := s.ConstructContext(matchup)
+	context.URL = payload.URL
+	context.PullTimestamp = payload.PullTimestamp
+	gf, err := ParseGameFeed(payload.Body)
+	if err != nil {
+		return nil, err
+	}
 	var data []model.FieldingBoxScore
 	// home pitchers
 	res, err := s.constructFielding("home", gf.HomePitchers, gf, context)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.FieldingBoxScore]{Error: err, Context: context}
+		return nil, err
 	}
 	if res != nil {
 		data = append(data, res...)
@@ -56,7 +82,7 @@ func (s FieldingBoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.Event
 	// home batters
 	res, err = s.constructFielding("home", gf.HomeBatters, gf, context)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.FieldingBoxScore]{Error: err, Context: context}
+		return nil, err
 	}
 	if res != nil {
 		data = append(data, res...)
@@ -64,7 +90,7 @@ func (s FieldingBoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.Event
 	// away pitchers
 	res, err = s.constructFielding("away", gf.AwayPitchers, gf, context)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.FieldingBoxScore]{Error: err, Context: context}
+		return nil, err
 	}
 	if res != nil {
 		data = append(data, res...)
@@ -72,12 +98,12 @@ func (s FieldingBoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.Event
 	// away batters
 	res, err = s.constructFielding("away", gf.AwayBatters, gf, context)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.FieldingBoxScore]{Error: err, Context: context}
+		return nil, err
 	}
 	if res != nil {
 		data = append(data, res...)
 	}
-	return sportscrape.EventDataOutput[model.FieldingBoxScore]{Error: err, Context: context, Output: data}
+	return data, nil
 }
 
 func (s FieldingBoxScoreScraper) constructFielding(team string, plays *jsonresponse.Plays, gf jsonresponse.GameFeed, context sportscrape.EventDataContext) ([]model.FieldingBoxScore, error) {
@@ -3,17 +3,18 @@
 package baseballsavantmlb
 
 import (
+	"context"
 	"testing"
 
 	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+	"github.com/lightning-dabbler/sportscrape/internal/cassettetest"
 	"github.com/lightning-dabbler/sportscrape/runner"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestFieldingBoxScoreScraper(t *testing.T) {
-	if testing.Short() {
-		t.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(t)
+	ctx := cassette.Context(context.Background())
 	date := "2024-10-07"
 	matchupscraper := NewMatchupScraper(
 		MatchupScraperDate(date),
@@ -23,7 +24,7 @@ func TestFieldingBoxScoreScraper(t *testing.T) {
 			Scraper: matchupscraper,
 		},
 	)
-	matchups, err := matchuprunner.Run()
+	matchups, err := matchuprunner.RunContext(ctx)
 	assert.NoError(t, err)
 
 	boxscorescraper := NewFieldingBoxScoreScraper()
@@ -33,7 +34,7 @@ func TestFieldingBoxScoreScraper(t *testing.T) {
 			Concurrency: 1,
 		},
 	)
-	boxScoreStats, err := boxscorerunner.Run(matchups)
+	boxScoreStats, err := boxscorerunner.RunContext(ctx, matchups)
 	assert.NoError(t, err)
 	assert.Equal(t, 64, len(boxScoreStats), "64 statlines")
 
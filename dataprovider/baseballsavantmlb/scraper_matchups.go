@@ -1,7 +1,9 @@
 package baseballsavantmlb
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"log"
 	"time"
@@ -9,6 +11,7 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
 	"github.com/lightning-dabbler/sportscrape/util/request"
 	"github.com/xitongsys/parquet-go/types"
@@ -24,6 +27,13 @@ func MatchupScraperDate(date string) MatchupScraperOption {
 	}
 }
 
+// MatchupScraperClient sets the Client option
+func MatchupScraperClient(client *request.Client) MatchupScraperOption {
+	return func(s *MatchupScraper) {
+		s.Client = client
+	}
+}
+
 // NewMatchupScraper creates a new MatchupScraper with the provided options
 func NewMatchupScraper(options ...MatchupScraperOption) *MatchupScraper {
 	s := &MatchupScraper{}
@@ -38,12 +48,15 @@ func NewMatchupScraper(options ...MatchupScraperOption) *MatchupScraper {
 
 type MatchupScraper struct {
 	Date string
+	// Client - The HTTP client fetching matchups. Default nil = request.DefaultClient
+	Client *request.Client
 }
 
-func (s MatchupScraper) Init() {
+func (s MatchupScraper) Init() error {
 	if s.Date == "" {
-		log.Fatalln("Date is a required argument")
+		return fmt.Errorf("baseballsavant MatchupScraper: %w", scraper.ErrMissingDate)
 	}
+	return nil
 }
 
 func (s MatchupScraper) Provider() sportscrape.Provider {
@@ -54,7 +67,7 @@ func (s MatchupScraper) Feed() sportscrape.Feed {
 	return sportscrape.BaseballSavantMLBMatchup
 }
 
-func (s MatchupScraper) Scrape() sportscrape.MatchupOutput[model.Matchup] {
+func (s MatchupScraper) Scrape(ctx context.Context) sportscrape.MatchupOutput[model.Matchup] {
 	var matchups []model.Matchup
 	output := sportscrape.MatchupOutput[model.Matchup]{}
 
@@ -66,7 +79,7 @@ func (s MatchupScraper) Scrape() sportscrape.MatchupOutput[model.Matchup] {
 
 	var jsonobj jsonresponse.Matchups
 	pullTimestamp := time.Now().UTC()
-	response, err := request.Get(url)
+	response, err := s.Client.GetContext(ctx, url)
 	if err != nil {
 		output.Error = err
 		return output
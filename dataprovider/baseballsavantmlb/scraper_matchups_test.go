@@ -3,17 +3,18 @@
 package baseballsavantmlb
 
 import (
+	"context"
 	"testing"
 
 	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+	"github.com/lightning-dabbler/sportscrape/internal/cassettetest"
 	"github.com/lightning-dabbler/sportscrape/runner"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestMatchupScraper_NBA(t *testing.T) {
-	if testing.Short() {
-		t.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(t)
+	ctx := cassette.Context(context.Background())
 	matchupscraper := NewMatchupScraper(
 		MatchupScraperDate("2024-10-18"),
 	)
@@ -22,7 +23,7 @@ func TestMatchupScraper_NBA(t *testing.T) {
 			Scraper: matchupscraper,
 		},
 	)
-	matchups, err := matchuprunner.Run()
+	matchups, err := matchuprunner.RunContext(ctx)
 	assert.NoError(t, err)
 	n_matchups := len(matchups)
 	assert.Equal(t, 2, n_matchups, "2 events")
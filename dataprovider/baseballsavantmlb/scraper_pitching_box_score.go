@@ -1,19 +1,29 @@
 package baseballsavantmlb
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
+	"github.com/lightning-dabbler/sportscrape/util/request"
 	"github.com/xitongsys/parquet-go/types"
 )
 
 // PitchingBoxScoreScraperOption defines a configuration option for the scraper
 type PitchingBoxScoreScraperOption func(*PitchingBoxScoreScraper)
 
+// PitchingBoxScoreScraperClient sets the Client option
+func PitchingBoxScoreScraperClient(client *request.Client) PitchingBoxScoreScraperOption {
+	return func(s *PitchingBoxScoreScraper) {
+		s.Client = client
+	}
+}
+
 // NewPitchingBoxScoreScraper creates a new PitchingBoxScoreScraper with the provided options
 func NewPitchingBoxScoreScraper(options ...PitchingBoxScoreScraperOption) *PitchingBoxScoreScraper {
 	s := &PitchingBoxScoreScraper{}
@@ -34,22 +44,38 @@ func (s PitchingBoxScoreScraper) Feed() sportscrape.Feed {
 	return sportscrape.BaseballSavantMLBPitchingBoxScore
 }
 
-func (s PitchingBoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.PitchingBoxScore] {
+func (s PitchingBoxScoreScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.PitchingBoxScore] {
 	context := s.ConstructContext(matchup)
 	url := ConstructEventDataURL(matchup.EventID)
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
-	gf, err := s.FetchGameFeed(url)
+	responseBody, err := s.FetchData(ctx, url)
 	if err != nil {
 		log.Println("Issue fetching event data")
 		return sportscrape.EventDataOutput[model.PitchingBoxScore]{Error: err, Context: context}
 	}
 	context.PullTimestamp = pullTimestamp
+	data, err := s.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: responseBody})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.PitchingBoxScore]{Error: err, Context: context}
+	}
+	return sportscrape.EventDataOutput[model.PitchingBoxScore]{Context: context, Output: data}
+}
+
+// Parse builds the pitching box score of matchup from payload, the game feed JSON returned by baseballsavant.
+func (s PitchingBoxScoreScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.PitchingBoxScore, error) {
+	context := s.ConstructContext(matchup)
+	context.URL = payload.URL
+	context.PullTimestamp = payload.PullTimestamp
+	gf, err := ParseGameFeed(payload.Body)
+	if err != nil {
+		return nil, err
+	}
 	var data []model.PitchingBoxScore
 	// home pitchers
 	res, err := s.constructPitching("home", gf.HomePitchers, gf, context)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.PitchingBoxScore]{Error: err, Context: context}
+		return nil, err
 	}
 	if res != nil {
 		data = append(data, res...)
@@ -57,12 +83,12 @@ func (s PitchingBoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.Event
 	// away pitchers
 	res, err = s.constructPitching("away", gf.AwayPitchers, gf, context)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.PitchingBoxScore]{Error: err, Context: context}
+		return nil, err
 	}
 	if res != nil {
 		data = append(data, res...)
 	}
-	return sportscrape.EventDataOutput[model.PitchingBoxScore]{Error: err, Context: context, Output: data}
+	return data, nil
 }
 
 func (s PitchingBoxScoreScraper) constructPitching(team string, plays *jsonresponse.Plays, gf jsonresponse.GameFeed, context sportscrape.EventDataContext) ([]model.PitchingBoxScore, error) {
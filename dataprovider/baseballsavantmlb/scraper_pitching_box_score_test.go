@@ -1,17 +1,20 @@
+//go:build integration
+
 package baseballsavantmlb
 
 import (
+	"context"
 	"testing"
 
 	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+	"github.com/lightning-dabbler/sportscrape/internal/cassettetest"
 	"github.com/lightning-dabbler/sportscrape/runner"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestMLBPitchingBoxScoreScraper(t *testing.T) {
-	if testing.Short() {
-		t.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(t)
+	ctx := cassette.Context(context.Background())
 	matchupscraper := NewMatchupScraper(
 		MatchupScraperDate("2024-10-30"),
 	)
@@ -20,7 +23,7 @@ func TestMLBPitchingBoxScoreScraper(t *testing.T) {
 			Scraper: matchupscraper,
 		},
 	)
-	matchups, err := matchuprunner.Run()
+	matchups, err := matchuprunner.RunContext(ctx)
 	assert.NoError(t, err)
 
 	boxscorescraper := NewPitchingBoxScoreScraper()
@@ -31,7 +34,7 @@ func TestMLBPitchingBoxScoreScraper(t *testing.T) {
 		},
 	)
 
-	boxScoreStats, err := boxscorerunner.Run(matchups)
+	boxScoreStats, err := boxscorerunner.RunContext(ctx, matchups)
 	assert.NoError(t, err)
 	assert.Equal(t, 13, len(boxScoreStats), "13 statlines")
 	GerritColeTested := false
@@ -1,19 +1,29 @@
 package baseballsavantmlb
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
+	"github.com/lightning-dabbler/sportscrape/util/request"
 	"github.com/xitongsys/parquet-go/types"
 )
 
 // PlayByPlayScraperOption defines a configuration option for the scraper
 type PlayByPlayScraperOption func(*PlayByPlayScraper)
 
+// PlayByPlayScraperClient sets the Client option
+func PlayByPlayScraperClient(client *request.Client) PlayByPlayScraperOption {
+	return func(s *PlayByPlayScraper) {
+		s.Client = client
+	}
+}
+
 // NewPlayByPlayScraper creates a new PlayByPlayScraper with the provided options
 func NewPlayByPlayScraper(options ...PlayByPlayScraperOption) *PlayByPlayScraper {
 	s := &PlayByPlayScraper{}
@@ -34,22 +44,38 @@ func (s PlayByPlayScraper) Feed() sportscrape.Feed {
 	return sportscrape.BaseballSavantMLBPlayByPlay
 }
 
-func (s PlayByPlayScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.PlayByPlay] {
+func (s PlayByPlayScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.PlayByPlay] {
 	context := s.ConstructContext(matchup)
 	url := ConstructEventDataURL(matchup.EventID)
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
-	gf, err := s.FetchGameFeed(url)
+	responseBody, err := s.FetchData(ctx, url)
 	if err != nil {
 		log.Println("Issue fetching event data")
 		return sportscrape.EventDataOutput[model.PlayByPlay]{Error: err, Context: context}
 	}
 	context.PullTimestamp = pullTimestamp
+	data, err := s.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: responseBody})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.PlayByPlay]{Error: err, Context: context}
+	}
+	return sportscrape.EventDataOutput[model.PlayByPlay]{Context: context, Output: data}
+}
+
+// Parse builds the play by play of matchup from payload, the game feed JSON returned by baseballsavant.
+func (s PlayByPlayScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.PlayByPlay, error) {
+	context := s.ConstructContext(matchup)
+	context.URL = payload.URL
+	context.PullTimestamp = payload.PullTimestamp
+	gf, err := ParseGameFeed(payload.Body)
+	if err != nil {
+		return nil, err
+	}
 	var data []model.PlayByPlay
 	// home pitchers
 	res, err := s.constructPlayByPlay(gf.HomePitchers, context)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.PlayByPlay]{Error: err, Context: context}
+		return nil, err
 	}
 	if res != nil {
 		data = append(data, res...)
@@ -57,12 +83,12 @@ func (s PlayByPlayScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOu
 	// away pitchers
 	res, err = s.constructPlayByPlay(gf.AwayPitchers, context)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.PlayByPlay]{Error: err, Context: context}
+		return nil, err
 	}
 	if res != nil {
 		data = append(data, res...)
 	}
-	return sportscrape.EventDataOutput[model.PlayByPlay]{Error: err, Context: context, Output: data}
+	return data, nil
 }
 
 func (s PlayByPlayScraper) constructPlayByPlay(plays *jsonresponse.Plays, context sportscrape.EventDataContext) ([]model.PlayByPlay, error) {
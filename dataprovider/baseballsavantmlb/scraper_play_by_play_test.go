@@ -1,17 +1,20 @@
+//go:build integration
+
 package baseballsavantmlb
 
 import (
+	"context"
 	"testing"
 
 	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+	"github.com/lightning-dabbler/sportscrape/internal/cassettetest"
 	"github.com/lightning-dabbler/sportscrape/runner"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestPlayByPlayScraper(t *testing.T) {
-	if testing.Short() {
-		t.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(t)
+	ctx := cassette.Context(context.Background())
 	matchupscraper := NewMatchupScraper(
 		MatchupScraperDate("2024-10-30"),
 	)
@@ -20,7 +23,7 @@ func TestPlayByPlayScraper(t *testing.T) {
 			Scraper: matchupscraper,
 		},
 	)
-	matchups, err := matchuprunner.Run()
+	matchups, err := matchuprunner.RunContext(ctx)
 	assert.NoError(t, err)
 
 	playbyplayscraper := NewPlayByPlayScraper()
@@ -30,7 +33,7 @@ func TestPlayByPlayScraper(t *testing.T) {
 			Concurrency: 1,
 		},
 	)
-	plays, err := playbyplayrunner.Run(matchups)
+	plays, err := playbyplayrunner.RunContext(ctx, matchups)
 	assert.NoError(t, err)
 	assert.Equal(t, 342, len(plays), "342 plays")
 }
@@ -1,6 +1,7 @@
 package basketballreferencenba
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -101,7 +102,7 @@ func (s *AdvBoxScoreScraper) Feed() sportscrape.Feed {
 }
 
 // Scrape retrieves NBA advanced box score statistics for a single matchup.
-func (abs *AdvBoxScoreScraper) Scrape(matchup model.NBAMatchup) sportscrape.EventDataOutput[model.NBAAdvBoxScoreStats] {
+func (abs *AdvBoxScoreScraper) Scrape(ctx context.Context, matchup model.NBAMatchup) sportscrape.EventDataOutput[model.NBAAdvBoxScoreStats] {
 	context := abs.ConstructContext(matchup)
 	output := sportscrape.EventDataOutput[model.NBAAdvBoxScoreStats]{
 		Context: context,
@@ -111,7 +112,7 @@ func (abs *AdvBoxScoreScraper) Scrape(matchup model.NBAMatchup) sportscrape.Even
 	start := time.Now().UTC()
 	var advNBABoxScoreStats []model.NBAAdvBoxScoreStats
 	log.Println("Scraping Advanced Box Score: " + url)
-	doc, err := abs.FetchDoc(url, contentReadySelector)
+	doc, err := abs.FetchDocContext(ctx, url, contentReadySelector)
 	if err != nil {
 		output.Error = err
 		return output
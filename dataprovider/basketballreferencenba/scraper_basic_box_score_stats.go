@@ -1,6 +1,7 @@
 package basketballreferencenba
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/basketballreferencenba/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
 	"github.com/lightning-dabbler/sportscrape/util/sportsreference"
 	"github.com/xitongsys/parquet-go/types"
@@ -178,11 +180,11 @@ type BasicBoxScoreScraper struct {
 	Period Period
 }
 
-func (s *BasicBoxScoreScraper) Init() {
+func (s *BasicBoxScoreScraper) Init() error {
 	if s.Period.Undefined() {
-		log.Fatalln("Period is a required argument for basketball reference nba BasicBoxScoreScraper")
+		return fmt.Errorf("basketball reference nba BasicBoxScoreScraper: %w", scraper.ErrUndefinedPeriod)
 	}
-	s.EventDataScraper.Init()
+	return s.EventDataScraper.Init()
 }
 
 func (s *BasicBoxScoreScraper) Feed() sportscrape.Feed {
@@ -206,7 +208,7 @@ func (s *BasicBoxScoreScraper) Feed() sportscrape.Feed {
 }
 
 // Scrape retrieves NBA basic box score statistics for a single matchup.
-func (bs *BasicBoxScoreScraper) Scrape(matchup model.NBAMatchup) sportscrape.EventDataOutput[model.NBABasicBoxScoreStats] {
+func (bs *BasicBoxScoreScraper) Scrape(ctx context.Context, matchup model.NBAMatchup) sportscrape.EventDataOutput[model.NBABasicBoxScoreStats] {
 	context := bs.ConstructContext(matchup)
 	output := sportscrape.EventDataOutput[model.NBABasicBoxScoreStats]{
 		Context: context,
@@ -216,7 +218,7 @@ func (bs *BasicBoxScoreScraper) Scrape(matchup model.NBAMatchup) sportscrape.Eve
 	start := time.Now().UTC()
 	var basicNBABoxScoreStats []model.NBABasicBoxScoreStats
 	log.Printf("Scraping %s Basic Box Score: %s\n", bs.Period.String(), url)
-	doc, err := bs.FetchDoc(url, contentReadySelector)
+	doc, err := bs.FetchDocContext(ctx, url, contentReadySelector)
 	if err != nil {
 		output.Error = err
 		return output
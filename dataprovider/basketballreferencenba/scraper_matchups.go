@@ -1,6 +1,7 @@
 package basketballreferencenba
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -85,11 +86,11 @@ func (ms *MatchupScraper) Provider() sportscrape.Provider {
 	return sportscrape.BasketballReference
 }
 
-func (ms *MatchupScraper) Init() {
+func (ms *MatchupScraper) Init() error {
 	if ms.Date == "" {
-		log.Fatalln("Date is a required argument")
+		return fmt.Errorf("basketball reference nba MatchupScraper: %w", scraper.ErrMissingDate)
 	}
-	ms.BaseDocumentScraper.Init()
+	return ms.BaseDocumentScraper.Init()
 }
 
 func (ms *MatchupScraper) Feed() sportscrape.Feed {
@@ -97,7 +98,7 @@ func (ms *MatchupScraper) Feed() sportscrape.Feed {
 }
 
 // GetMatchups retrieves NBA matchups for the specified date.
-func (ms *MatchupScraper) Scrape() sportscrape.MatchupOutput[model.NBAMatchup] {
+func (ms *MatchupScraper) Scrape(ctx context.Context) sportscrape.MatchupOutput[model.NBAMatchup] {
 	var matchups []model.NBAMatchup
 	output := sportscrape.MatchupOutput[model.NBAMatchup]{}
 	timestamp, err := util.DateStrToTime(ms.Date)
@@ -122,7 +123,7 @@ func (ms *MatchupScraper) Scrape() sportscrape.MatchupOutput[model.NBAMatchup] {
 		output.Error = err
 		return output
 	}
-	doc, err := ms.FetchDoc(url, contentReadySelector)
+	doc, err := ms.FetchDocContext(ctx, url, contentReadySelector)
 	if err != nil {
 		output.Error = err
 		return output
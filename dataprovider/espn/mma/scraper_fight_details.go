@@ -1,8 +1,9 @@
 package mma
 
 import (
+	"bytes"
+	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -21,24 +22,58 @@ type ESPNMMAFightDetailsScraper struct {
 	League string //ufc or PFL
 }
 
-func (e *ESPNMMAFightDetailsScraper) Init() {
+func (e *ESPNMMAFightDetailsScraper) Init() error {
 	if e.League != "pfl" && e.League != "ufc" {
-		log.Fatalln("League must be either pfl or ufc")
+		return fmt.Errorf("%w %q for espn mma FightDetailsScraper: must be either pfl or ufc", scraper.ErrUnsupportedLeague, e.League)
 	}
-	e.BaseDocumentScraper.Init()
+	return e.BaseDocumentScraper.Init()
 }
 
-func (e *ESPNMMAFightDetailsScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.FightDetails] {
-
-	jsonRetriever := scraper.BaseJsonScraper[jsonresponse.ESPNEventData]{}
-
+func (e *ESPNMMAFightDetailsScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.FightDetails] {
 	url := fmt.Sprintf(ESPNMMAEventURL, matchup.EventID, e.League)
-	doc, err := e.FetchDoc(url, "html")
+	doc, err := e.FetchDocContext(ctx, url, "html")
+	if err != nil {
+		return sportscrape.EventDataOutput[model.FightDetails]{
+			Error: err,
+		}
+	}
+	pullTimestamp := time.Now()
+	html, err := doc.Html()
 	if err != nil {
 		return sportscrape.EventDataOutput[model.FightDetails]{
 			Error: err,
 		}
 	}
+	out, err := e.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: []byte(html)})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.FightDetails]{
+			Error: err,
+		}
+	}
+	return sportscrape.EventDataOutput[model.FightDetails]{
+		Error:  nil,
+		Output: out,
+		Context: sportscrape.EventDataContext{
+			PullTimestamp: pullTimestamp,
+			EventTime:     matchup.EventTime,
+			EventID:       matchup.EventID,
+			URL:           url,
+			AwayID:        "NA/Multiple",
+			AwayTeam:      "NA/Multiple",
+			HomeID:        "NA/Multiple",
+			HomeTeam:      "NA/Multiple",
+		},
+	}
+}
+
+// Parse builds the fight details of matchup from payload, the HTML of its ESPN fightcenter page.
+func (e *ESPNMMAFightDetailsScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.FightDetails, error) {
+	jsonRetriever := scraper.BaseJsonScraper[jsonresponse.ESPNEventData]{}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(payload.Body))
+	if err != nil {
+		return nil, err
+	}
 
 	data := &jsonresponse.ESPNEventData{}
 
@@ -56,26 +91,13 @@ func (e *ESPNMMAFightDetailsScraper) Scrape(matchup model.Matchup) sportscrape.E
 		}
 	})
 
-	data.PullTime = time.Now()
+	data.PullTime = payload.PullTimestamp
 
 	fights := data.GetFightDetails(matchup)
 
 	out := make([]model.FightDetails, 0, len(fights))
 	out = append(out, fights...)
-	return sportscrape.EventDataOutput[model.FightDetails]{
-		Error:  nil,
-		Output: out,
-		Context: sportscrape.EventDataContext{
-			PullTimestamp: data.PullTime,
-			EventTime:     matchup.EventTime,
-			EventID:       matchup.EventID,
-			URL:           url,
-			AwayID:        "NA/Multiple",
-			AwayTeam:      "NA/Multiple",
-			HomeID:        "NA/Multiple",
-			HomeTeam:      "NA/Multiple",
-		},
-	}
+	return out, nil
 }
 
 func (e *ESPNMMAFightDetailsScraper) Feed() sportscrape.Feed {
@@ -3,10 +3,12 @@
 package mma
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/lightning-dabbler/sportscrape/dataprovider/espn/mma/model"
+	"github.com/lightning-dabbler/sportscrape/internal/cassettetest"
 	"github.com/lightning-dabbler/sportscrape/runner"
 	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/stretchr/testify/assert"
@@ -18,15 +20,15 @@ func int32ptr(i int32) *int32 {
 }
 
 func TestESPNMMAFightDetailsScraper(T *testing.T) {
-	if testing.Short() {
-		T.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(T)
+	ctx := cassette.Context(context.Background())
 
 	fightdetailsscraper := ESPNMMAFightDetailsScraper{
 		League: "ufc",
 		BaseDocumentScraper: scraper.BaseDocumentScraper{
-			Timeout:        3 * time.Minute,
-			NetworkHeaders: NetworkHeaders,
+			Timeout:           3 * time.Minute,
+			NetworkHeaders:    NetworkHeaders,
+			DocumentRetriever: cassettetest.DocumentRetriever(T, cassette, NetworkHeaders),
 		},
 	}
 
@@ -46,7 +48,7 @@ func TestESPNMMAFightDetailsScraper(T *testing.T) {
 		},
 	)
 
-	result, err := fightdetailsrunner.Run([]model.Matchup{matchup})
+	result, err := fightdetailsrunner.RunContext(ctx, []model.Matchup{matchup})
 	assert.NoError(T, err)
 	assert.NotEmpty(T, result)
 	for _, fight := range result {
@@ -1,9 +1,9 @@
 package mma
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -23,23 +23,23 @@ type ESPNMMAMatchupScraper struct {
 	League string
 }
 
-func (m *ESPNMMAMatchupScraper) Init() {
+func (m *ESPNMMAMatchupScraper) Init() error {
 	if m.Year == "" {
-		log.Fatalln("Year is a required argument")
+		return fmt.Errorf("espn mma MatchupScraper: %w", scraper.ErrMissingYear)
 	}
 	if m.League == "" {
-		log.Fatalln("League is a required argument")
+		return fmt.Errorf("espn mma MatchupScraper: %w", scraper.ErrUndefinedLeague)
 	}
 	if m.League != "pfl" && m.League != "ufc" {
-		log.Fatalln("League must be either pfl or ufc")
+		return fmt.Errorf("%w %q for espn mma MatchupScraper: must be either pfl or ufc", scraper.ErrUnsupportedLeague, m.League)
 	}
-	m.BaseDocumentScraper.Init()
+	return m.BaseDocumentScraper.Init()
 }
 
-func (m *ESPNMMAMatchupScraper) Scrape() sportscrape.MatchupOutput[model.Matchup] {
+func (m *ESPNMMAMatchupScraper) Scrape(ctx context.Context) sportscrape.MatchupOutput[model.Matchup] {
 	url := fmt.Sprintf(ESPNMMAEventsFeedURL, m.Year, m.League)
 
-	doc, err := m.FetchDoc(url, "html")
+	doc, err := m.FetchDocContext(ctx, url, "html")
 	if err != nil {
 		return sportscrape.MatchupOutput[model.Matchup]{
 			Context: sportscrape.MatchupContext{
@@ -3,10 +3,12 @@
 package mma
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/lightning-dabbler/sportscrape/dataprovider/espn/mma/model"
+	"github.com/lightning-dabbler/sportscrape/internal/cassettetest"
 	"github.com/lightning-dabbler/sportscrape/runner"
 	"github.com/lightning-dabbler/sportscrape/scraper"
 
@@ -14,15 +16,15 @@ import (
 )
 
 func TestESPNMMMAMatchupScraper(T *testing.T) {
-	if testing.Short() {
-		T.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(T)
+	ctx := cassette.Context(context.Background())
 	matchupscraper := ESPNMMAMatchupScraper{
 		Year:   "2024",
 		League: "ufc",
 		BaseDocumentScraper: scraper.BaseDocumentScraper{
-			Timeout:        3 * time.Minute,
-			NetworkHeaders: NetworkHeaders,
+			Timeout:           3 * time.Minute,
+			NetworkHeaders:    NetworkHeaders,
+			DocumentRetriever: cassettetest.DocumentRetriever(T, cassette, NetworkHeaders),
 		},
 	}
 	matchuprunner := runner.NewMatchupRunner(
@@ -30,7 +32,7 @@ func TestESPNMMMAMatchupScraper(T *testing.T) {
 			Scraper: &matchupscraper,
 		},
 	)
-	r, err := matchuprunner.Run()
+	r, err := matchuprunner.RunContext(ctx)
 	assert.NoError(T, err)
 	output := r
 	assert.NotEmpty(T, output)
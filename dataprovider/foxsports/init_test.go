@@ -0,0 +1,53 @@
+//go:build unit
+
+package foxsports
+
+import (
+	"testing"
+
+	"github.com/lightning-dabbler/sportscrape/scraper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchupScraperInit(t *testing.T) {
+	tests := []struct {
+		name    string
+		scraper *MatchupScraper
+		err     error
+	}{
+		{
+			name:    "missing segmenter",
+			scraper: NewMatchupScraper(MatchupScraperLeague(NBA)),
+			err:     ErrMissingSegmenter,
+		},
+		{
+			name:    "undefined league",
+			scraper: NewMatchupScraper(MatchupScraperSegmenter(&GeneralSegmenter{Date: "2025-04-07"})),
+			err:     scraper.ErrUndefinedLeague,
+		},
+		{
+			name: "valid",
+			scraper: NewMatchupScraper(
+				MatchupScraperLeague(NBA),
+				MatchupScraperSegmenter(&GeneralSegmenter{Date: "2025-04-07"}),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.scraper.Init()
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				assert.ErrorIs(t, err, scraper.ErrInvalidConfig)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNBABoxScoreScraperInit(t *testing.T) {
+	assert.NoError(t, NewNBABoxScoreScraper().Init())
+	assert.ErrorIs(t, NewNBABoxScoreScraper(NBABoxScoreScraperLeague(MLB)).Init(), scraper.ErrUnsupportedLeague)
+	assert.NoError(t, NewNBABoxScoreScraper(NBABoxScoreScraperLeague(WNBA)).Init())
+}
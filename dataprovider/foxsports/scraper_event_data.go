@@ -1,11 +1,13 @@
 package foxsports
 
 import (
+	"context"
+	"fmt"
 	"io"
-	"log"
 
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util/request"
 )
 
@@ -14,18 +16,21 @@ type EventDataScraper struct {
 	League League
 	// Params - URL Query parameters
 	Params map[string]string
+	// Client - The HTTP client fetching event data. Default nil = request.DefaultClient
+	Client *request.Client
 }
 
-func (e *EventDataScraper) Init() {
+func (e *EventDataScraper) Init() error {
 	// Ensure League is set
 	if e.League.Undefined() {
-		log.Fatalln("League is a required argument for foxsports EventDataScraper")
+		return fmt.Errorf("foxsports EventDataScraper: %w", scraper.ErrUndefinedLeague)
 	}
 	// Params
 	if e.Params == nil {
 		e.Params = map[string]string{}
 	}
 	e.League.SetParams(e.Params)
+	return nil
 }
 
 func (e *EventDataScraper) ConstructEventDataURL(eventID int64) (string, error) {
@@ -65,8 +70,8 @@ func (e *EventDataScraper) ConstructMatchupComparisonURL(eventID int64) (string,
 	return url.String(), nil
 }
 
-func (e *EventDataScraper) FetchData(url string) ([]byte, error) {
-	response, err := request.Get(url)
+func (e *EventDataScraper) FetchData(ctx context.Context, url string) ([]byte, error) {
+	response, err := e.Client.GetContext(ctx, url)
 	if err != nil {
 		return []byte{}, err
 	}
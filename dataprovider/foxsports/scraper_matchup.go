@@ -1,6 +1,7 @@
 package foxsports
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,11 +13,15 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
 	"github.com/lightning-dabbler/sportscrape/util/request"
 	"github.com/xitongsys/parquet-go/types"
 )
 
+// ErrMissingSegmenter is returned by MatchupScraper.Init when no Segmenter is set.
+var ErrMissingSegmenter = fmt.Errorf("%w: Segmenter is a required argument for foxsports MatchupScraper", scraper.ErrInvalidConfig)
+
 // MatchupScraperOption defines a configuration option for the scraper
 type MatchupScraperOption func(*MatchupScraper)
 
@@ -41,6 +46,13 @@ func MatchupScraperSegmenter(segmenter Segmenter) MatchupScraperOption {
 	}
 }
 
+// MatchupScraperClient sets the Client option
+func MatchupScraperClient(client *request.Client) MatchupScraperOption {
+	return func(s *MatchupScraper) {
+		s.Client = client
+	}
+}
+
 // NewMatchupScraper creates a new MatchupScraper with the provided options
 func NewMatchupScraper(options ...MatchupScraperOption) *MatchupScraper {
 	s := &MatchupScraper{}
@@ -60,26 +72,29 @@ type MatchupScraper struct {
 	Params map[string]string
 	// Segmenter - The interface for constructing segment IDs
 	Segmenter Segmenter
+	// Client - The HTTP client fetching matchups. Default nil = request.DefaultClient
+	Client *request.Client
 	// segmentID - The base subdirectory in url used to fetch the point-in-time dataset
 	segmentID string
 	// pullTimestamp - approximate timestamp for when the request to fetch matchups was made
 	pullTimestamp time.Time
 }
 
-func (s *MatchupScraper) Init() {
+func (s *MatchupScraper) Init() error {
 	// Ensure Segmenter is set
 	if s.Segmenter == nil {
-		log.Fatalln("Segmenter is a required argument for foxsports MatchupScraper")
+		return ErrMissingSegmenter
 	}
 	// Ensure League is set
 	if s.League.Undefined() {
-		log.Fatalln("League is a required argument for foxsports MatchupScraper")
+		return fmt.Errorf("foxsports MatchupScraper: %w", scraper.ErrUndefinedLeague)
 	}
 	// Params
 	if s.Params == nil {
 		s.Params = map[string]string{}
 	}
 	s.League.SetParams(s.Params)
+	return nil
 }
 func (s MatchupScraper) Provider() sportscrape.Provider {
 	return sportscrape.FS
@@ -128,9 +143,9 @@ func (s *MatchupScraper) ConstructFullURL() (string, error) {
 //   - url: The URL to fetch matchups from
 //
 // Returns the JSON struct and optional error
-func (s *MatchupScraper) FetchMatchups(url string) (jsonresponse.Matchup, error) {
+func (s *MatchupScraper) FetchMatchups(ctx context.Context, url string) (jsonresponse.Matchup, error) {
 	var responsePayload jsonresponse.Matchup
-	response, err := request.Get(url)
+	response, err := s.Client.GetContext(ctx, url)
 	if err != nil {
 		return responsePayload, err
 	}
@@ -221,7 +236,7 @@ func (s *MatchupScraper) ParseMatchup(eventPayload jsonresponse.Event) (model.Ma
 }
 
 // Scrape gets all matchups of a League and segment ID
-func (s *MatchupScraper) Scrape() sportscrape.MatchupOutput[model.Matchup] {
+func (s *MatchupScraper) Scrape(ctx context.Context) sportscrape.MatchupOutput[model.Matchup] {
 	var matchups []model.Matchup
 	output := sportscrape.MatchupOutput[model.Matchup]{}
 	// Construct full url
@@ -236,7 +251,7 @@ func (s *MatchupScraper) Scrape() sportscrape.MatchupOutput[model.Matchup] {
 	// Fetch matchups data
 	s.pullTimestamp = time.Now().UTC()
 	log.Printf("Fetching %s Matchups at segment %s: %s\n", s.League.String(), s.segmentID, url)
-	jsonPayload, err := s.FetchMatchups(url)
+	jsonPayload, err := s.FetchMatchups(ctx, url)
 	if err != nil {
 		log.Println("Issue fetching matchups")
 		output.Error = err
@@ -3,19 +3,20 @@
 package foxsports
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model"
+	"github.com/lightning-dabbler/sportscrape/internal/cassettetest"
 	"github.com/lightning-dabbler/sportscrape/runner"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestMatchupScraper_NBA(t *testing.T) {
 	// https://api.foxsports.com/bifrost/v1/nba/scoreboard/segment/20250406?apikey=jE7yBJVRNAwdDesMgTzTXUUSx1It41Fq
-	if testing.Short() {
-		t.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(t)
+	ctx := cassette.Context(context.Background())
 	matchupScraper := NewMatchupScraper(
 		MatchupScraperLeague(NBA),
 		MatchupScraperSegmenter(&GeneralSegmenter{Date: "2025-04-06"}),
@@ -27,7 +28,7 @@ func TestMatchupScraper_NBA(t *testing.T) {
 		},
 	)
 
-	matchups, err := matchuprunner.Run()
+	matchups, err := matchuprunner.RunContext(ctx)
 	assert.NoError(t, err)
 
 	n_matchups := len(matchups)
@@ -58,9 +59,8 @@ func TestMatchupScraper_NBA(t *testing.T) {
 
 func TestMatchupScraper_WNBA(t *testing.T) {
 	// https://api.foxsports.com/bifrost/v1/wnba/scoreboard/segment/20250502?apikey=jE7yBJVRNAwdDesMgTzTXUUSx1It41Fq
-	if testing.Short() {
-		t.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(t)
+	ctx := cassette.Context(context.Background())
 	matchupScraper := NewMatchupScraper(
 		MatchupScraperLeague(WNBA),
 		MatchupScraperSegmenter(&GeneralSegmenter{Date: "2025-05-02"}),
@@ -72,7 +72,7 @@ func TestMatchupScraper_WNBA(t *testing.T) {
 		},
 	)
 
-	matchups, err := matchuprunner.Run()
+	matchups, err := matchuprunner.RunContext(ctx)
 	assert.NoError(t, err)
 
 	n_matchups := len(matchups)
@@ -103,9 +103,8 @@ func TestMatchupScraper_WNBA(t *testing.T) {
 
 func TestMatchupScraper_MLB(t *testing.T) {
 	// https://api.foxsports.com/bifrost/v1/mlb/scoreboard/segment/20241018?apikey=jE7yBJVRNAwdDesMgTzTXUUSx1It41Fq
-	if testing.Short() {
-		t.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(t)
+	ctx := cassette.Context(context.Background())
 	matchupScraper := NewMatchupScraper(
 		MatchupScraperLeague(MLB),
 		MatchupScraperSegmenter(&GeneralSegmenter{Date: "2024-10-18"}),
@@ -117,7 +116,7 @@ func TestMatchupScraper_MLB(t *testing.T) {
 		},
 	)
 
-	matchups, err := matchuprunner.Run()
+	matchups, err := matchuprunner.RunContext(ctx)
 	assert.NoError(t, err)
 
 	n_matchups := len(matchups)
@@ -148,9 +147,8 @@ func TestMatchupScraper_MLB(t *testing.T) {
 
 func TestMatchupScraper_NFL(t *testing.T) {
 	// https://api.foxsports.com/bifrost/v1/nfl/scoreboard/segment/2024-4-2?apikey=jE7yBJVRNAwdDesMgTzTXUUSx1It41Fq
-	if testing.Short() {
-		t.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(t)
+	ctx := cassette.Context(context.Background())
 
 	matchupScraper := NewMatchupScraper(
 		MatchupScraperLeague(NFL),
@@ -163,7 +161,7 @@ func TestMatchupScraper_NFL(t *testing.T) {
 		},
 	)
 
-	matchups, err := matchuprunner.Run()
+	matchups, err := matchuprunner.RunContext(ctx)
 	assert.NoError(t, err)
 	n_matchups := len(matchups)
 	assert.Equal(t, 2, n_matchups, "2 events")
@@ -192,9 +190,8 @@ func TestMatchupScraper_NFL(t *testing.T) {
 
 func TestMatchupScraper_NCAAB(t *testing.T) {
 	// https://api.foxsports.com/bifrost/v1/cbk/scoreboard/segment/20250405?groupId=2&apikey=jE7yBJVRNAwdDesMgTzTXUUSx1It41Fq
-	if testing.Short() {
-		t.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(t)
+	ctx := cassette.Context(context.Background())
 	matchupScraper := NewMatchupScraper(
 		MatchupScraperLeague(NCAAB),
 		MatchupScraperSegmenter(&GeneralSegmenter{Date: "2025-04-05"}),
@@ -206,7 +203,7 @@ func TestMatchupScraper_NCAAB(t *testing.T) {
 		},
 	)
 
-	matchups, err := matchuprunner.Run()
+	matchups, err := matchuprunner.RunContext(ctx)
 	assert.NoError(t, err)
 
 	n_matchups := len(matchups)
@@ -1,6 +1,7 @@
 package foxsports
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -10,7 +11,9 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
+	"github.com/lightning-dabbler/sportscrape/util/request"
 	"github.com/xitongsys/parquet-go/types"
 )
 
@@ -26,6 +29,13 @@ func MLBBattingBoxScoreScraperParams(params map[string]string) MLBBattingBoxScor
 	}
 }
 
+// MLBBattingBoxScoreScraperClient sets the Client option
+func MLBBattingBoxScoreScraperClient(client *request.Client) MLBBattingBoxScoreScraperOption {
+	return func(s *MLBBattingBoxScoreScraper) {
+		s.Client = client
+	}
+}
+
 // NewMLBBattingBoxScoreScraper creates a new MLBBattingBoxScoreScraper with the provided options
 func NewMLBBattingBoxScoreScraper(options ...MLBBattingBoxScoreScraperOption) *MLBBattingBoxScoreScraper {
 	s := &MLBBattingBoxScoreScraper{}
@@ -47,11 +57,10 @@ func (s MLBBattingBoxScoreScraper) Feed() sportscrape.Feed {
 	return sportscrape.FSMLBBattingBoxScore
 }
 
-func (s *MLBBattingBoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats] {
+func (s *MLBBattingBoxScoreScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats] {
 	start := time.Now().UTC()
 	context := s.ConstructContext(matchup)
 
-	var data []model.MLBBattingBoxScoreStats
 	// Construct event data URL
 	log.Println("Constructing event data URL")
 	url, err := s.ConstructEventDataURL(matchup.EventID)
@@ -62,33 +71,50 @@ func (s *MLBBattingBoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.Ev
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
 	// Fetch event data
-	responseBody, err := s.FetchData(url)
+	responseBody, err := s.FetchData(ctx, url)
 	if err != nil {
 		log.Println("Issue fetching event data")
 		return sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats]{Error: err, Context: context}
 	}
 	context.PullTimestamp = pullTimestamp
+	data, err := s.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: responseBody})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats]{Error: err, Context: context}
+	}
+	diff := time.Now().UTC().Sub(start)
+	log.Printf("Scraping of event %d (%s vs %s) completed in %s\n", matchup.EventID, matchup.AwayTeamNameFull, matchup.HomeTeamNameFull, diff)
+	return sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats]{Output: data, Context: context}
+}
+
+// Parse builds the MLB batting box score of matchup from payload, the JSON response of foxsports.
+func (s *MLBBattingBoxScoreScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.MLBBattingBoxScoreStats, error) {
+	context := s.ConstructContext(matchup)
+	context.URL = payload.URL
+	context.PullTimestamp = payload.PullTimestamp
+	responseBody := payload.Body
+
+	var data []model.MLBBattingBoxScoreStats
 	// Unmarshal JSON
 	var responsePayload jsonresponse.MLBEventData
-	err = json.Unmarshal(responseBody, &responsePayload)
+	err := json.Unmarshal(responseBody, &responsePayload)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats]{Error: err, Context: context}
+		return nil, err
 	}
 	// Check for box score data
 	if responsePayload.BoxScore == nil || responsePayload.BoxScore.BoxScoreSections == nil {
 		log.Printf("No MLB batting box score data available for event id: %d\n", matchup.EventID)
-		return sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats]{Output: data, Context: context}
+		return data, nil
 	}
 
 	// Check that both Away and Home team box score stats are populated
 	if responsePayload.BoxScore.BoxScoreSections.AwayStats == nil {
 		log.Printf("No MLB batting box score data available for away team (%s) for event id: %d\n", matchup.AwayTeamNameFull, matchup.EventID)
-		return sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats]{Output: data, Context: context}
+		return data, nil
 	}
 
 	if responsePayload.BoxScore.BoxScoreSections.AwayStats == nil {
 		log.Printf("No MLB batting box score data available for home team (%s) for event id: %d\n", matchup.HomeTeamNameFull, matchup.EventID)
-		return sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats]{Output: data, Context: context}
+		return data, nil
 	}
 
 	// validate MLBBattingBoxScoreStats home and away positions
@@ -96,14 +122,14 @@ func (s *MLBBattingBoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.Ev
 	actualHomeID, err := util.TextToInt64(uriSplit[len(uriSplit)-1])
 	if actualHomeID != matchup.HomeTeamID {
 		log.Printf("Home team ID, %d (%s), does not match expected, %d (%s)\n", actualHomeID, responsePayload.BoxScore.BoxScoreSections.HomeStats.Title, matchup.HomeTeamID, matchup.HomeTeamNameFull)
-		return sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats]{Error: err, Context: context}
+		return nil, err
 	}
 
 	uriSplit = strings.Split(responsePayload.BoxScore.BoxScoreSections.AwayStats.ContentURI, "/")
 	actualAwayID, err := util.TextToInt64(uriSplit[len(uriSplit)-1])
 	if actualAwayID != matchup.AwayTeamID {
 		log.Printf("Away team ID, %d (%s), does not match expected, %d (%s)\n", actualAwayID, responsePayload.BoxScore.BoxScoreSections.AwayStats.Title, matchup.AwayTeamID, matchup.AwayTeamNameFull)
-		return sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats]{Error: err, Context: context}
+		return nil, err
 	}
 
 	// validate headers
@@ -114,12 +140,12 @@ func (s *MLBBattingBoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.Ev
 	actualHeaderSize := len(actualHeaders)
 	if actualHeaderSize != expectedHeadersSize {
 		err = fmt.Errorf("Home team batting headers size mismatch. actual: %d expected: %d", actualHeaderSize, expectedHeadersSize)
-		return sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats]{Error: err, Context: context}
+		return nil, err
 	}
 	for idx, column := range actualHeaders {
 		if column.Text != battingHeaders[idx] {
 			err = fmt.Errorf("Home team batting header '%s' unexpect at index %d. Expected %s", column.Text, idx, battingHeaders[idx])
-			return sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats]{Error: err, Context: context}
+			return nil, err
 		}
 	}
 
@@ -128,24 +154,22 @@ func (s *MLBBattingBoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.Ev
 	actualHeaderSize = len(actualHeaders)
 	if actualHeaderSize != expectedHeadersSize {
 		err = fmt.Errorf("Away team batting headers size mismatch. actual: %d expected: %d", actualHeaderSize, expectedHeadersSize)
-		return sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats]{Error: err, Context: context}
+		return nil, err
 	}
 	for idx, column := range actualHeaders {
 		if column.Text != battingHeaders[idx] {
 			err = fmt.Errorf("Away team batting header '%s' unexpect at index %d. Expected %s", column.Text, idx, battingHeaders[idx])
-			return sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats]{Error: err, Context: context}
+			return nil, err
 		}
 	}
 	stats, err := s.parseBattingStats(responsePayload, context)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats]{Error: err, Context: context}
+		return nil, err
 	}
 	for _, obj := range stats {
 		data = append(data, *obj)
 	}
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of event %d (%s vs %s) completed in %s\n", matchup.EventID, matchup.AwayTeamNameFull, matchup.HomeTeamNameFull, diff)
-	return sportscrape.EventDataOutput[model.MLBBattingBoxScoreStats]{Output: data, Context: context}
+	return data, nil
 }
 
 func (s *MLBBattingBoxScoreScraper) parseBattingStats(responsePayload jsonresponse.MLBEventData, context sportscrape.EventDataContext) ([]*model.MLBBattingBoxScoreStats, error) {
@@ -1,6 +1,7 @@
 package foxsports
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -9,7 +10,9 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
+	"github.com/lightning-dabbler/sportscrape/util/request"
 	"github.com/xitongsys/parquet-go/types"
 )
 
@@ -27,6 +30,13 @@ func MLBOddsMoneyLineScraperParams(params map[string]string) MLBOddsMoneyLineScr
 	}
 }
 
+// MLBOddsMoneyLineScraperClient sets the Client option
+func MLBOddsMoneyLineScraperClient(client *request.Client) MLBOddsMoneyLineScraperOption {
+	return func(s *MLBOddsMoneyLineScraper) {
+		s.Client = client
+	}
+}
+
 // NewMLBOddsMoneyLineScraper creates a new MLBOddsMoneyLineScraper with the provided options
 func NewMLBOddsMoneyLineScraper(options ...MLBOddsMoneyLineScraperOption) *MLBOddsMoneyLineScraper {
 	s := &MLBOddsMoneyLineScraper{}
@@ -48,11 +58,10 @@ func (s MLBOddsMoneyLineScraper) Feed() sportscrape.Feed {
 	return sportscrape.FSMLBOddsMoneyLine
 }
 
-func (s *MLBOddsMoneyLineScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.MLBOddsMoneyLine] {
+func (s *MLBOddsMoneyLineScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.MLBOddsMoneyLine] {
 	start := time.Now().UTC()
 	context := s.ConstructContext(matchup)
 
-	var data []model.MLBOddsMoneyLine
 	// Construct event data URL
 	log.Println("Constructing event data URL")
 	url, err := s.ConstructMatchupComparisonURL(matchup.EventID)
@@ -63,39 +72,53 @@ func (s *MLBOddsMoneyLineScraper) Scrape(matchup model.Matchup) sportscrape.Even
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
 	// Fetch event data
-	responseBody, err := s.FetchData(url)
+	responseBody, err := s.FetchData(ctx, url)
 	if err != nil {
 		log.Println("Issue fetching matchup comparison")
 		return sportscrape.EventDataOutput[model.MLBOddsMoneyLine]{Error: err, Context: context}
 	}
 	context.PullTimestamp = pullTimestamp
+	data, err := s.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: responseBody})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.MLBOddsMoneyLine]{Error: err, Context: context}
+	}
+	diff := time.Now().UTC().Sub(start)
+	log.Printf("Scraping of event %d (%s vs %s) completed in %s\n", matchup.EventID, matchup.AwayTeamNameFull, matchup.HomeTeamNameFull, diff)
+	return sportscrape.EventDataOutput[model.MLBOddsMoneyLine]{Output: data, Context: context}
+}
+
+// Parse builds the MLB money line odds of matchup from payload, the JSON response of foxsports.
+func (s *MLBOddsMoneyLineScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.MLBOddsMoneyLine, error) {
+	context := s.ConstructContext(matchup)
+	context.URL = payload.URL
+	context.PullTimestamp = payload.PullTimestamp
+	responseBody := payload.Body
+
+	var data []model.MLBOddsMoneyLine
 	// Unmarshal JSON
 	var responsePayload jsonresponse.MLBMatchupComparison
-	err = json.Unmarshal(responseBody, &responsePayload)
+	err := json.Unmarshal(responseBody, &responsePayload)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.MLBOddsMoneyLine]{Error: err, Context: context}
+		return nil, err
 	}
 	if responsePayload.BetSection == nil {
 		log.Printf("No betting odds data available for event %d\n", matchup.EventID)
-		return sportscrape.EventDataOutput[model.MLBOddsMoneyLine]{Context: context}
+		return nil, nil
 	}
 	if responsePayload.BetSection.Name != betSectionTitle {
 		err = fmt.Errorf("unknown title '%s'. expected '%s'", responsePayload.BetSection.Name, betSectionTitle)
-		return sportscrape.EventDataOutput[model.MLBOddsMoneyLine]{Error: err, Context: context}
+		return nil, err
 	}
 
 	odds, err := s.record(matchup, responsePayload, context)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.MLBOddsMoneyLine]{Error: err, Context: context}
+		return nil, err
 	}
 
 	if odds != nil {
 		data = append(data, *odds)
 	}
-
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of event %d (%s vs %s) completed in %s\n", matchup.EventID, matchup.AwayTeamNameFull, matchup.HomeTeamNameFull, diff)
-	return sportscrape.EventDataOutput[model.MLBOddsMoneyLine]{Output: data, Context: context}
+	return data, nil
 }
 
 func (s *MLBOddsMoneyLineScraper) record(matchup model.Matchup, responsePayload jsonresponse.MLBMatchupComparison, context sportscrape.EventDataContext) (*model.MLBOddsMoneyLine, error) {
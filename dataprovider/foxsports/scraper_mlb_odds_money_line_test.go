@@ -3,17 +3,18 @@
 package foxsports
 
 import (
+	"context"
 	"testing"
 
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model"
+	"github.com/lightning-dabbler/sportscrape/internal/cassettetest"
 	"github.com/lightning-dabbler/sportscrape/runner"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestMLBOddsMoneyLineScraper(t *testing.T) {
-	if testing.Short() {
-		t.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(t)
+	ctx := cassette.Context(context.Background())
 
 	// Get matchups
 	matchupScraper := NewMatchupScraper(
@@ -27,7 +28,7 @@ func TestMLBOddsMoneyLineScraper(t *testing.T) {
 		},
 	)
 
-	matchups, err := matchuprunner.Run()
+	matchups, err := matchuprunner.RunContext(ctx)
 	assert.NoError(t, err)
 
 	oddsScraper := NewMLBOddsMoneyLineScraper()
@@ -37,7 +38,7 @@ func TestMLBOddsMoneyLineScraper(t *testing.T) {
 			Concurrency: 1,
 		},
 	)
-	odds, err := oddsrunner.Run(matchups)
+	odds, err := oddsrunner.RunContext(ctx, matchups)
 	assert.NoError(t, err)
 	n_records := len(odds)
 	n_expected := 1
@@ -1,6 +1,7 @@
 package foxsports
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -11,7 +12,9 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
+	"github.com/lightning-dabbler/sportscrape/util/request"
 	"github.com/xitongsys/parquet-go/types"
 )
 
@@ -33,6 +36,13 @@ func MLBOddsTotalScraperParams(params map[string]string) MLBOddsTotalScraperOpti
 	}
 }
 
+// MLBOddsTotalScraperClient sets the Client option
+func MLBOddsTotalScraperClient(client *request.Client) MLBOddsTotalScraperOption {
+	return func(s *MLBOddsTotalScraper) {
+		s.Client = client
+	}
+}
+
 // NewMLBOddsTotalScraper creates a new MLBOddsTotalScraper with the provided options
 func NewMLBOddsTotalScraper(options ...MLBOddsTotalScraperOption) *MLBOddsTotalScraper {
 	s := &MLBOddsTotalScraper{}
@@ -54,11 +64,10 @@ func (s MLBOddsTotalScraper) Feed() sportscrape.Feed {
 	return sportscrape.FSMLBOddsTotal
 }
 
-func (s *MLBOddsTotalScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.MLBOddsTotal] {
+func (s *MLBOddsTotalScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.MLBOddsTotal] {
 	start := time.Now().UTC()
 	context := s.ConstructContext(matchup)
 
-	var data []model.MLBOddsTotal
 	// Construct event data URL
 	log.Println("Constructing event data URL")
 	url, err := s.ConstructMatchupComparisonURL(matchup.EventID)
@@ -69,39 +78,53 @@ func (s *MLBOddsTotalScraper) Scrape(matchup model.Matchup) sportscrape.EventDat
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
 	// Fetch event data
-	responseBody, err := s.FetchData(url)
+	responseBody, err := s.FetchData(ctx, url)
 	if err != nil {
 		log.Println("Issue fetching matchup comparison")
 		return sportscrape.EventDataOutput[model.MLBOddsTotal]{Error: err, Context: context}
 	}
 	context.PullTimestamp = pullTimestamp
+	data, err := s.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: responseBody})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.MLBOddsTotal]{Error: err, Context: context}
+	}
+	diff := time.Now().UTC().Sub(start)
+	log.Printf("Scraping of event %d (%s vs %s) completed in %s\n", matchup.EventID, matchup.AwayTeamNameFull, matchup.HomeTeamNameFull, diff)
+	return sportscrape.EventDataOutput[model.MLBOddsTotal]{Output: data, Context: context}
+}
+
+// Parse builds the MLB total odds of matchup from payload, the JSON response of foxsports.
+func (s *MLBOddsTotalScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.MLBOddsTotal, error) {
+	context := s.ConstructContext(matchup)
+	context.URL = payload.URL
+	context.PullTimestamp = payload.PullTimestamp
+	responseBody := payload.Body
+
+	var data []model.MLBOddsTotal
 	// Unmarshal JSON
 	var responsePayload jsonresponse.MLBMatchupComparison
-	err = json.Unmarshal(responseBody, &responsePayload)
+	err := json.Unmarshal(responseBody, &responsePayload)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.MLBOddsTotal]{Error: err, Context: context}
+		return nil, err
 	}
 	if responsePayload.BetSection == nil {
 		log.Printf("No betting odds data available for event %d\n", matchup.EventID)
-		return sportscrape.EventDataOutput[model.MLBOddsTotal]{Context: context}
+		return nil, nil
 	}
 	if responsePayload.BetSection.Name != betSectionTitle {
 		err = fmt.Errorf("unknown title '%s'. expected '%s'", responsePayload.BetSection.Name, betSectionTitle)
-		return sportscrape.EventDataOutput[model.MLBOddsTotal]{Error: err, Context: context}
+		return nil, err
 	}
 
 	odds, err := s.record(matchup, responsePayload, context)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.MLBOddsTotal]{Error: err, Context: context}
+		return nil, err
 	}
 
 	if odds != nil {
 		data = append(data, *odds)
 	}
-
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of event %d (%s vs %s) completed in %s\n", matchup.EventID, matchup.AwayTeamNameFull, matchup.HomeTeamNameFull, diff)
-	return sportscrape.EventDataOutput[model.MLBOddsTotal]{Output: data, Context: context}
+	return data, nil
 }
 
 func (s *MLBOddsTotalScraper) parseLine(lineText string) (float32, error) {
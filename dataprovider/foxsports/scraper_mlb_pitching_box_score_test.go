@@ -3,11 +3,13 @@
 package foxsports
 
 import (
+	"context"
 	"log"
 	"path/filepath"
 	"testing"
 
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model"
+	"github.com/lightning-dabbler/sportscrape/internal/cassettetest"
 	"github.com/lightning-dabbler/sportscrape/runner"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
@@ -18,9 +20,8 @@ import (
 )
 
 func TestMLBPitchingBoxScoreScraper(t *testing.T) {
-	if testing.Short() {
-		t.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(t)
+	ctx := cassette.Context(context.Background())
 
 	// Get matchups
 	matchupScraper := NewMatchupScraper(
@@ -34,7 +35,7 @@ func TestMLBPitchingBoxScoreScraper(t *testing.T) {
 		},
 	)
 
-	matchups, err := matchuprunner.Run()
+	matchups, err := matchuprunner.RunContext(ctx)
 	assert.NoError(t, err)
 
 	// Get boxscore data
@@ -45,7 +46,7 @@ func TestMLBPitchingBoxScoreScraper(t *testing.T) {
 			Concurrency: 1,
 		},
 	)
-	boxScoreStats, err := boxscorerunner.Run(matchups)
+	boxScoreStats, err := boxscorerunner.RunContext(ctx, matchups)
 	assert.NoError(t, err)
 	n_stats := len(boxScoreStats)
 	n_expected := 13
@@ -1,6 +1,7 @@
 package foxsports
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -10,7 +11,9 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
+	"github.com/lightning-dabbler/sportscrape/util/request"
 	"github.com/xitongsys/parquet-go/types"
 )
 
@@ -31,6 +34,13 @@ func MLBProbableStartingPitcherScraperParams(params map[string]string) MLBProbab
 	}
 }
 
+// MLBProbableStartingPitcherScraperClient sets the Client option
+func MLBProbableStartingPitcherScraperClient(client *request.Client) MLBProbableStartingPitcherScraperOption {
+	return func(s *MLBProbableStartingPitcherScraper) {
+		s.Client = client
+	}
+}
+
 // NewMLBProbableStartingPitcherScraper creates a new MLBProbableStartingPitcherScraper with the provided options
 func NewMLBProbableStartingPitcherScraper(options ...MLBProbableStartingPitcherScraperOption) *MLBProbableStartingPitcherScraper {
 	s := &MLBProbableStartingPitcherScraper{}
@@ -52,11 +62,10 @@ func (s MLBProbableStartingPitcherScraper) Feed() sportscrape.Feed {
 	return sportscrape.FSMLBProbableStartingPitcher
 }
 
-func (s *MLBProbableStartingPitcherScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.MLBProbableStartingPitcher] {
+func (s *MLBProbableStartingPitcherScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.MLBProbableStartingPitcher] {
 	start := time.Now().UTC()
 	context := s.ConstructContext(matchup)
 
-	var data []model.MLBProbableStartingPitcher
 	// Construct event data URL
 	log.Println("Constructing event data URL")
 	url, err := s.ConstructMatchupComparisonURL(matchup.EventID)
@@ -67,30 +76,47 @@ func (s *MLBProbableStartingPitcherScraper) Scrape(matchup model.Matchup) sports
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
 	// Fetch event data
-	responseBody, err := s.FetchData(url)
+	responseBody, err := s.FetchData(ctx, url)
 	if err != nil {
 		log.Println("Issue fetching matchup comparison")
 		return sportscrape.EventDataOutput[model.MLBProbableStartingPitcher]{Error: err, Context: context}
 	}
 	context.PullTimestamp = pullTimestamp
+	data, err := s.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: responseBody})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.MLBProbableStartingPitcher]{Error: err, Context: context}
+	}
+	diff := time.Now().UTC().Sub(start)
+	log.Printf("Scraping of event %d (%s vs %s) completed in %s\n", matchup.EventID, matchup.AwayTeamNameFull, matchup.HomeTeamNameFull, diff)
+	return sportscrape.EventDataOutput[model.MLBProbableStartingPitcher]{Output: data, Context: context}
+}
+
+// Parse builds the MLB probable starting pitchers of matchup from payload, the JSON response of foxsports.
+func (s *MLBProbableStartingPitcherScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.MLBProbableStartingPitcher, error) {
+	context := s.ConstructContext(matchup)
+	context.URL = payload.URL
+	context.PullTimestamp = payload.PullTimestamp
+	responseBody := payload.Body
+
+	var data []model.MLBProbableStartingPitcher
 	// Unmarshal JSON
 	var responsePayload jsonresponse.MLBMatchupComparison
-	err = json.Unmarshal(responseBody, &responsePayload)
+	err := json.Unmarshal(responseBody, &responsePayload)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.MLBProbableStartingPitcher]{Error: err, Context: context}
+		return nil, err
 	}
 	if responsePayload.FeaturedPairing == nil {
 		log.Printf("No probable starting pitcher data available for event %d\n", matchup.EventID)
-		return sportscrape.EventDataOutput[model.MLBProbableStartingPitcher]{Context: context}
+		return nil, nil
 	}
 	if responsePayload.FeaturedPairing.Title != probablePitcherTitle {
 		err = fmt.Errorf("unknown title '%s', expected '%s'", responsePayload.FeaturedPairing.Title, probablePitcherTitle)
-		return sportscrape.EventDataOutput[model.MLBProbableStartingPitcher]{Error: err, Context: context}
+		return nil, err
 	}
 
 	pitcher, err := s.pitcher("home", responsePayload, context)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.MLBProbableStartingPitcher]{Error: err, Context: context}
+		return nil, err
 	}
 	if pitcher != nil {
 		data = append(data, *pitcher)
@@ -98,15 +124,12 @@ func (s *MLBProbableStartingPitcherScraper) Scrape(matchup model.Matchup) sports
 
 	pitcher, err = s.pitcher("away", responsePayload, context)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.MLBProbableStartingPitcher]{Error: err, Context: context}
+		return nil, err
 	}
 	if pitcher != nil {
 		data = append(data, *pitcher)
 	}
-
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of event %d (%s vs %s) completed in %s\n", matchup.EventID, matchup.AwayTeamNameFull, matchup.HomeTeamNameFull, diff)
-	return sportscrape.EventDataOutput[model.MLBProbableStartingPitcher]{Output: data, Context: context}
+	return data, nil
 }
 
 func (s *MLBProbableStartingPitcherScraper) era(rawStatline string) (float32, error) {
@@ -1,6 +1,7 @@
 package foxsports
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -11,7 +12,9 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
+	"github.com/lightning-dabbler/sportscrape/util/request"
 	"github.com/xitongsys/parquet-go/types"
 )
 
@@ -40,6 +43,13 @@ func NBABoxScoreScraperParams(params map[string]string) NBABoxScoreScraperOption
 	}
 }
 
+// NBABoxScoreScraperClient sets the Client option
+func NBABoxScoreScraperClient(client *request.Client) NBABoxScoreScraperOption {
+	return func(s *NBABoxScoreScraper) {
+		s.Client = client
+	}
+}
+
 // NewNBABoxScoreScraper creates a new NBABoxScoreScraper with the provided options
 func NewNBABoxScoreScraper(options ...NBABoxScoreScraperOption) *NBABoxScoreScraper {
 	s := &NBABoxScoreScraper{}
@@ -57,11 +67,11 @@ type NBABoxScoreScraper struct {
 	EventDataScraper
 }
 
-func (s *NBABoxScoreScraper) Init() {
+func (s *NBABoxScoreScraper) Init() error {
 	if !slices.Contains(NBABoxScoreLeagueSupported, s.League) {
-		log.Fatalf("League %s is not supported for NBABoxScoreScraper. Only NBA and WNBA are supported.\n", s.League.String())
+		return fmt.Errorf("%w %s for NBABoxScoreScraper: only NBA and WNBA are supported", scraper.ErrUnsupportedLeague, s.League.String())
 	}
-	s.EventDataScraper.Init()
+	return s.EventDataScraper.Init()
 }
 
 func (s NBABoxScoreScraper) Feed() sportscrape.Feed {
@@ -73,11 +83,10 @@ func (s NBABoxScoreScraper) Feed() sportscrape.Feed {
 	}
 }
 
-func (s *NBABoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.NBABoxScoreStats] {
+func (s *NBABoxScoreScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.NBABoxScoreStats] {
 	start := time.Now().UTC()
 	context := s.ConstructContext(matchup)
 
-	var data []model.NBABoxScoreStats
 	// Construct event data URL
 	log.Println("Constructing event data URL")
 	url, err := s.ConstructEventDataURL(matchup.EventID)
@@ -88,34 +97,51 @@ func (s *NBABoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.EventData
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
 	// Fetch event data
-	responseBody, err := s.FetchData(url)
+	responseBody, err := s.FetchData(ctx, url)
 	if err != nil {
 		log.Println("Issue fetching event data")
 		return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
 	}
 	context.PullTimestamp = pullTimestamp
+	data, err := s.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: responseBody})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
+	}
+	diff := time.Now().UTC().Sub(start)
+	log.Printf("Scraping of event %d (%s vs %s) completed in %s\n", matchup.EventID, matchup.AwayTeamNameFull, matchup.HomeTeamNameFull, diff)
+	return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Output: data, Context: context}
+}
+
+// Parse builds the NBA box score of matchup from payload, the JSON response of foxsports.
+func (s *NBABoxScoreScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.NBABoxScoreStats, error) {
+	context := s.ConstructContext(matchup)
+	context.URL = payload.URL
+	context.PullTimestamp = payload.PullTimestamp
+	responseBody := payload.Body
+
+	var data []model.NBABoxScoreStats
 	// Unmarshal JSON
 	var responsePayload jsonresponse.NBAEventData
-	err = json.Unmarshal(responseBody, &responsePayload)
+	err := json.Unmarshal(responseBody, &responsePayload)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
+		return nil, err
 	}
 	league := s.League.String()
 	// Check for box score data
 	if responsePayload.BoxScore == nil || responsePayload.BoxScore.BoxScoreSections == nil {
 		log.Printf("No %s box score data available for event id: %d\n", league, matchup.EventID)
-		return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Output: data, Context: context}
+		return data, nil
 	}
 
 	// Check that both Away and Home team box score stats are populated
 	if responsePayload.BoxScore.BoxScoreSections.AwayPlayerStats == nil {
 		log.Printf("No %s box score data available for away team (%s) for event id: %d\n", league, matchup.AwayTeamNameFull, matchup.EventID)
-		return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Output: data, Context: context}
+		return data, nil
 	}
 
 	if responsePayload.BoxScore.BoxScoreSections.AwayPlayerStats == nil {
 		log.Printf("No %s box score data available for home team (%s) for event id: %d\n", league, matchup.HomeTeamNameFull, matchup.EventID)
-		return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Output: data, Context: context}
+		return data, nil
 	}
 
 	// validate NBABoxScoreStats home and away positions
@@ -123,14 +149,14 @@ func (s *NBABoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.EventData
 	actualHomeID, err := util.TextToInt64(uriSplit[len(uriSplit)-1])
 	if actualHomeID != matchup.HomeTeamID {
 		log.Printf("Home team ID, %d (%s), does not match expected, %d (%s)\n", actualHomeID, responsePayload.BoxScore.BoxScoreSections.HomePlayerStats.Title, matchup.HomeTeamID, matchup.HomeTeamNameFull)
-		return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
+		return nil, err
 	}
 
 	uriSplit = strings.Split(responsePayload.BoxScore.BoxScoreSections.AwayPlayerStats.ContentURI, "/")
 	actualAwayID, err := util.TextToInt64(uriSplit[len(uriSplit)-1])
 	if actualAwayID != matchup.AwayTeamID {
 		log.Printf("Away team ID, %d (%s), does not match expected, %d (%s)\n", actualAwayID, responsePayload.BoxScore.BoxScoreSections.AwayPlayerStats.Title, matchup.AwayTeamID, matchup.AwayTeamNameFull)
-		return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
+		return nil, err
 	}
 
 	// validate headers
@@ -146,12 +172,12 @@ func (s *NBABoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.EventData
 	actualHeaderSize := len(actualHeaders)
 	if actualHeaderSize != expectedStarterHeaderSize {
 		err = fmt.Errorf("Home team starter headers size mismatch. actual: %d expected: %d", actualHeaderSize, expectedStarterHeaderSize)
-		return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
+		return nil, err
 	}
 	for idx, column := range actualHeaders {
 		if column.Text != expectedStartersHeaders[idx] {
 			err = fmt.Errorf("Home team starter header '%s' unexpect at index %d. Expected %s", column.Text, idx, expectedStartersHeaders[idx])
-			return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
+			return nil, err
 		}
 	}
 
@@ -160,12 +186,12 @@ func (s *NBABoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.EventData
 	actualHeaderSize = len(actualHeaders)
 	if actualHeaderSize != expectedBenchHeaderSize {
 		err = fmt.Errorf("Home team bench headers size mismatch. actual: %d expected: %d", actualHeaderSize, expectedBenchHeaderSize)
-		return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
+		return nil, err
 	}
 	for idx, column := range actualHeaders {
 		if column.Text != expectedBenchHeaders[idx] {
 			err = fmt.Errorf("Home team bench header '%s' unexpect at index %d. Expected %s", column.Text, idx, expectedBenchHeaders[idx])
-			return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
+			return nil, err
 		}
 	}
 
@@ -174,12 +200,12 @@ func (s *NBABoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.EventData
 	actualHeaderSize = len(actualHeaders)
 	if actualHeaderSize != expectedShootingHeaderSize {
 		err = fmt.Errorf("Home team shooting headers size mismatch. actual: %d expected: %d", actualHeaderSize, expectedShootingHeaderSize)
-		return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
+		return nil, err
 	}
 	for idx, column := range actualHeaders {
 		if column.Text != expectedShootingHeaders[idx] {
 			err = fmt.Errorf("Home team shooting header '%s' unexpect at index %d. Expected %s", column.Text, idx, expectedShootingHeaders[idx])
-			return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
+			return nil, err
 		}
 	}
 
@@ -188,12 +214,12 @@ func (s *NBABoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.EventData
 	actualHeaderSize = len(actualHeaders)
 	if actualHeaderSize != expectedStarterHeaderSize {
 		err = fmt.Errorf("Away team starter headers size mismatch. actual: %d expected: %d", actualHeaderSize, expectedStarterHeaderSize)
-		return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
+		return nil, err
 	}
 	for idx, column := range actualHeaders {
 		if column.Text != expectedStartersHeaders[idx] {
 			err = fmt.Errorf("Away team starter header '%s' unexpect at index %d. Expected %s", column.Text, idx, expectedStartersHeaders[idx])
-			return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
+			return nil, err
 		}
 	}
 
@@ -202,12 +228,12 @@ func (s *NBABoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.EventData
 	actualHeaderSize = len(actualHeaders)
 	if actualHeaderSize != expectedBenchHeaderSize {
 		err = fmt.Errorf("Away team bench headers size mismatch. actual: %d expected: %d", actualHeaderSize, expectedBenchHeaderSize)
-		return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
+		return nil, err
 	}
 	for idx, column := range actualHeaders {
 		if column.Text != expectedBenchHeaders[idx] {
 			err = fmt.Errorf("Away team bench header '%s' unexpect at index %d. Expected %s", column.Text, idx, expectedBenchHeaders[idx])
-			return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
+			return nil, err
 		}
 	}
 
@@ -216,28 +242,26 @@ func (s *NBABoxScoreScraper) Scrape(matchup model.Matchup) sportscrape.EventData
 	actualHeaderSize = len(actualHeaders)
 	if actualHeaderSize != expectedShootingHeaderSize {
 		err = fmt.Errorf("Away team shooting headers size mismatch. actual: %d expected: %d", actualHeaderSize, expectedShootingHeaderSize)
-		return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
+		return nil, err
 	}
 	for idx, column := range actualHeaders {
 		if column.Text != expectedShootingHeaders[idx] {
 			err = fmt.Errorf("Away team shooting header '%s' unexpect at index %d. Expected %s", column.Text, idx, expectedShootingHeaders[idx])
-			return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
+			return nil, err
 		}
 	}
 
 	// parse and develop playerMap of relevant statlines
 	playerMap, err := s.parseBoxScoreStats(responsePayload, context)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Error: err, Context: context}
+		return nil, err
 	}
 
 	// Allocate each statline to data output
 	for _, obj := range playerMap {
 		data = append(data, *obj)
 	}
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of event %d (%s vs %s) completed in %s\n", matchup.EventID, matchup.AwayTeamNameFull, matchup.HomeTeamNameFull, diff)
-	return sportscrape.EventDataOutput[model.NBABoxScoreStats]{Output: data, Context: context}
+	return data, nil
 }
 
 func (s *NBABoxScoreScraper) parseBoxScoreStats(responsePayload jsonresponse.NBAEventData, context sportscrape.EventDataContext) (map[int64]*model.NBABoxScoreStats, error) {
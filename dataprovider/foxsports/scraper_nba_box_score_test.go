@@ -3,18 +3,19 @@
 package foxsports
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model"
+	"github.com/lightning-dabbler/sportscrape/internal/cassettetest"
 	"github.com/lightning-dabbler/sportscrape/runner"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestNBABoxScoreScraper_nba(t *testing.T) {
-	if testing.Short() {
-		t.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(t)
+	ctx := cassette.Context(context.Background())
 
 	// Get matchups
 	matchupScraper := NewMatchupScraper(
@@ -28,7 +29,7 @@ func TestNBABoxScoreScraper_nba(t *testing.T) {
 		},
 	)
 
-	matchups, err := matchuprunner.Run()
+	matchups, err := matchuprunner.RunContext(ctx)
 	assert.NoError(t, err)
 
 	// Get boxscore data
@@ -39,7 +40,7 @@ func TestNBABoxScoreScraper_nba(t *testing.T) {
 			Concurrency: 2,
 		},
 	)
-	boxScoreStats, err := boxscorerunner.Run(matchups)
+	boxScoreStats, err := boxscorerunner.RunContext(ctx, matchups)
 	assert.NoError(t, err)
 	n_stats := len(boxScoreStats)
 	assert.Equal(t, 41, n_stats, "41 statlines")
@@ -103,7 +104,7 @@ func TestNBABoxScoreScraper_nba(t *testing.T) {
 		},
 	)
 
-	matchups, err = matchuprunner.Run()
+	matchups, err = matchuprunner.RunContext(ctx)
 	assert.NoError(t, err)
 
 	// Get boxscore data
@@ -114,16 +115,15 @@ func TestNBABoxScoreScraper_nba(t *testing.T) {
 			Concurrency: 2,
 		},
 	)
-	boxScoreStats, err = boxscorerunner.Run(matchups)
+	boxScoreStats, err = boxscorerunner.RunContext(ctx, matchups)
 	assert.NoError(t, err)
 	n_stats = len(boxScoreStats)
 	assert.Equal(t, 69, n_stats, "69 statlines")
 }
 
 func TestNBABoxScoreScraper_wnba(t *testing.T) {
-	if testing.Short() {
-		t.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(t)
+	ctx := cassette.Context(context.Background())
 
 	// Get matchups
 	matchupScraper := NewMatchupScraper(
@@ -137,7 +137,7 @@ func TestNBABoxScoreScraper_wnba(t *testing.T) {
 		},
 	)
 
-	matchups, err := matchuprunner.Run()
+	matchups, err := matchuprunner.RunContext(ctx)
 	assert.NoError(t, err)
 
 	// Get boxscore data
@@ -150,7 +150,7 @@ func TestNBABoxScoreScraper_wnba(t *testing.T) {
 			Concurrency: 2,
 		},
 	)
-	boxScoreStats, err := boxscorerunner.Run(matchups)
+	boxScoreStats, err := boxscorerunner.RunContext(ctx, matchups)
 	assert.NoError(t, err)
 	n_stats := len(boxScoreStats)
 	assert.Equal(t, 48, n_stats, "48 statlines")
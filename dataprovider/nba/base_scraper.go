@@ -1,9 +1,20 @@
 package nba
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
 	"github.com/chromedp/cdproto/network"
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/scraper"
+	"github.com/lightning-dabbler/sportscrape/util/request"
 )
 
 const (
@@ -19,10 +30,111 @@ var NetworkHeaders = network.Headers{
 	"upgrade-insecure-requests": "1",
 }
 
+// ErrMissingNextData is returned by the HTTP fetch of a page whose server-rendered HTML has no valid __NEXT_DATA__ script.
+var ErrMissingNextData = errors.New("__NEXT_DATA__ missing from server-rendered HTML")
+
 type Scraper struct {
 	scraper.BaseDocumentScraper
+	// HTTPFirst fetches pages with plain HTTP and extracts the __NEXT_DATA__
+	// script from the server-rendered HTML. The browser is only launched, on
+	// first use, for pages where that fails.
+	HTTPFirst bool
+	// Client sends the HTTP requests when HTTPFirst is set. Default nil = a
+	// client sending NetworkHeaders through Proxy, created by Init
+	Client *request.Client
+
+	// launch serializes the lazy launch of the browser when HTTPFirst is set
+	launch *sync.Mutex
 }
 
 func (s *Scraper) Provider() sportscrape.Provider {
 	return sportscrape.NBA
 }
+
+// Init launches the browser, or when HTTPFirst is set, only creates the
+// Client, deferring the browser launch to the first fallback.
+// Returns scraper.ErrMissingTimeout if Timeout is zero and no DocumentRetriever is set.
+func (s *Scraper) Init() error {
+	if !s.HTTPFirst {
+		return s.BaseDocumentScraper.Init()
+	}
+	if s.Timeout == 0 && s.DocumentRetriever == nil {
+		return scraper.ErrMissingTimeout
+	}
+	if s.Client == nil {
+		headers := s.NetworkHeaders
+		if headers == nil {
+			headers = NetworkHeaders
+		}
+		options := []request.ClientOption{request.WithClientTimeout(s.Timeout), request.WithProxy(s.Proxy)}
+		for key, value := range headers {
+			options = append(options, request.WithClientHeader(key, fmt.Sprint(value)))
+		}
+		s.Client = request.NewClient(options...)
+	}
+	s.launch = &sync.Mutex{}
+	return nil
+}
+
+// FetchNextData retrieves the document at URL containing the __NEXT_DATA__
+// script (see Selector). When HTTPFirst is set, the page is downloaded with
+// plain HTTP first and the browser is only used if that fails.
+func (s *Scraper) FetchNextData(ctx context.Context, URL string) (*goquery.Document, error) {
+	if !s.HTTPFirst || s.launch == nil {
+		return s.FetchDocContext(ctx, URL, Selector)
+	}
+	doc, err := s.fetchNextDataHTTP(ctx, URL)
+	if err == nil {
+		return doc, nil
+	}
+	if ctx.Err() != nil {
+		return nil, err
+	}
+	log.Printf("Falling back to browser for %s: %v\n", URL, err)
+	s.launch.Lock()
+	err = s.BaseDocumentScraper.Init()
+	s.launch.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return s.FetchDocContext(ctx, URL, Selector)
+}
+
+// fetchNextDataHTTP downloads the page at URL with Client and checks its
+// server-rendered HTML holds the __NEXT_DATA__ JSON.
+func (s *Scraper) fetchNextDataHTTP(ctx context.Context, URL string) (*goquery.Document, error) {
+	resp, err := s.Client.GetContext(ctx, URL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", URL, err)
+	}
+	data := strings.TrimSpace(doc.Find(Selector).Text())
+	if data == "" || !json.Valid([]byte(data)) {
+		return nil, fmt.Errorf("%w: %s", ErrMissingNextData, URL)
+	}
+	log.Println("Document retrieved")
+	return doc, nil
+}
+
+// NextData returns the __NEXT_DATA__ JSON of payload: an nba.com page, its
+// __NEXT_DATA__ script or the JSON itself.
+// Returns ErrMissingNextData if payload holds no such script.
+func NextData(payload []byte) ([]byte, error) {
+	payload = bytes.TrimSpace(payload)
+	if len(payload) > 0 && payload[0] == '{' {
+		return payload, nil
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	data := strings.TrimSpace(doc.Find(Selector).Text())
+	if data == "" {
+		return nil, ErrMissingNextData
+	}
+	return []byte(data), nil
+}
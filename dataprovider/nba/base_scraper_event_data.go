@@ -7,6 +7,12 @@ import (
 
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
+)
+
+var (
+	ErrUndefinedFeedType     = fmt.Errorf("%w: FeedType is a required argument", scraper.ErrInvalidConfig)
+	ErrUndefinedBoxScoreType = fmt.Errorf("%w: BoxScoreType is a required argument when FeedType is BoxScore", scraper.ErrInvalidConfig)
 )
 
 type BaseEventDataScraper struct {
@@ -16,15 +22,15 @@ type BaseEventDataScraper struct {
 	BoxScoreType BoxScoreType
 }
 
-func (beds *BaseEventDataScraper) Init() {
+func (beds *BaseEventDataScraper) Init() error {
 	if beds.FeedType.Undefined() {
-		log.Fatalln("FeedType is a required argument")
+		return ErrUndefinedFeedType
 	}
 
 	switch beds.FeedType {
 	case BoxScore:
 		if beds.BoxScoreType.Undefined() {
-			log.Fatalln("BoxScoreType is a required argument when FeedType is BoxScore")
+			return ErrUndefinedBoxScoreType
 		}
 		switch beds.BoxScoreType {
 		case Traditional, Advanced, Misc, Scoring, Usage, FourFactors:
@@ -42,7 +48,7 @@ func (beds *BaseEventDataScraper) Init() {
 			beds.Period = Full
 		}
 	}
-	beds.Scraper.Init()
+	return beds.Scraper.Init()
 }
 
 func (beds BaseEventDataScraper) ConstructContext(matchup model.Matchup) sportscrape.EventDataContext {
@@ -2,9 +2,9 @@ package nba
 
 import (
 	"fmt"
-	"log"
 	"net/url"
 
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
 )
 
@@ -21,16 +21,16 @@ type BaseMatchupScraper struct {
 	Date string
 }
 
-func (bms *BaseMatchupScraper) Init() {
+func (bms *BaseMatchupScraper) Init() error {
 	if bms.Date == "" {
-		log.Fatalln("Date is a required argument")
+		return scraper.ErrMissingDate
 	}
 	// Validate Date in the form YYYY-MM-DD
 	_, err := util.DateStrToTime(bms.Date)
 	if err != nil {
-		log.Fatalln(err)
+		return fmt.Errorf("%w: %w", scraper.ErrInvalidDate, err)
 	}
-	bms.Scraper.Init()
+	return bms.Scraper.Init()
 }
 
 func (bms *BaseMatchupScraper) URL() (string, error) {
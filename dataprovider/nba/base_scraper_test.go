@@ -0,0 +1,169 @@
+//go:build unit
+
+package nba
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
+	"github.com/lightning-dabbler/sportscrape/util/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBaseMatchupScraperInit(t *testing.T) {
+	tests := []struct {
+		name string
+		date string
+		err  error
+	}{
+		{name: "missing date", date: "", err: scraper.ErrMissingDate},
+		{name: "invalid date", date: "2025-4-7", err: scraper.ErrInvalidDate},
+		{name: "valid", date: "2025-04-07"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &BaseMatchupScraper{Date: tt.date}
+			// Avoid launching a browser
+			s.DocumentRetriever = &request.DocumentRetrieverV2{}
+			err := s.Init()
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBaseEventDataScraperInit(t *testing.T) {
+	s := &BaseEventDataScraper{}
+	s.DocumentRetriever = &request.DocumentRetrieverV2{}
+	assert.ErrorIs(t, s.Init(), ErrUndefinedFeedType)
+
+	s.FeedType = BoxScore
+	assert.ErrorIs(t, s.Init(), ErrUndefinedBoxScoreType)
+
+	s.BoxScoreType = Advanced
+	assert.NoError(t, s.Init())
+	assert.Equal(t, Full, s.Period)
+}
+
+func TestBoxScoreAdvancedScraperInitMissingTimeout(t *testing.T) {
+	s := NewBoxScoreAdvancedScraper()
+	assert.ErrorIs(t, s.Init(), scraper.ErrMissingTimeout)
+}
+
+func TestScraperInitHTTPFirst(t *testing.T) {
+	s := &Scraper{}
+	s.HTTPFirst = true
+	assert.ErrorIs(t, s.Init(), scraper.ErrMissingTimeout)
+
+	s.Timeout = time.Second
+	require.NoError(t, s.Init())
+	assert.Nil(t, s.DocumentRetriever, "browser launched before a fallback")
+	assert.Equal(t, NetworkHeaders["user-agent"], s.Client.Header.Get("User-Agent"))
+	assert.Equal(t, time.Second, s.Client.Timeout)
+}
+
+func TestScraperFetchNextData(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/server-rendered":
+			w.Write([]byte(`<html><body><script id="__NEXT_DATA__" type="application/json">{"props":{"http":true}}</script></body></html>`))
+		case "/client-rendered":
+			w.Write([]byte(`<html><body><div id="__next"></div></body></html>`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer ts.Close()
+
+	// Browser fallback replayed from a cassette
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	var documents []request.Snapshot
+	for _, page := range []string{"/client-rendered", "/blocked"} {
+		documents = append(documents, request.Snapshot{
+			URL:      ts.URL + page,
+			Selector: Selector,
+			HTML:     `<script id="__NEXT_DATA__">{"props":{"http":false}}</script>`,
+		})
+	}
+	b, err := json.Marshal(map[string]any{"documents": documents})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, b, 0o644))
+	cassette, err := request.OpenCassette(path, request.ModeReplay)
+	require.NoError(t, err)
+	dr, err := cassette.NewDocumentRetriever(nil)
+	require.NoError(t, err)
+	defer dr.Close()
+
+	s := &Scraper{HTTPFirst: true}
+	s.Timeout = time.Second
+	require.NoError(t, s.Init())
+	s.DocumentRetriever = dr
+
+	for page, fromHTTP := range map[string]bool{"/server-rendered": true, "/client-rendered": false, "/blocked": false} {
+		t.Run(page, func(t *testing.T) {
+			doc, err := s.FetchNextData(context.Background(), ts.URL+page)
+			require.NoError(t, err)
+			var data struct {
+				Props struct {
+					HTTP bool `json:"http"`
+				} `json:"props"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(doc.Find(Selector).Text()), &data))
+			assert.Equal(t, fromHTTP, data.Props.HTTP)
+		})
+	}
+
+	_, err = s.fetchNextDataHTTP(context.Background(), ts.URL+"/client-rendered")
+	assert.ErrorIs(t, err, ErrMissingNextData)
+}
+
+func TestNextData(t *testing.T) {
+	data := `{"props":{"pageProps":{}}}`
+	for name, payload := range map[string]string{
+		"page":   `<html><body><script id="__NEXT_DATA__" type="application/json">` + data + `</script></body></html>`,
+		"script": `<script id="__NEXT_DATA__">` + data + `</script>`,
+		"json":   "\n" + data + "\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := NextData([]byte(payload))
+			require.NoError(t, err)
+			assert.JSONEq(t, data, string(got))
+		})
+	}
+	_, err := NextData([]byte(`<html><body><div id="__next"></div></body></html>`))
+	assert.ErrorIs(t, err, ErrMissingNextData)
+}
+
+func TestPlayByPlayScraperParse(t *testing.T) {
+	pulled := time.Date(2025, 6, 12, 4, 0, 0, 0, time.UTC)
+	payload := scraper.Payload{
+		URL:           "https://www.nba.com/game/okc-vs-ind-0042400403/play-by-play",
+		PullTimestamp: pulled,
+		Body: []byte(`<script id="__NEXT_DATA__">{"props":{"pageProps":{"playByPlay":{"gameId":"0042400403","actions":[
+			{"actionNumber":7,"clock":"PT11M39.00S","period":1,"teamTricode":"IND","playerName":"Siakam","shotResult":"Made","actionType":"Made Shot","actionId":4}
+		]}}}}</script>`),
+	}
+	data, err := NewPlayByPlayScraper().Parse(model.Matchup{EventID: "0042400403"}, payload)
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	assert.Equal(t, "0042400403", data[0].EventID)
+	assert.Equal(t, pulled, data[0].PullTimestamp)
+	assert.Equal(t, int32(7), data[0].ActionNumber)
+	assert.Equal(t, "IND", data[0].TeamAbbreviation)
+	assert.Equal(t, "Made Shot", data[0].ActionType)
+
+	_, err = NewPlayByPlayScraper().Parse(model.Matchup{}, scraper.Payload{Body: []byte(`<html></html>`)})
+	assert.ErrorIs(t, err, ErrMissingNextData)
+}
@@ -1,6 +1,7 @@
 package nba
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
 	"github.com/xitongsys/parquet-go/types"
 )
@@ -37,6 +39,13 @@ func WithBoxScoreAdvancedDebug(debug bool) BoxScoreAdvancedScraperOption {
 	}
 }
 
+// WithBoxScoreAdvancedHTTPFirst enables or disables fetching pages with plain HTTP before falling back to the browser for box score advanced scraper
+func WithBoxScoreAdvancedHTTPFirst(httpFirst bool) BoxScoreAdvancedScraperOption {
+	return func(bs *BoxScoreAdvancedScraper) {
+		bs.HTTPFirst = httpFirst
+	}
+}
+
 // NewBoxScoreAdvancedScraper creates a new BoxScoreAdvancedScraper with the provided options
 func NewBoxScoreAdvancedScraper(options ...BoxScoreAdvancedScraperOption) *BoxScoreAdvancedScraper {
 	bs := &BoxScoreAdvancedScraper{}
@@ -53,13 +62,13 @@ type BoxScoreAdvancedScraper struct {
 	BaseEventDataScraper
 }
 
-func (bs *BoxScoreAdvancedScraper) Init() {
+func (bs *BoxScoreAdvancedScraper) Init() error {
 	// FeedType is BoxScore
 	bs.FeedType = BoxScore
 	// FeedType is Advanced
 	bs.BoxScoreType = Advanced
 	// Base validations
-	bs.BaseEventDataScraper.Init()
+	return bs.BaseEventDataScraper.Init()
 }
 func (bs *BoxScoreAdvancedScraper) Feed() sportscrape.Feed {
 	switch bs.Period {
@@ -84,7 +93,7 @@ func (bs *BoxScoreAdvancedScraper) Feed() sportscrape.Feed {
 	}
 }
 
-func (bs *BoxScoreAdvancedScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreAdvanced] {
+func (bs *BoxScoreAdvancedScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreAdvanced] {
 	start := time.Now().UTC()
 	context := bs.ConstructContext(matchup)
 	url, err := bs.URL(matchup.ShareURL)
@@ -93,23 +102,39 @@ func (bs *BoxScoreAdvancedScraper) Scrape(matchup model.Matchup) sportscrape.Eve
 	}
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
-	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	context.PullTimestamp = pullTimestamp
-	doc, err := bs.FetchDoc(url, Selector)
+	doc, err := bs.FetchNextData(ctx, url)
 	if err != nil {
 		return sportscrape.EventDataOutput[model.BoxScoreAdvanced]{Error: err, Context: context}
 	}
-	jsonstr := doc.Find(Selector).Text()
+	data, err := bs.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: []byte(doc.Find(Selector).Text())})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.BoxScoreAdvanced]{Error: err, Context: context}
+	}
+	diff := time.Now().UTC().Sub(start)
+	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
+	return sportscrape.EventDataOutput[model.BoxScoreAdvanced]{Context: context, Output: data}
+}
+
+// Parse builds the advanced box score of matchup from payload: the nba.com page, its
+// __NEXT_DATA__ script or the script's JSON.
+func (bs *BoxScoreAdvancedScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.BoxScoreAdvanced, error) {
+	jsonstr, err := NextData(payload.Body)
+	if err != nil {
+		return nil, err
+	}
+	pullTimestamp := payload.PullTimestamp
+	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	var jsonPayload jsonresponse.BoxScoreAdvancedJSON
 	var data []model.BoxScoreAdvanced
 
-	err = json.Unmarshal([]byte(jsonstr), &jsonPayload)
+	err = json.Unmarshal(jsonstr, &jsonPayload)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.BoxScoreAdvanced]{Error: err, Context: context}
+		return nil, err
 	}
 	// Check period matches with response payload data
 	if !bs.PeriodBasedBoxScoreDataAvailable(jsonPayload.Props.PageProps.Game.Period, jsonPayload.Props.PageProps.Game.GameStatus) {
-		return sportscrape.EventDataOutput[model.BoxScoreAdvanced]{Context: context}
+		return nil, nil
 	}
 	homeTeamFull := fmt.Sprintf("%s %s", jsonPayload.Props.PageProps.Game.HomeTeam.TeamCity, jsonPayload.Props.PageProps.Game.HomeTeam.TeamName)
 	awayTeamFull := fmt.Sprintf("%s %s", jsonPayload.Props.PageProps.Game.AwayTeam.TeamCity, jsonPayload.Props.PageProps.Game.AwayTeam.TeamName)
@@ -164,7 +189,7 @@ func (bs *BoxScoreAdvancedScraper) Scrape(matchup model.Matchup) sportscrape.Eve
 		if stats.Statistics.Minutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreAdvanced]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.Minutes = minutes
 		}
@@ -221,14 +246,12 @@ func (bs *BoxScoreAdvancedScraper) Scrape(matchup model.Matchup) sportscrape.Eve
 		if stats.Statistics.Minutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreAdvanced]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.Minutes = minutes
 		}
 		data = append(data, boxscore)
 	}
 
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
-	return sportscrape.EventDataOutput[model.BoxScoreAdvanced]{Context: context, Output: data}
+	return data, nil
 }
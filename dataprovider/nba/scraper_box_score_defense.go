@@ -1,6 +1,7 @@
 package nba
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
 	"github.com/xitongsys/parquet-go/types"
 )
@@ -30,6 +32,13 @@ func WithBoxScoreDefenseDebug(debug bool) BoxScoreDefenseScraperOption {
 	}
 }
 
+// WithBoxScoreDefenseHTTPFirst enables or disables fetching pages with plain HTTP before falling back to the browser for box score defense scraper
+func WithBoxScoreDefenseHTTPFirst(httpFirst bool) BoxScoreDefenseScraperOption {
+	return func(bs *BoxScoreDefenseScraper) {
+		bs.HTTPFirst = httpFirst
+	}
+}
+
 // NewBoxScoreDefenseScraper creates a new BoxScoreDefenseScraper with the provided options
 func NewBoxScoreDefenseScraper(options ...BoxScoreDefenseScraperOption) *BoxScoreDefenseScraper {
 	bs := &BoxScoreDefenseScraper{}
@@ -46,19 +55,19 @@ type BoxScoreDefenseScraper struct {
 	BaseEventDataScraper
 }
 
-func (bs *BoxScoreDefenseScraper) Init() {
+func (bs *BoxScoreDefenseScraper) Init() error {
 	// FeedType is BoxScore
 	bs.FeedType = BoxScore
 	// FeedType is Defense
 	bs.BoxScoreType = Defense
 	// Base validations
-	bs.BaseEventDataScraper.Init()
+	return bs.BaseEventDataScraper.Init()
 }
 func (bs *BoxScoreDefenseScraper) Feed() sportscrape.Feed {
 	return sportscrape.NBADefenseBoxScore
 }
 
-func (bs *BoxScoreDefenseScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreDefense] {
+func (bs *BoxScoreDefenseScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreDefense] {
 	start := time.Now().UTC()
 	context := bs.ConstructContext(matchup)
 	url, err := bs.URL(matchup.ShareURL)
@@ -67,23 +76,39 @@ func (bs *BoxScoreDefenseScraper) Scrape(matchup model.Matchup) sportscrape.Even
 	}
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
-	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	context.PullTimestamp = pullTimestamp
-	doc, err := bs.FetchDoc(url, Selector)
+	doc, err := bs.FetchNextData(ctx, url)
 	if err != nil {
 		return sportscrape.EventDataOutput[model.BoxScoreDefense]{Error: err, Context: context}
 	}
-	jsonstr := doc.Find(Selector).Text()
+	data, err := bs.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: []byte(doc.Find(Selector).Text())})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.BoxScoreDefense]{Error: err, Context: context}
+	}
+	diff := time.Now().UTC().Sub(start)
+	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
+	return sportscrape.EventDataOutput[model.BoxScoreDefense]{Context: context, Output: data}
+}
+
+// Parse builds the defense box score of matchup from payload: the nba.com page, its
+// __NEXT_DATA__ script or the script's JSON.
+func (bs *BoxScoreDefenseScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.BoxScoreDefense, error) {
+	jsonstr, err := NextData(payload.Body)
+	if err != nil {
+		return nil, err
+	}
+	pullTimestamp := payload.PullTimestamp
+	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	var jsonPayload jsonresponse.BoxScoreDefenseJSON
 	var data []model.BoxScoreDefense
 
-	err = json.Unmarshal([]byte(jsonstr), &jsonPayload)
+	err = json.Unmarshal(jsonstr, &jsonPayload)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.BoxScoreDefense]{Error: err, Context: context}
+		return nil, err
 	}
 	// Check period matches with response payload data
 	if !bs.NonPeriodBasedBoxScoreDataAvailable(jsonPayload.Props.PageProps.Game.GameStatus) {
-		return sportscrape.EventDataOutput[model.BoxScoreDefense]{Context: context}
+		return nil, nil
 	}
 	homeTeamFull := fmt.Sprintf("%s %s", jsonPayload.Props.PageProps.Game.HomeTeam.TeamCity, jsonPayload.Props.PageProps.Game.HomeTeam.TeamName)
 	awayTeamFull := fmt.Sprintf("%s %s", jsonPayload.Props.PageProps.Game.AwayTeam.TeamCity, jsonPayload.Props.PageProps.Game.AwayTeam.TeamName)
@@ -130,7 +155,7 @@ func (bs *BoxScoreDefenseScraper) Scrape(matchup model.Matchup) sportscrape.Even
 		if stats.Statistics.MatchupMinutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.MatchupMinutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreDefense]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.MatchupMinutes = minutes
 		}
@@ -179,14 +204,12 @@ func (bs *BoxScoreDefenseScraper) Scrape(matchup model.Matchup) sportscrape.Even
 		if stats.Statistics.MatchupMinutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.MatchupMinutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreDefense]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.MatchupMinutes = minutes
 		}
 		data = append(data, boxscore)
 	}
 
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
-	return sportscrape.EventDataOutput[model.BoxScoreDefense]{Context: context, Output: data}
+	return data, nil
 }
@@ -1,6 +1,7 @@
 package nba
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
 	"github.com/xitongsys/parquet-go/types"
 )
@@ -37,6 +39,13 @@ func WithBoxScoreFourFactorsDebug(debug bool) BoxScoreFourFactorsScraperOption {
 	}
 }
 
+// WithBoxScoreFourFactorsHTTPFirst enables or disables fetching pages with plain HTTP before falling back to the browser for box score four factors scraper
+func WithBoxScoreFourFactorsHTTPFirst(httpFirst bool) BoxScoreFourFactorsScraperOption {
+	return func(bs *BoxScoreFourFactorsScraper) {
+		bs.HTTPFirst = httpFirst
+	}
+}
+
 // NewBoxScoreFourFactorsScraper creates a new BoxScoreFourFactorsScraper with the provided options
 func NewBoxScoreFourFactorsScraper(options ...BoxScoreFourFactorsScraperOption) *BoxScoreFourFactorsScraper {
 	bs := &BoxScoreFourFactorsScraper{}
@@ -53,13 +62,13 @@ type BoxScoreFourFactorsScraper struct {
 	BaseEventDataScraper
 }
 
-func (bs *BoxScoreFourFactorsScraper) Init() {
+func (bs *BoxScoreFourFactorsScraper) Init() error {
 	// FeedType is BoxScore
 	bs.FeedType = BoxScore
 	// FeedType is FourFactors
 	bs.BoxScoreType = FourFactors
 	// Base validations
-	bs.BaseEventDataScraper.Init()
+	return bs.BaseEventDataScraper.Init()
 }
 func (bs *BoxScoreFourFactorsScraper) Feed() sportscrape.Feed {
 	switch bs.Period {
@@ -84,7 +93,7 @@ func (bs *BoxScoreFourFactorsScraper) Feed() sportscrape.Feed {
 	}
 }
 
-func (bs *BoxScoreFourFactorsScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreFourFactors] {
+func (bs *BoxScoreFourFactorsScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreFourFactors] {
 	start := time.Now().UTC()
 	context := bs.ConstructContext(matchup)
 	url, err := bs.URL(matchup.ShareURL)
@@ -93,24 +102,40 @@ func (bs *BoxScoreFourFactorsScraper) Scrape(matchup model.Matchup) sportscrape.
 	}
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
-	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	context.PullTimestamp = pullTimestamp
-	doc, err := bs.FetchDoc(url, Selector)
+	doc, err := bs.FetchNextData(ctx, url)
 	if err != nil {
 		return sportscrape.EventDataOutput[model.BoxScoreFourFactors]{Error: err, Context: context}
 	}
-	jsonstr := doc.Find(Selector).Text()
+	data, err := bs.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: []byte(doc.Find(Selector).Text())})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.BoxScoreFourFactors]{Error: err, Context: context}
+	}
+	diff := time.Now().UTC().Sub(start)
+	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
+	return sportscrape.EventDataOutput[model.BoxScoreFourFactors]{Context: context, Output: data}
+}
+
+// Parse builds the four factors box score of matchup from payload: the nba.com page, its
+// __NEXT_DATA__ script or the script's JSON.
+func (bs *BoxScoreFourFactorsScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.BoxScoreFourFactors, error) {
+	jsonstr, err := NextData(payload.Body)
+	if err != nil {
+		return nil, err
+	}
+	pullTimestamp := payload.PullTimestamp
+	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	var jsonPayload jsonresponse.BoxScoreFourFactorsJSON
 	var data []model.BoxScoreFourFactors
 
-	err = json.Unmarshal([]byte(jsonstr), &jsonPayload)
+	err = json.Unmarshal(jsonstr, &jsonPayload)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.BoxScoreFourFactors]{Error: err, Context: context}
+		return nil, err
 	}
 
 	// Check period matches with response payload data
 	if !bs.PeriodBasedBoxScoreDataAvailable(jsonPayload.Props.PageProps.Game.Period, jsonPayload.Props.PageProps.Game.GameStatus) {
-		return sportscrape.EventDataOutput[model.BoxScoreFourFactors]{Context: context}
+		return nil, nil
 	}
 
 	homeTeamFull := fmt.Sprintf("%s %s", jsonPayload.Props.PageProps.Game.HomeTeam.TeamCity, jsonPayload.Props.PageProps.Game.HomeTeam.TeamName)
@@ -152,7 +177,7 @@ func (bs *BoxScoreFourFactorsScraper) Scrape(matchup model.Matchup) sportscrape.
 		if stats.Statistics.Minutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreFourFactors]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.Minutes = minutes
 		}
@@ -195,14 +220,12 @@ func (bs *BoxScoreFourFactorsScraper) Scrape(matchup model.Matchup) sportscrape.
 		if stats.Statistics.Minutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreFourFactors]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.Minutes = minutes
 		}
 		data = append(data, boxscore)
 	}
 
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
-	return sportscrape.EventDataOutput[model.BoxScoreFourFactors]{Context: context, Output: data}
+	return data, nil
 }
@@ -1,32 +1,36 @@
+//go:build integration
+
 package nba
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/internal/cassettetest"
 	"github.com/lightning-dabbler/sportscrape/runner"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestBoxScoreFourFactorsScraper(t *testing.T) {
 	// https://www.nba.com/games?date=2025-06-05
-	if testing.Short() {
-		t.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(t)
+	ctx := cassette.Context(context.Background())
 
 	matchupScraper := NewMatchupScraper(
 		WithMatchupDate("2025-06-05"),
 		WithMatchupTimeout(3*time.Minute),
 	)
 	matchupScraper.NetworkHeaders = NetworkHeaders
+	matchupScraper.DocumentRetriever = cassettetest.DocumentRetriever(t, cassette, NetworkHeaders)
 	matchuprunner := runner.NewMatchupRunner(
 		runner.MatchupRunnerConfig[model.Matchup]{
 			Scraper:   matchupScraper,
 			KeepAlive: true,
 		},
 	)
-	matchups, err := matchuprunner.Run()
+	matchups, err := matchuprunner.RunContext(ctx)
 	if err != nil {
 		matchupScraper.Close()
 		t.Fatal(err)
@@ -43,7 +47,7 @@ func TestBoxScoreFourFactorsScraper(t *testing.T) {
 		},
 	)
 
-	records, err := boxscorerunner.Run(matchups)
+	records, err := boxscorerunner.RunContext(ctx, matchups)
 	assert.NoError(t, err)
 	n_records := len(records)
 	assert.Equal(t, 29, n_records, "29 stat lines")
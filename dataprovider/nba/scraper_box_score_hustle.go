@@ -1,6 +1,7 @@
 package nba
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
 	"github.com/xitongsys/parquet-go/types"
 )
@@ -30,6 +32,13 @@ func WithBoxScoreHustleDebug(debug bool) BoxScoreHustleScraperOption {
 	}
 }
 
+// WithBoxScoreHustleHTTPFirst enables or disables fetching pages with plain HTTP before falling back to the browser for box score hustle scraper
+func WithBoxScoreHustleHTTPFirst(httpFirst bool) BoxScoreHustleScraperOption {
+	return func(bs *BoxScoreHustleScraper) {
+		bs.HTTPFirst = httpFirst
+	}
+}
+
 // NewBoxScoreHustleScraper creates a new BoxScoreHustleScraper with the provided options
 func NewBoxScoreHustleScraper(options ...BoxScoreHustleScraperOption) *BoxScoreHustleScraper {
 	bs := &BoxScoreHustleScraper{}
@@ -46,19 +55,19 @@ type BoxScoreHustleScraper struct {
 	BaseEventDataScraper
 }
 
-func (bs *BoxScoreHustleScraper) Init() {
+func (bs *BoxScoreHustleScraper) Init() error {
 	// FeedType is BoxScore
 	bs.FeedType = BoxScore
 	// FeedType is Hustle
 	bs.BoxScoreType = Hustle
 	// Base validations
-	bs.BaseEventDataScraper.Init()
+	return bs.BaseEventDataScraper.Init()
 }
 func (bs *BoxScoreHustleScraper) Feed() sportscrape.Feed {
 	return sportscrape.NBAHustleBoxScore
 }
 
-func (bs *BoxScoreHustleScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreHustle] {
+func (bs *BoxScoreHustleScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreHustle] {
 	start := time.Now().UTC()
 	context := bs.ConstructContext(matchup)
 	url, err := bs.URL(matchup.ShareURL)
@@ -67,23 +76,39 @@ func (bs *BoxScoreHustleScraper) Scrape(matchup model.Matchup) sportscrape.Event
 	}
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
-	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	context.PullTimestamp = pullTimestamp
-	doc, err := bs.FetchDoc(url, Selector)
+	doc, err := bs.FetchNextData(ctx, url)
 	if err != nil {
 		return sportscrape.EventDataOutput[model.BoxScoreHustle]{Error: err, Context: context}
 	}
-	jsonstr := doc.Find(Selector).Text()
+	data, err := bs.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: []byte(doc.Find(Selector).Text())})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.BoxScoreHustle]{Error: err, Context: context}
+	}
+	diff := time.Now().UTC().Sub(start)
+	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
+	return sportscrape.EventDataOutput[model.BoxScoreHustle]{Context: context, Output: data}
+}
+
+// Parse builds the hustle box score of matchup from payload: the nba.com page, its
+// __NEXT_DATA__ script or the script's JSON.
+func (bs *BoxScoreHustleScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.BoxScoreHustle, error) {
+	jsonstr, err := NextData(payload.Body)
+	if err != nil {
+		return nil, err
+	}
+	pullTimestamp := payload.PullTimestamp
+	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	var jsonPayload jsonresponse.BoxScoreHustleJSON
 	var data []model.BoxScoreHustle
 
-	err = json.Unmarshal([]byte(jsonstr), &jsonPayload)
+	err = json.Unmarshal(jsonstr, &jsonPayload)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.BoxScoreHustle]{Error: err, Context: context}
+		return nil, err
 	}
 	// Check period matches with response payload data
 	if !bs.NonPeriodBasedBoxScoreDataAvailable(jsonPayload.Props.PageProps.Game.GameStatus) {
-		return sportscrape.EventDataOutput[model.BoxScoreHustle]{Context: context}
+		return nil, nil
 	}
 	homeTeamFull := fmt.Sprintf("%s %s", jsonPayload.Props.PageProps.Game.HomeTeam.TeamCity, jsonPayload.Props.PageProps.Game.HomeTeam.TeamName)
 	awayTeamFull := fmt.Sprintf("%s %s", jsonPayload.Props.PageProps.Game.AwayTeam.TeamCity, jsonPayload.Props.PageProps.Game.AwayTeam.TeamName)
@@ -132,7 +157,7 @@ func (bs *BoxScoreHustleScraper) Scrape(matchup model.Matchup) sportscrape.Event
 		if stats.Statistics.Minutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreHustle]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.Minutes = minutes
 		}
@@ -183,14 +208,12 @@ func (bs *BoxScoreHustleScraper) Scrape(matchup model.Matchup) sportscrape.Event
 		if stats.Statistics.Minutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreHustle]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.Minutes = minutes
 		}
 		data = append(data, boxscore)
 	}
 
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
-	return sportscrape.EventDataOutput[model.BoxScoreHustle]{Context: context, Output: data}
+	return data, nil
 }
@@ -1,31 +1,35 @@
+//go:build integration
+
 package nba
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/internal/cassettetest"
 	"github.com/lightning-dabbler/sportscrape/runner"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestBoxScoreHustleScraper(t *testing.T) {
-	if testing.Short() {
-		t.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(t)
+	ctx := cassette.Context(context.Background())
 
 	matchupScraper := NewMatchupScraper(
 		WithMatchupDate("2025-06-05"),
 		WithMatchupTimeout(3*time.Minute),
 	)
 	matchupScraper.NetworkHeaders = NetworkHeaders
+	matchupScraper.DocumentRetriever = cassettetest.DocumentRetriever(t, cassette, NetworkHeaders)
 	matchuprunner := runner.NewMatchupRunner(
 		runner.MatchupRunnerConfig[model.Matchup]{
 			Scraper:   matchupScraper,
 			KeepAlive: true,
 		},
 	)
-	matchups, err := matchuprunner.Run()
+	matchups, err := matchuprunner.RunContext(ctx)
 	if err != nil {
 		matchupScraper.Close()
 		t.Fatal(err)
@@ -41,7 +45,7 @@ func TestBoxScoreHustleScraper(t *testing.T) {
 		},
 	)
 
-	records, err := boxscorerunner.Run(matchups)
+	records, err := boxscorerunner.RunContext(ctx, matchups)
 	assert.NoError(t, err)
 	n_records := len(records)
 	assert.Equal(t, 19, n_records, "19 stat lines")
@@ -1,6 +1,7 @@
 package nba
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
 	"github.com/xitongsys/parquet-go/types"
 )
@@ -30,6 +32,13 @@ func WithBoxScoreLiveDebug(debug bool) BoxScoreLiveScraperOption {
 	}
 }
 
+// WithBoxScoreLiveHTTPFirst enables or disables fetching pages with plain HTTP before falling back to the browser for box score live scraper
+func WithBoxScoreLiveHTTPFirst(httpFirst bool) BoxScoreLiveScraperOption {
+	return func(bs *BoxScoreLiveScraper) {
+		bs.HTTPFirst = httpFirst
+	}
+}
+
 // NewBoxScoreLiveScraper creates a new BoxScoreLiveScraper with the provided options
 func NewBoxScoreLiveScraper(options ...BoxScoreLiveScraperOption) *BoxScoreLiveScraper {
 	bs := &BoxScoreLiveScraper{}
@@ -46,19 +55,19 @@ type BoxScoreLiveScraper struct {
 	BaseEventDataScraper
 }
 
-func (bs *BoxScoreLiveScraper) Init() {
+func (bs *BoxScoreLiveScraper) Init() error {
 	// FeedType is BoxScore
 	bs.FeedType = BoxScore
 	// FeedType is Usage
 	bs.BoxScoreType = Live
 	// Base validations
-	bs.BaseEventDataScraper.Init()
+	return bs.BaseEventDataScraper.Init()
 }
 func (bs *BoxScoreLiveScraper) Feed() sportscrape.Feed {
 	return sportscrape.NBALiveBoxScore
 }
 
-func (bs *BoxScoreLiveScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreLive] {
+func (bs *BoxScoreLiveScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreLive] {
 	start := time.Now().UTC()
 	context := bs.ConstructContext(matchup)
 	url, err := bs.URL(matchup.ShareURL)
@@ -67,23 +76,39 @@ func (bs *BoxScoreLiveScraper) Scrape(matchup model.Matchup) sportscrape.EventDa
 	}
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
-	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	context.PullTimestamp = pullTimestamp
-	doc, err := bs.FetchDoc(url, Selector)
+	doc, err := bs.FetchNextData(ctx, url)
 	if err != nil {
 		return sportscrape.EventDataOutput[model.BoxScoreLive]{Error: err, Context: context}
 	}
-	jsonstr := doc.Find(Selector).Text()
+	data, err := bs.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: []byte(doc.Find(Selector).Text())})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.BoxScoreLive]{Error: err, Context: context}
+	}
+	diff := time.Now().UTC().Sub(start)
+	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
+	return sportscrape.EventDataOutput[model.BoxScoreLive]{Context: context, Output: data}
+}
+
+// Parse builds the live box score of matchup from payload: the nba.com page, its
+// __NEXT_DATA__ script or the script's JSON.
+func (bs *BoxScoreLiveScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.BoxScoreLive, error) {
+	jsonstr, err := NextData(payload.Body)
+	if err != nil {
+		return nil, err
+	}
+	pullTimestamp := payload.PullTimestamp
+	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	var jsonPayload jsonresponse.BoxScoreLiveJSON
 	var data []model.BoxScoreLive
 
-	err = json.Unmarshal([]byte(jsonstr), &jsonPayload)
+	err = json.Unmarshal(jsonstr, &jsonPayload)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.BoxScoreLive]{Error: err, Context: context}
+		return nil, err
 	}
 	// Check period matches with response payload data
 	if !bs.LiveBoxScoreDataAvailable(jsonPayload.Props.PageProps.Game.GameStatus) {
-		return sportscrape.EventDataOutput[model.BoxScoreLive]{Context: context}
+		return nil, nil
 	}
 	homeTeamFull := fmt.Sprintf("%s %s", jsonPayload.Props.PageProps.Game.HomeTeam.TeamCity, jsonPayload.Props.PageProps.Game.HomeTeam.TeamName)
 	awayTeamFull := fmt.Sprintf("%s %s", jsonPayload.Props.PageProps.Game.AwayTeam.TeamCity, jsonPayload.Props.PageProps.Game.AwayTeam.TeamName)
@@ -147,11 +172,11 @@ func (bs *BoxScoreLiveScraper) Scrape(matchup model.Matchup) sportscrape.EventDa
 		}
 		mins, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 		if err != nil {
-			return sportscrape.EventDataOutput[model.BoxScoreLive]{Error: err, Context: context}
+			return nil, err
 		}
 		minsCalc, err := util.TransformMinutesPlayed(stats.Statistics.MinutesCalculated)
 		if err != nil {
-			return sportscrape.EventDataOutput[model.BoxScoreLive]{Error: err, Context: context}
+			return nil, err
 		}
 		boxscore.Minutes = mins
 		boxscore.MinutesCalculated = minsCalc
@@ -218,18 +243,16 @@ func (bs *BoxScoreLiveScraper) Scrape(matchup model.Matchup) sportscrape.EventDa
 
 		mins, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 		if err != nil {
-			return sportscrape.EventDataOutput[model.BoxScoreLive]{Error: err, Context: context}
+			return nil, err
 		}
 		minsCalc, err := util.TransformMinutesPlayed(stats.Statistics.MinutesCalculated)
 		if err != nil {
-			return sportscrape.EventDataOutput[model.BoxScoreLive]{Error: err, Context: context}
+			return nil, err
 		}
 		boxscore.Minutes = mins
 		boxscore.MinutesCalculated = minsCalc
 		data = append(data, boxscore)
 	}
 
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
-	return sportscrape.EventDataOutput[model.BoxScoreLive]{Context: context, Output: data}
+	return data, nil
 }
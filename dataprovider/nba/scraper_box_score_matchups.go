@@ -1,6 +1,7 @@
 package nba
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
 	"github.com/xitongsys/parquet-go/types"
 )
@@ -30,6 +32,13 @@ func WithBoxScoreMatchupsDebug(debug bool) BoxScoreMatchupsScraperOption {
 	}
 }
 
+// WithBoxScoreMatchupsHTTPFirst enables or disables fetching pages with plain HTTP before falling back to the browser for box score matchups scraper
+func WithBoxScoreMatchupsHTTPFirst(httpFirst bool) BoxScoreMatchupsScraperOption {
+	return func(bs *BoxScoreMatchupsScraper) {
+		bs.HTTPFirst = httpFirst
+	}
+}
+
 // NewBoxScoreMatchupsScraper creates a new BoxScoreMatchupsScraper with the provided options
 func NewBoxScoreMatchupsScraper(options ...BoxScoreMatchupsScraperOption) *BoxScoreMatchupsScraper {
 	bs := &BoxScoreMatchupsScraper{}
@@ -46,19 +55,19 @@ type BoxScoreMatchupsScraper struct {
 	BaseEventDataScraper
 }
 
-func (bs *BoxScoreMatchupsScraper) Init() {
+func (bs *BoxScoreMatchupsScraper) Init() error {
 	// FeedType is BoxScore
 	bs.FeedType = BoxScore
 	// FeedType is Matchups
 	bs.BoxScoreType = Matchups
 	// Base validations
-	bs.BaseEventDataScraper.Init()
+	return bs.BaseEventDataScraper.Init()
 }
 func (bs *BoxScoreMatchupsScraper) Feed() sportscrape.Feed {
 	return sportscrape.NBAMatchupsBoxScore
 }
 
-func (bs *BoxScoreMatchupsScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreMatchups] {
+func (bs *BoxScoreMatchupsScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreMatchups] {
 	start := time.Now().UTC()
 	context := bs.ConstructContext(matchup)
 	url, err := bs.URL(matchup.ShareURL)
@@ -67,23 +76,39 @@ func (bs *BoxScoreMatchupsScraper) Scrape(matchup model.Matchup) sportscrape.Eve
 	}
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
-	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	context.PullTimestamp = pullTimestamp
-	doc, err := bs.FetchDoc(url, Selector)
+	doc, err := bs.FetchNextData(ctx, url)
 	if err != nil {
 		return sportscrape.EventDataOutput[model.BoxScoreMatchups]{Error: err, Context: context}
 	}
-	jsonstr := doc.Find(Selector).Text()
+	data, err := bs.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: []byte(doc.Find(Selector).Text())})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.BoxScoreMatchups]{Error: err, Context: context}
+	}
+	diff := time.Now().UTC().Sub(start)
+	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
+	return sportscrape.EventDataOutput[model.BoxScoreMatchups]{Context: context, Output: data}
+}
+
+// Parse builds the matchups box score of matchup from payload: the nba.com page, its
+// __NEXT_DATA__ script or the script's JSON.
+func (bs *BoxScoreMatchupsScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.BoxScoreMatchups, error) {
+	jsonstr, err := NextData(payload.Body)
+	if err != nil {
+		return nil, err
+	}
+	pullTimestamp := payload.PullTimestamp
+	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	var jsonPayload jsonresponse.BoxScoreMatchupsJSON
 	var data []model.BoxScoreMatchups
 
-	err = json.Unmarshal([]byte(jsonstr), &jsonPayload)
+	err = json.Unmarshal(jsonstr, &jsonPayload)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.BoxScoreMatchups]{Error: err, Context: context}
+		return nil, err
 	}
 	// Check period matches with response payload data
 	if !bs.NonPeriodBasedBoxScoreDataAvailable(jsonPayload.Props.PageProps.Game.GameStatus) {
-		return sportscrape.EventDataOutput[model.BoxScoreMatchups]{Context: context}
+		return nil, nil
 	}
 	homeTeamFull := fmt.Sprintf("%s %s", jsonPayload.Props.PageProps.Game.HomeTeam.TeamCity, jsonPayload.Props.PageProps.Game.HomeTeam.TeamName)
 	awayTeamFull := fmt.Sprintf("%s %s", jsonPayload.Props.PageProps.Game.AwayTeam.TeamCity, jsonPayload.Props.PageProps.Game.AwayTeam.TeamName)
@@ -146,7 +171,7 @@ func (bs *BoxScoreMatchupsScraper) Scrape(matchup model.Matchup) sportscrape.Eve
 			if matchup_.Statistics.MatchupMinutes != "" {
 				minutes, err := util.TransformMinutesPlayed(matchup_.Statistics.MatchupMinutes)
 				if err != nil {
-					return sportscrape.EventDataOutput[model.BoxScoreMatchups]{Error: err, Context: context}
+					return nil, err
 				}
 				boxscore.MatchupMinutes = minutes
 			}
@@ -211,7 +236,7 @@ func (bs *BoxScoreMatchupsScraper) Scrape(matchup model.Matchup) sportscrape.Eve
 			if matchup_.Statistics.MatchupMinutes != "" {
 				minutes, err := util.TransformMinutesPlayed(matchup_.Statistics.MatchupMinutes)
 				if err != nil {
-					return sportscrape.EventDataOutput[model.BoxScoreMatchups]{Error: err, Context: context}
+					return nil, err
 				}
 				boxscore.MatchupMinutes = minutes
 			}
@@ -219,7 +244,5 @@ func (bs *BoxScoreMatchupsScraper) Scrape(matchup model.Matchup) sportscrape.Eve
 		}
 	}
 
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
-	return sportscrape.EventDataOutput[model.BoxScoreMatchups]{Context: context, Output: data}
+	return data, nil
 }
@@ -1,6 +1,7 @@
 package nba
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
 	"github.com/xitongsys/parquet-go/types"
 )
@@ -37,6 +39,13 @@ func WithBoxScoreMiscDebug(debug bool) BoxScoreMiscScraperOption {
 	}
 }
 
+// WithBoxScoreMiscHTTPFirst enables or disables fetching pages with plain HTTP before falling back to the browser for box score misc scraper
+func WithBoxScoreMiscHTTPFirst(httpFirst bool) BoxScoreMiscScraperOption {
+	return func(bs *BoxScoreMiscScraper) {
+		bs.HTTPFirst = httpFirst
+	}
+}
+
 // NewBoxScoreMiscScraper creates a new BoxScoreMiscScraper with the provided options
 func NewBoxScoreMiscScraper(options ...BoxScoreMiscScraperOption) *BoxScoreMiscScraper {
 	bs := &BoxScoreMiscScraper{}
@@ -53,13 +62,13 @@ type BoxScoreMiscScraper struct {
 	BaseEventDataScraper
 }
 
-func (bs *BoxScoreMiscScraper) Init() {
+func (bs *BoxScoreMiscScraper) Init() error {
 	// FeedType is BoxScore
 	bs.FeedType = BoxScore
 	// FeedType is Misc
 	bs.BoxScoreType = Misc
 	// Base validations
-	bs.BaseEventDataScraper.Init()
+	return bs.BaseEventDataScraper.Init()
 }
 func (bs *BoxScoreMiscScraper) Feed() sportscrape.Feed {
 	switch bs.Period {
@@ -84,7 +93,7 @@ func (bs *BoxScoreMiscScraper) Feed() sportscrape.Feed {
 	}
 }
 
-func (bs *BoxScoreMiscScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreMisc] {
+func (bs *BoxScoreMiscScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreMisc] {
 	start := time.Now().UTC()
 	context := bs.ConstructContext(matchup)
 	url, err := bs.URL(matchup.ShareURL)
@@ -93,29 +102,45 @@ func (bs *BoxScoreMiscScraper) Scrape(matchup model.Matchup) sportscrape.EventDa
 	}
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
-	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	context.PullTimestamp = pullTimestamp
-	doc, err := bs.FetchDoc(url, Selector)
+	doc, err := bs.FetchNextData(ctx, url)
 	if err != nil {
 		return sportscrape.EventDataOutput[model.BoxScoreMisc]{Error: err, Context: context}
 	}
-	jsonstr := doc.Find(Selector).Text()
+	data, err := bs.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: []byte(doc.Find(Selector).Text())})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.BoxScoreMisc]{Error: err, Context: context}
+	}
+	diff := time.Now().UTC().Sub(start)
+	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
+	return sportscrape.EventDataOutput[model.BoxScoreMisc]{Context: context, Output: data}
+}
+
+// Parse builds the misc box score of matchup from payload: the nba.com page, its
+// __NEXT_DATA__ script or the script's JSON.
+func (bs *BoxScoreMiscScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.BoxScoreMisc, error) {
+	jsonstr, err := NextData(payload.Body)
+	if err != nil {
+		return nil, err
+	}
+	pullTimestamp := payload.PullTimestamp
+	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	var jsonPayload jsonresponse.BoxScoreMiscJSON
 	var data []model.BoxScoreMisc
 
-	err = json.Unmarshal([]byte(jsonstr), &jsonPayload)
+	err = json.Unmarshal(jsonstr, &jsonPayload)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.BoxScoreMisc]{Error: err, Context: context}
+		return nil, err
 	}
 
 	// In an OT case and number of periods aren't aligned with expected count, return
 	if bs.Period == AllOT && jsonPayload.Props.PageProps.Game.Period <= 4 {
-		return sportscrape.EventDataOutput[model.BoxScoreMisc]{Context: context}
+		return nil, nil
 	}
 
 	// Check period matches with response payload data
 	if !bs.PeriodBasedBoxScoreDataAvailable(jsonPayload.Props.PageProps.Game.Period, jsonPayload.Props.PageProps.Game.GameStatus) {
-		return sportscrape.EventDataOutput[model.BoxScoreMisc]{Context: context}
+		return nil, nil
 	}
 
 	homeTeamFull := fmt.Sprintf("%s %s", jsonPayload.Props.PageProps.Game.HomeTeam.TeamCity, jsonPayload.Props.PageProps.Game.HomeTeam.TeamName)
@@ -161,7 +186,7 @@ func (bs *BoxScoreMiscScraper) Scrape(matchup model.Matchup) sportscrape.EventDa
 		if stats.Statistics.Minutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreMisc]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.Minutes = minutes
 		}
@@ -208,14 +233,12 @@ func (bs *BoxScoreMiscScraper) Scrape(matchup model.Matchup) sportscrape.EventDa
 		if stats.Statistics.Minutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreMisc]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.Minutes = minutes
 		}
 		data = append(data, boxscore)
 	}
 
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
-	return sportscrape.EventDataOutput[model.BoxScoreMisc]{Context: context, Output: data}
+	return data, nil
 }
@@ -1,6 +1,7 @@
 package nba
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
 	"github.com/xitongsys/parquet-go/types"
 )
@@ -37,6 +39,13 @@ func WithBoxScoreScoringDebug(debug bool) BoxScoreScoringScraperOption {
 	}
 }
 
+// WithBoxScoreScoringHTTPFirst enables or disables fetching pages with plain HTTP before falling back to the browser for box score scoring scraper
+func WithBoxScoreScoringHTTPFirst(httpFirst bool) BoxScoreScoringScraperOption {
+	return func(bs *BoxScoreScoringScraper) {
+		bs.HTTPFirst = httpFirst
+	}
+}
+
 // NewBoxScoreScoringScraper creates a new BoxScoreScoringScraper with the provided options
 func NewBoxScoreScoringScraper(options ...BoxScoreScoringScraperOption) *BoxScoreScoringScraper {
 	bs := &BoxScoreScoringScraper{}
@@ -53,13 +62,13 @@ type BoxScoreScoringScraper struct {
 	BaseEventDataScraper
 }
 
-func (bs *BoxScoreScoringScraper) Init() {
+func (bs *BoxScoreScoringScraper) Init() error {
 	// FeedType is BoxScore
 	bs.FeedType = BoxScore
 	// FeedType is Scoring
 	bs.BoxScoreType = Scoring
 	// Base validations
-	bs.BaseEventDataScraper.Init()
+	return bs.BaseEventDataScraper.Init()
 }
 func (bs *BoxScoreScoringScraper) Feed() sportscrape.Feed {
 	switch bs.Period {
@@ -84,7 +93,7 @@ func (bs *BoxScoreScoringScraper) Feed() sportscrape.Feed {
 	}
 }
 
-func (bs *BoxScoreScoringScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreScoring] {
+func (bs *BoxScoreScoringScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreScoring] {
 	start := time.Now().UTC()
 	context := bs.ConstructContext(matchup)
 	url, err := bs.URL(matchup.ShareURL)
@@ -93,24 +102,40 @@ func (bs *BoxScoreScoringScraper) Scrape(matchup model.Matchup) sportscrape.Even
 	}
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
-	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	context.PullTimestamp = pullTimestamp
-	doc, err := bs.FetchDoc(url, Selector)
+	doc, err := bs.FetchNextData(ctx, url)
 	if err != nil {
 		return sportscrape.EventDataOutput[model.BoxScoreScoring]{Error: err, Context: context}
 	}
-	jsonstr := doc.Find(Selector).Text()
+	data, err := bs.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: []byte(doc.Find(Selector).Text())})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.BoxScoreScoring]{Error: err, Context: context}
+	}
+	diff := time.Now().UTC().Sub(start)
+	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
+	return sportscrape.EventDataOutput[model.BoxScoreScoring]{Context: context, Output: data}
+}
+
+// Parse builds the scoring box score of matchup from payload: the nba.com page, its
+// __NEXT_DATA__ script or the script's JSON.
+func (bs *BoxScoreScoringScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.BoxScoreScoring, error) {
+	jsonstr, err := NextData(payload.Body)
+	if err != nil {
+		return nil, err
+	}
+	pullTimestamp := payload.PullTimestamp
+	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	var jsonPayload jsonresponse.BoxScoreScoringJSON
 	var data []model.BoxScoreScoring
 
-	err = json.Unmarshal([]byte(jsonstr), &jsonPayload)
+	err = json.Unmarshal(jsonstr, &jsonPayload)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.BoxScoreScoring]{Error: err, Context: context}
+		return nil, err
 	}
 
 	// Check period matches with response payload data
 	if !bs.PeriodBasedBoxScoreDataAvailable(jsonPayload.Props.PageProps.Game.Period, jsonPayload.Props.PageProps.Game.GameStatus) {
-		return sportscrape.EventDataOutput[model.BoxScoreScoring]{Context: context}
+		return nil, nil
 	}
 
 	homeTeamFull := fmt.Sprintf("%s %s", jsonPayload.Props.PageProps.Game.HomeTeam.TeamCity, jsonPayload.Props.PageProps.Game.HomeTeam.TeamName)
@@ -159,7 +184,7 @@ func (bs *BoxScoreScoringScraper) Scrape(matchup model.Matchup) sportscrape.Even
 		if stats.Statistics.Minutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreScoring]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.Minutes = minutes
 		}
@@ -209,14 +234,12 @@ func (bs *BoxScoreScoringScraper) Scrape(matchup model.Matchup) sportscrape.Even
 		if stats.Statistics.Minutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreScoring]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.Minutes = minutes
 		}
 		data = append(data, boxscore)
 	}
 
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
-	return sportscrape.EventDataOutput[model.BoxScoreScoring]{Context: context, Output: data}
+	return data, nil
 }
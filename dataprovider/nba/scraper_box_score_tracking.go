@@ -1,6 +1,7 @@
 package nba
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
 	"github.com/xitongsys/parquet-go/types"
 )
@@ -30,6 +32,13 @@ func WithBoxScoreTrackingDebug(debug bool) BoxScoreTrackingScraperOption {
 	}
 }
 
+// WithBoxScoreTrackingHTTPFirst enables or disables fetching pages with plain HTTP before falling back to the browser for box score tracking scraper
+func WithBoxScoreTrackingHTTPFirst(httpFirst bool) BoxScoreTrackingScraperOption {
+	return func(bs *BoxScoreTrackingScraper) {
+		bs.HTTPFirst = httpFirst
+	}
+}
+
 // NewBoxScoreTrackingScraper creates a new BoxScoreTrackingScraper with the provided options
 func NewBoxScoreTrackingScraper(options ...BoxScoreTrackingScraperOption) *BoxScoreTrackingScraper {
 	bs := &BoxScoreTrackingScraper{}
@@ -46,19 +55,19 @@ type BoxScoreTrackingScraper struct {
 	BaseEventDataScraper
 }
 
-func (bs *BoxScoreTrackingScraper) Init() {
+func (bs *BoxScoreTrackingScraper) Init() error {
 	// FeedType is BoxScore
 	bs.FeedType = BoxScore
 	// FeedType is Tracking
 	bs.BoxScoreType = Tracking
 	// Base validations
-	bs.BaseEventDataScraper.Init()
+	return bs.BaseEventDataScraper.Init()
 }
 func (bs *BoxScoreTrackingScraper) Feed() sportscrape.Feed {
 	return sportscrape.NBATrackingBoxScore
 }
 
-func (bs *BoxScoreTrackingScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreTracking] {
+func (bs *BoxScoreTrackingScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreTracking] {
 	start := time.Now().UTC()
 	context := bs.ConstructContext(matchup)
 	url, err := bs.URL(matchup.ShareURL)
@@ -67,23 +76,39 @@ func (bs *BoxScoreTrackingScraper) Scrape(matchup model.Matchup) sportscrape.Eve
 	}
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
-	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	context.PullTimestamp = pullTimestamp
-	doc, err := bs.FetchDoc(url, Selector)
+	doc, err := bs.FetchNextData(ctx, url)
 	if err != nil {
 		return sportscrape.EventDataOutput[model.BoxScoreTracking]{Error: err, Context: context}
 	}
-	jsonstr := doc.Find(Selector).Text()
+	data, err := bs.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: []byte(doc.Find(Selector).Text())})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.BoxScoreTracking]{Error: err, Context: context}
+	}
+	diff := time.Now().UTC().Sub(start)
+	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
+	return sportscrape.EventDataOutput[model.BoxScoreTracking]{Context: context, Output: data}
+}
+
+// Parse builds the tracking box score of matchup from payload: the nba.com page, its
+// __NEXT_DATA__ script or the script's JSON.
+func (bs *BoxScoreTrackingScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.BoxScoreTracking, error) {
+	jsonstr, err := NextData(payload.Body)
+	if err != nil {
+		return nil, err
+	}
+	pullTimestamp := payload.PullTimestamp
+	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	var jsonPayload jsonresponse.BoxScoreTrackingJSON
 	var data []model.BoxScoreTracking
 
-	err = json.Unmarshal([]byte(jsonstr), &jsonPayload)
+	err = json.Unmarshal(jsonstr, &jsonPayload)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.BoxScoreTracking]{Error: err, Context: context}
+		return nil, err
 	}
 	// Check period matches with response payload data
 	if !bs.NonPeriodBasedBoxScoreDataAvailable(jsonPayload.Props.PageProps.Game.GameStatus) {
-		return sportscrape.EventDataOutput[model.BoxScoreTracking]{Context: context}
+		return nil, nil
 	}
 	homeTeamFull := fmt.Sprintf("%s %s", jsonPayload.Props.PageProps.Game.HomeTeam.TeamCity, jsonPayload.Props.PageProps.Game.HomeTeam.TeamName)
 	awayTeamFull := fmt.Sprintf("%s %s", jsonPayload.Props.PageProps.Game.AwayTeam.TeamCity, jsonPayload.Props.PageProps.Game.AwayTeam.TeamName)
@@ -136,7 +161,7 @@ func (bs *BoxScoreTrackingScraper) Scrape(matchup model.Matchup) sportscrape.Eve
 		if stats.Statistics.Minutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreTracking]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.Minutes = minutes
 		}
@@ -191,14 +216,12 @@ func (bs *BoxScoreTrackingScraper) Scrape(matchup model.Matchup) sportscrape.Eve
 		if stats.Statistics.Minutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreTracking]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.Minutes = minutes
 		}
 		data = append(data, boxscore)
 	}
 
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
-	return sportscrape.EventDataOutput[model.BoxScoreTracking]{Context: context, Output: data}
+	return data, nil
 }
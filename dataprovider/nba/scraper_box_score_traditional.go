@@ -1,6 +1,7 @@
 package nba
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
 	"github.com/xitongsys/parquet-go/types"
 )
@@ -37,6 +39,13 @@ func WithBoxScoreTraditionalDebug(debug bool) BoxScoreTraditionalScraperOption {
 	}
 }
 
+// WithBoxScoreTraditionalHTTPFirst enables or disables fetching pages with plain HTTP before falling back to the browser for box score traditional scraper
+func WithBoxScoreTraditionalHTTPFirst(httpFirst bool) BoxScoreTraditionalScraperOption {
+	return func(bs *BoxScoreTraditionalScraper) {
+		bs.HTTPFirst = httpFirst
+	}
+}
+
 // NewBoxScoreTraditionalScraper creates a new BoxScoreTraditionalScraper with the provided options
 func NewBoxScoreTraditionalScraper(options ...BoxScoreTraditionalScraperOption) *BoxScoreTraditionalScraper {
 	bs := &BoxScoreTraditionalScraper{}
@@ -53,13 +62,13 @@ type BoxScoreTraditionalScraper struct {
 	BaseEventDataScraper
 }
 
-func (bs *BoxScoreTraditionalScraper) Init() {
+func (bs *BoxScoreTraditionalScraper) Init() error {
 	// FeedType is BoxScore
 	bs.FeedType = BoxScore
 	// FeedType is Traditional
 	bs.BoxScoreType = Traditional
 	// Base validations
-	bs.BaseEventDataScraper.Init()
+	return bs.BaseEventDataScraper.Init()
 }
 func (bs *BoxScoreTraditionalScraper) Feed() sportscrape.Feed {
 	switch bs.Period {
@@ -84,7 +93,7 @@ func (bs *BoxScoreTraditionalScraper) Feed() sportscrape.Feed {
 	}
 }
 
-func (bs *BoxScoreTraditionalScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreTraditional] {
+func (bs *BoxScoreTraditionalScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreTraditional] {
 	start := time.Now().UTC()
 	context := bs.ConstructContext(matchup)
 	url, err := bs.URL(matchup.ShareURL)
@@ -93,24 +102,40 @@ func (bs *BoxScoreTraditionalScraper) Scrape(matchup model.Matchup) sportscrape.
 	}
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
-	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	context.PullTimestamp = pullTimestamp
-	doc, err := bs.FetchDoc(url, Selector)
+	doc, err := bs.FetchNextData(ctx, url)
 	if err != nil {
 		return sportscrape.EventDataOutput[model.BoxScoreTraditional]{Error: err, Context: context}
 	}
-	jsonstr := doc.Find(Selector).Text()
+	data, err := bs.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: []byte(doc.Find(Selector).Text())})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.BoxScoreTraditional]{Error: err, Context: context}
+	}
+	diff := time.Now().UTC().Sub(start)
+	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
+	return sportscrape.EventDataOutput[model.BoxScoreTraditional]{Context: context, Output: data}
+}
+
+// Parse builds the traditional box score of matchup from payload: the nba.com page, its
+// __NEXT_DATA__ script or the script's JSON.
+func (bs *BoxScoreTraditionalScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.BoxScoreTraditional, error) {
+	jsonstr, err := NextData(payload.Body)
+	if err != nil {
+		return nil, err
+	}
+	pullTimestamp := payload.PullTimestamp
+	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	var jsonPayload jsonresponse.BoxScoreTraditionalJSON
 	var data []model.BoxScoreTraditional
 
-	err = json.Unmarshal([]byte(jsonstr), &jsonPayload)
+	err = json.Unmarshal(jsonstr, &jsonPayload)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.BoxScoreTraditional]{Error: err, Context: context}
+		return nil, err
 	}
 
 	// Check period matches with response payload data
 	if !bs.PeriodBasedBoxScoreDataAvailable(jsonPayload.Props.PageProps.Game.Period, jsonPayload.Props.PageProps.Game.GameStatus) {
-		return sportscrape.EventDataOutput[model.BoxScoreTraditional]{Context: context}
+		return nil, nil
 	}
 
 	homeTeamFull := fmt.Sprintf("%s %s", jsonPayload.Props.PageProps.Game.HomeTeam.TeamCity, jsonPayload.Props.PageProps.Game.HomeTeam.TeamName)
@@ -163,7 +188,7 @@ func (bs *BoxScoreTraditionalScraper) Scrape(matchup model.Matchup) sportscrape.
 		if stats.Statistics.Minutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreTraditional]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.Minutes = minutes
 		}
@@ -217,14 +242,12 @@ func (bs *BoxScoreTraditionalScraper) Scrape(matchup model.Matchup) sportscrape.
 		if stats.Statistics.Minutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreTraditional]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.Minutes = minutes
 		}
 		data = append(data, boxscore)
 	}
 
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
-	return sportscrape.EventDataOutput[model.BoxScoreTraditional]{Context: context, Output: data}
+	return data, nil
 }
@@ -1,6 +1,7 @@
 package nba
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
 	"github.com/xitongsys/parquet-go/types"
 )
@@ -37,6 +39,13 @@ func WithBoxScoreUsageDebug(debug bool) BoxScoreUsageScraperOption {
 	}
 }
 
+// WithBoxScoreUsageHTTPFirst enables or disables fetching pages with plain HTTP before falling back to the browser for box score usage scraper
+func WithBoxScoreUsageHTTPFirst(httpFirst bool) BoxScoreUsageScraperOption {
+	return func(bs *BoxScoreUsageScraper) {
+		bs.HTTPFirst = httpFirst
+	}
+}
+
 // NewBoxScoreUsageScraper creates a new BoxScoreUsageScraper with the provided options
 func NewBoxScoreUsageScraper(options ...BoxScoreUsageScraperOption) *BoxScoreUsageScraper {
 	bs := &BoxScoreUsageScraper{}
@@ -53,13 +62,13 @@ type BoxScoreUsageScraper struct {
 	BaseEventDataScraper
 }
 
-func (bs *BoxScoreUsageScraper) Init() {
+func (bs *BoxScoreUsageScraper) Init() error {
 	// FeedType is BoxScore
 	bs.FeedType = BoxScore
 	// FeedType is Usage
 	bs.BoxScoreType = Usage
 	// Base validations
-	bs.BaseEventDataScraper.Init()
+	return bs.BaseEventDataScraper.Init()
 }
 func (bs *BoxScoreUsageScraper) Feed() sportscrape.Feed {
 	switch bs.Period {
@@ -84,7 +93,7 @@ func (bs *BoxScoreUsageScraper) Feed() sportscrape.Feed {
 	}
 }
 
-func (bs *BoxScoreUsageScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreUsage] {
+func (bs *BoxScoreUsageScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.BoxScoreUsage] {
 	start := time.Now().UTC()
 	context := bs.ConstructContext(matchup)
 	url, err := bs.URL(matchup.ShareURL)
@@ -93,24 +102,40 @@ func (bs *BoxScoreUsageScraper) Scrape(matchup model.Matchup) sportscrape.EventD
 	}
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
-	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	context.PullTimestamp = pullTimestamp
-	doc, err := bs.FetchDoc(url, Selector)
+	doc, err := bs.FetchNextData(ctx, url)
 	if err != nil {
 		return sportscrape.EventDataOutput[model.BoxScoreUsage]{Error: err, Context: context}
 	}
-	jsonstr := doc.Find(Selector).Text()
+	data, err := bs.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: []byte(doc.Find(Selector).Text())})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.BoxScoreUsage]{Error: err, Context: context}
+	}
+	diff := time.Now().UTC().Sub(start)
+	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
+	return sportscrape.EventDataOutput[model.BoxScoreUsage]{Context: context, Output: data}
+}
+
+// Parse builds the usage box score of matchup from payload: the nba.com page, its
+// __NEXT_DATA__ script or the script's JSON.
+func (bs *BoxScoreUsageScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.BoxScoreUsage, error) {
+	jsonstr, err := NextData(payload.Body)
+	if err != nil {
+		return nil, err
+	}
+	pullTimestamp := payload.PullTimestamp
+	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	var jsonPayload jsonresponse.BoxScoreUsageJSON
 	var data []model.BoxScoreUsage
 
-	err = json.Unmarshal([]byte(jsonstr), &jsonPayload)
+	err = json.Unmarshal(jsonstr, &jsonPayload)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.BoxScoreUsage]{Error: err, Context: context}
+		return nil, err
 	}
 
 	// Check period matches with response payload data
 	if !bs.PeriodBasedBoxScoreDataAvailable(jsonPayload.Props.PageProps.Game.Period, jsonPayload.Props.PageProps.Game.GameStatus) {
-		return sportscrape.EventDataOutput[model.BoxScoreUsage]{Context: context}
+		return nil, nil
 	}
 
 	homeTeamFull := fmt.Sprintf("%s %s", jsonPayload.Props.PageProps.Game.HomeTeam.TeamCity, jsonPayload.Props.PageProps.Game.HomeTeam.TeamName)
@@ -162,7 +187,7 @@ func (bs *BoxScoreUsageScraper) Scrape(matchup model.Matchup) sportscrape.EventD
 		if stats.Statistics.Minutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreUsage]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.Minutes = minutes
 		}
@@ -215,14 +240,12 @@ func (bs *BoxScoreUsageScraper) Scrape(matchup model.Matchup) sportscrape.EventD
 		if stats.Statistics.Minutes != "" {
 			minutes, err := util.TransformMinutesPlayed(stats.Statistics.Minutes)
 			if err != nil {
-				return sportscrape.EventDataOutput[model.BoxScoreUsage]{Error: err, Context: context}
+				return nil, err
 			}
 			boxscore.Minutes = minutes
 		}
 		data = append(data, boxscore)
 	}
 
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
-	return sportscrape.EventDataOutput[model.BoxScoreUsage]{Context: context, Output: data}
+	return data, nil
 }
@@ -1,6 +1,7 @@
 package nba
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 
@@ -35,6 +36,13 @@ func WithMatchupDebug(debug bool) MatchupScraperOption {
 	}
 }
 
+// WithMatchupHTTPFirst enables or disables fetching pages with plain HTTP before falling back to the browser for matchup scraper
+func WithMatchupHTTPFirst(httpFirst bool) MatchupScraperOption {
+	return func(ms *MatchupScraper) {
+		ms.HTTPFirst = httpFirst
+	}
+}
+
 // NewMatchupScraper creates a new MatchupScraper with the provided options
 func NewMatchupScraper(options ...MatchupScraperOption) *MatchupScraper {
 	ms := &MatchupScraper{}
@@ -55,7 +63,7 @@ func (ms *MatchupScraper) Feed() sportscrape.Feed {
 	return sportscrape.NBAMatchup
 }
 
-func (ms *MatchupScraper) Scrape() sportscrape.MatchupOutput[model.Matchup] {
+func (ms *MatchupScraper) Scrape(ctx context.Context) sportscrape.MatchupOutput[model.Matchup] {
 	var matchups []model.Matchup
 	var jsonPayload jsonresponse.MatchupJSON
 	output := sportscrape.MatchupOutput[model.Matchup]{}
@@ -67,7 +75,7 @@ func (ms *MatchupScraper) Scrape() sportscrape.MatchupOutput[model.Matchup] {
 		return output
 	}
 	pullts := time.Now().UTC()
-	doc, err := ms.FetchDoc(url, Selector)
+	doc, err := ms.FetchNextData(ctx, url)
 	if err != nil {
 		output.Error = err
 		return output
@@ -1,6 +1,7 @@
 package nba
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 
@@ -35,6 +36,13 @@ func WithMatchupPeriodsDebug(debug bool) MatchupPeriodsScraperOption {
 	}
 }
 
+// WithMatchupPeriodsHTTPFirst enables or disables fetching pages with plain HTTP before falling back to the browser for matchup periods scraper
+func WithMatchupPeriodsHTTPFirst(httpFirst bool) MatchupPeriodsScraperOption {
+	return func(ms *MatchupPeriodsScraper) {
+		ms.HTTPFirst = httpFirst
+	}
+}
+
 // NewMatchupPeriodsScraper creates a new MatchupPeriodsScraper with the provided options
 func NewMatchupPeriodsScraper(options ...MatchupPeriodsScraperOption) *MatchupPeriodsScraper {
 	ms := &MatchupPeriodsScraper{}
@@ -55,7 +63,7 @@ func (ms *MatchupPeriodsScraper) Feed() sportscrape.Feed {
 	return sportscrape.NBAMatchupPeriods
 }
 
-func (ms *MatchupPeriodsScraper) Scrape() sportscrape.MatchupOutput[model.MatchupPeriods] {
+func (ms *MatchupPeriodsScraper) Scrape(ctx context.Context) sportscrape.MatchupOutput[model.MatchupPeriods] {
 	var matchupPeriods []model.MatchupPeriods
 	var jsonPayload jsonresponse.MatchupJSON
 	output := sportscrape.MatchupOutput[model.MatchupPeriods]{}
@@ -67,7 +75,7 @@ func (ms *MatchupPeriodsScraper) Scrape() sportscrape.MatchupOutput[model.Matchu
 		return output
 	}
 	pullts := time.Now().UTC()
-	doc, err := ms.FetchDoc(url, Selector)
+	doc, err := ms.FetchNextData(ctx, url)
 	if err != nil {
 		output.Error = err
 		return output
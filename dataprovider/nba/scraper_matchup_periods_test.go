@@ -3,31 +3,33 @@
 package nba
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/internal/cassettetest"
 	"github.com/lightning-dabbler/sportscrape/runner"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestMatchupPeriodsScraper(t *testing.T) {
 	// https://www.nba.com/games?date=2025-06-05
-	if testing.Short() {
-		t.Skip("Skipping integration test")
-	}
+	cassette := cassettetest.Open(t)
+	ctx := cassette.Context(context.Background())
 
 	matchupScraper := NewMatchupPeriodsScraper(
 		WithMatchupPeriodsDate("2025-06-05"),
 		WithMatchupPeriodsTimeout(3*time.Minute),
 	)
 	matchupScraper.NetworkHeaders = NetworkHeaders
+	matchupScraper.DocumentRetriever = cassettetest.DocumentRetriever(t, cassette, NetworkHeaders)
 	matchuprunner := runner.NewMatchupRunner(
 		runner.MatchupRunnerConfig[model.MatchupPeriods]{
 			Scraper: matchupScraper,
 		},
 	)
-	records, err := matchuprunner.Run()
+	records, err := matchuprunner.RunContext(ctx)
 	assert.NoError(t, err)
 	n_records := len(records)
 	assert.Equal(t, 4, n_records, "4 records")
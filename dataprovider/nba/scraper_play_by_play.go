@@ -1,6 +1,7 @@
 package nba
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/jsonresponse"
 	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/lightning-dabbler/sportscrape/scraper"
 	"github.com/lightning-dabbler/sportscrape/util"
 	"github.com/xitongsys/parquet-go/types"
 )
@@ -29,6 +31,13 @@ func WithPlayByPlayDebug(debug bool) PlayByPlayScraperOption {
 	}
 }
 
+// WithPlayByPlayHTTPFirst enables or disables fetching pages with plain HTTP before falling back to the browser for play by play scraper
+func WithPlayByPlayHTTPFirst(httpFirst bool) PlayByPlayScraperOption {
+	return func(pbp *PlayByPlayScraper) {
+		pbp.HTTPFirst = httpFirst
+	}
+}
+
 // NewPlayByPlayScraper creates a new PlayByPlayScraper with the provided options
 func NewPlayByPlayScraper(options ...PlayByPlayScraperOption) *PlayByPlayScraper {
 	pbp := &PlayByPlayScraper{}
@@ -45,19 +54,19 @@ type PlayByPlayScraper struct {
 	BaseEventDataScraper
 }
 
-func (pbp *PlayByPlayScraper) Init() {
+func (pbp *PlayByPlayScraper) Init() error {
 	// Full is currently the only supported period for play by play
 	pbp.Period = Full
 	// FeedType is PlayByPlay
 	pbp.FeedType = PlayByPlay
 	// Base validations
-	pbp.BaseEventDataScraper.Init()
+	return pbp.BaseEventDataScraper.Init()
 }
 func (pbp *PlayByPlayScraper) Feed() sportscrape.Feed {
 	return sportscrape.NBAPlayByPlay
 }
 
-func (pbp *PlayByPlayScraper) Scrape(matchup model.Matchup) sportscrape.EventDataOutput[model.PlayByPlay] {
+func (pbp *PlayByPlayScraper) Scrape(ctx context.Context, matchup model.Matchup) sportscrape.EventDataOutput[model.PlayByPlay] {
 	start := time.Now().UTC()
 	context := pbp.ConstructContext(matchup)
 	url, err := pbp.URL(matchup.ShareURL)
@@ -66,18 +75,34 @@ func (pbp *PlayByPlayScraper) Scrape(matchup model.Matchup) sportscrape.EventDat
 	}
 	context.URL = url
 	pullTimestamp := time.Now().UTC()
-	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	context.PullTimestamp = pullTimestamp
-	doc, err := pbp.FetchDoc(url, Selector)
+	doc, err := pbp.FetchNextData(ctx, url)
 	if err != nil {
 		return sportscrape.EventDataOutput[model.PlayByPlay]{Error: err, Context: context}
 	}
+	data, err := pbp.Parse(matchup, scraper.Payload{URL: url, PullTimestamp: pullTimestamp, Body: []byte(doc.Find(Selector).Text())})
+	if err != nil {
+		return sportscrape.EventDataOutput[model.PlayByPlay]{Error: err, Context: context}
+	}
+	diff := time.Now().UTC().Sub(start)
+	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
+	return sportscrape.EventDataOutput[model.PlayByPlay]{Context: context, Output: data}
+}
+
+// Parse builds the play by play of matchup from payload: the nba.com page, its
+// __NEXT_DATA__ script or the script's JSON.
+func (pbp *PlayByPlayScraper) Parse(matchup model.Matchup, payload scraper.Payload) ([]model.PlayByPlay, error) {
+	jsonstr, err := NextData(payload.Body)
+	if err != nil {
+		return nil, err
+	}
+	pullTimestamp := payload.PullTimestamp
+	pullTimestampParquet := types.TimeToTIMESTAMP_MILLIS(pullTimestamp, true)
 	var jsonPayload jsonresponse.PlayByPlayJSON
 	var data []model.PlayByPlay
-	jsonstr := doc.Find(Selector).Text()
-	err = json.Unmarshal([]byte(jsonstr), &jsonPayload)
+	err = json.Unmarshal(jsonstr, &jsonPayload)
 	if err != nil {
-		return sportscrape.EventDataOutput[model.PlayByPlay]{Error: err, Context: context}
+		return nil, err
 	}
 	for _, action := range jsonPayload.Props.PageProps.PlayByPlay.Actions {
 		playbyplay := model.PlayByPlay{
@@ -110,13 +135,11 @@ func (pbp *PlayByPlayScraper) Scrape(matchup model.Matchup) sportscrape.EventDat
 		}
 		mins, err := util.TransformMinutesPlayed(action.Clock)
 		if err != nil {
-			return sportscrape.EventDataOutput[model.PlayByPlay]{Error: err, Context: context}
+			return nil, err
 		}
 		playbyplay.Clock = mins
 
 		data = append(data, playbyplay)
 	}
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of event %s (%s vs %s) completed in %s\n", context.EventID, context.AwayTeam, context.HomeTeam, diff)
-	return sportscrape.EventDataOutput[model.PlayByPlay]{Context: context, Output: data}
+	return data, nil
 }
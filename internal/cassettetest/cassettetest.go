@@ -0,0 +1,66 @@
+// Package cassettetest wires request.Cassette into scraper tests.
+package cassettetest
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chromedp/cdproto/network"
+	"github.com/lightning-dabbler/sportscrape/util/request"
+)
+
+// Path returns the cassette of t: testdata/<test name>.json in the package of the test.
+func Path(t testing.TB) string {
+	return filepath.Join("testdata", strings.ReplaceAll(t.Name(), "/", "_")+".json")
+}
+
+// Open opens the cassette of t (see Path) in the mode of
+// request.CassetteModeFromEnv and, when recording, saves it once t and its
+// subtests finished. Without a recorded cassette t runs against the live
+// sites, or is skipped in short mode.
+//
+// Record the cassettes against the live sites with make record-cassettes.
+func Open(t testing.TB) *request.Cassette {
+	t.Helper()
+	path := Path(t)
+	mode := request.CassetteModeFromEnv()
+	record := mode == request.ModeRecord
+	if mode == request.ModeReplay {
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			if testing.Short() {
+				t.Skipf("cassette %s not recorded, record it with make record-cassettes", path)
+			}
+			// Fetch from the live sites, leaving the cassette unrecorded
+			mode = request.ModeRecord
+		}
+	}
+	cassette, err := request.OpenCassette(path, mode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record {
+		t.Cleanup(func() {
+			if err := cassette.Save(); err != nil {
+				t.Errorf("saving cassette %s: %v", path, err)
+			}
+		})
+	}
+	return cassette
+}
+
+// DocumentRetriever returns a DocumentRetrieverV2 fetching through cassette,
+// see request.Cassette.NewDocumentRetriever. Recording loads the documents
+// with a 3 minute timeout unless options set another.
+func DocumentRetriever(t testing.TB, cassette *request.Cassette, networkHeaders network.Headers, options ...request.RetrieverOptionV2) *request.DocumentRetrieverV2 {
+	t.Helper()
+	options = append([]request.RetrieverOptionV2{request.WithTimeoutV2(3 * time.Minute)}, options...)
+	dr, err := cassette.NewDocumentRetriever(networkHeaders, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dr
+}
@@ -5,6 +5,8 @@
 package scraper
 
 import (
+	"context"
+
 	"github.com/lightning-dabbler/sportscrape"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -114,9 +116,20 @@ func (_c *MockEventDataScraper_Feed_Call[M, E]) RunAndReturn(run func() sportscr
 }
 
 // Init provides a mock function for the type MockEventDataScraper
-func (_mock *MockEventDataScraper[M, E]) Init() {
-	_mock.Called()
-	return
+func (_mock *MockEventDataScraper[M, E]) Init() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Init")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
 // MockEventDataScraper_Init_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Init'
@@ -136,13 +149,13 @@ func (_c *MockEventDataScraper_Init_Call[M, E]) Run(run func()) *MockEventDataSc
 	return _c
 }
 
-func (_c *MockEventDataScraper_Init_Call[M, E]) Return() *MockEventDataScraper_Init_Call[M, E] {
-	_c.Call.Return()
+func (_c *MockEventDataScraper_Init_Call[M, E]) Return(err error) *MockEventDataScraper_Init_Call[M, E] {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockEventDataScraper_Init_Call[M, E]) RunAndReturn(run func()) *MockEventDataScraper_Init_Call[M, E] {
-	_c.Run(run)
+func (_c *MockEventDataScraper_Init_Call[M, E]) RunAndReturn(run func() error) *MockEventDataScraper_Init_Call[M, E] {
+	_c.Call.Return(run)
 	return _c
 }
 
@@ -191,16 +204,16 @@ func (_c *MockEventDataScraper_Provider_Call[M, E]) RunAndReturn(run func() spor
 }
 
 // Scrape provides a mock function for the type MockEventDataScraper
-func (_mock *MockEventDataScraper[M, E]) Scrape(matchup M) sportscrape.EventDataOutput[E] {
-	ret := _mock.Called(matchup)
+func (_mock *MockEventDataScraper[M, E]) Scrape(ctx context.Context, matchup M) sportscrape.EventDataOutput[E] {
+	ret := _mock.Called(ctx, matchup)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Scrape")
 	}
 
 	var r0 sportscrape.EventDataOutput[E]
-	if returnFunc, ok := ret.Get(0).(func(M) sportscrape.EventDataOutput[E]); ok {
-		r0 = returnFunc(matchup)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, M) sportscrape.EventDataOutput[E]); ok {
+		r0 = returnFunc(ctx, matchup)
 	} else {
 		r0 = ret.Get(0).(sportscrape.EventDataOutput[E])
 	}
@@ -213,19 +226,25 @@ type MockEventDataScraper_Scrape_Call[M any, E any] struct {
 }
 
 // Scrape is a helper method to define mock.On call
+//   - ctx context.Context
 //   - matchup M
-func (_e *MockEventDataScraper_Expecter[M, E]) Scrape(matchup interface{}) *MockEventDataScraper_Scrape_Call[M, E] {
-	return &MockEventDataScraper_Scrape_Call[M, E]{Call: _e.mock.On("Scrape", matchup)}
+func (_e *MockEventDataScraper_Expecter[M, E]) Scrape(ctx interface{}, matchup interface{}) *MockEventDataScraper_Scrape_Call[M, E] {
+	return &MockEventDataScraper_Scrape_Call[M, E]{Call: _e.mock.On("Scrape", ctx, matchup)}
 }
 
-func (_c *MockEventDataScraper_Scrape_Call[M, E]) Run(run func(matchup M)) *MockEventDataScraper_Scrape_Call[M, E] {
+func (_c *MockEventDataScraper_Scrape_Call[M, E]) Run(run func(ctx context.Context, matchup M)) *MockEventDataScraper_Scrape_Call[M, E] {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 M
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(M)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 M
+		if args[1] != nil {
+			arg1 = args[1].(M)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -236,7 +255,7 @@ func (_c *MockEventDataScraper_Scrape_Call[M, E]) Return(eventDataOutput sportsc
 	return _c
 }
 
-func (_c *MockEventDataScraper_Scrape_Call[M, E]) RunAndReturn(run func(matchup M) sportscrape.EventDataOutput[E]) *MockEventDataScraper_Scrape_Call[M, E] {
+func (_c *MockEventDataScraper_Scrape_Call[M, E]) RunAndReturn(run func(ctx context.Context, matchup M) sportscrape.EventDataOutput[E]) *MockEventDataScraper_Scrape_Call[M, E] {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,6 +5,8 @@
 package scraper
 
 import (
+	"context"
+
 	"github.com/lightning-dabbler/sportscrape"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -114,9 +116,20 @@ func (_c *MockMatchupScraper_Feed_Call[M]) RunAndReturn(run func() sportscrape.F
 }
 
 // Init provides a mock function for the type MockMatchupScraper
-func (_mock *MockMatchupScraper[M]) Init() {
-	_mock.Called()
-	return
+func (_mock *MockMatchupScraper[M]) Init() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Init")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
 // MockMatchupScraper_Init_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Init'
@@ -136,13 +149,13 @@ func (_c *MockMatchupScraper_Init_Call[M]) Run(run func()) *MockMatchupScraper_I
 	return _c
 }
 
-func (_c *MockMatchupScraper_Init_Call[M]) Return() *MockMatchupScraper_Init_Call[M] {
-	_c.Call.Return()
+func (_c *MockMatchupScraper_Init_Call[M]) Return(err error) *MockMatchupScraper_Init_Call[M] {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockMatchupScraper_Init_Call[M]) RunAndReturn(run func()) *MockMatchupScraper_Init_Call[M] {
-	_c.Run(run)
+func (_c *MockMatchupScraper_Init_Call[M]) RunAndReturn(run func() error) *MockMatchupScraper_Init_Call[M] {
+	_c.Call.Return(run)
 	return _c
 }
 
@@ -191,16 +204,16 @@ func (_c *MockMatchupScraper_Provider_Call[M]) RunAndReturn(run func() sportscra
 }
 
 // Scrape provides a mock function for the type MockMatchupScraper
-func (_mock *MockMatchupScraper[M]) Scrape() sportscrape.MatchupOutput[M] {
-	ret := _mock.Called()
+func (_mock *MockMatchupScraper[M]) Scrape(ctx context.Context) sportscrape.MatchupOutput[M] {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Scrape")
 	}
 
 	var r0 sportscrape.MatchupOutput[M]
-	if returnFunc, ok := ret.Get(0).(func() sportscrape.MatchupOutput[M]); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(context.Context) sportscrape.MatchupOutput[M]); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		r0 = ret.Get(0).(sportscrape.MatchupOutput[M])
 	}
@@ -213,13 +226,20 @@ type MockMatchupScraper_Scrape_Call[M any] struct {
 }
 
 // Scrape is a helper method to define mock.On call
-func (_e *MockMatchupScraper_Expecter[M]) Scrape() *MockMatchupScraper_Scrape_Call[M] {
-	return &MockMatchupScraper_Scrape_Call[M]{Call: _e.mock.On("Scrape")}
+//   - ctx context.Context
+func (_e *MockMatchupScraper_Expecter[M]) Scrape(ctx interface{}) *MockMatchupScraper_Scrape_Call[M] {
+	return &MockMatchupScraper_Scrape_Call[M]{Call: _e.mock.On("Scrape", ctx)}
 }
 
-func (_c *MockMatchupScraper_Scrape_Call[M]) Run(run func()) *MockMatchupScraper_Scrape_Call[M] {
+func (_c *MockMatchupScraper_Scrape_Call[M]) Run(run func(ctx context.Context)) *MockMatchupScraper_Scrape_Call[M] {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
@@ -229,7 +249,7 @@ func (_c *MockMatchupScraper_Scrape_Call[M]) Return(matchupOutput sportscrape.Ma
 	return _c
 }
 
-func (_c *MockMatchupScraper_Scrape_Call[M]) RunAndReturn(run func() sportscrape.MatchupOutput[M]) *MockMatchupScraper_Scrape_Call[M] {
+func (_c *MockMatchupScraper_Scrape_Call[M]) RunAndReturn(run func(ctx context.Context) sportscrape.MatchupOutput[M]) *MockMatchupScraper_Scrape_Call[M] {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,123 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape/util"
+)
+
+// ErrInvalidDateRange is returned by BackfillRunner when EndDate is before StartDate.
+var ErrInvalidDateRange = errors.New("end date is before start date")
+
+// BackfillRunnerConfig
+type BackfillRunnerConfig[M any] struct {
+	// StartDate is the first day (YYYY-MM-DD) to scrape.
+	StartDate string
+	// EndDate is the last day (YYYY-MM-DD) to scrape, inclusive.
+	EndDate string
+	// Concurrency is the number of days scraped at once. Default 1.
+	Concurrency int
+	// Pipeline builds the pipeline scraping the matchups (and their event data) of a single day.
+	Pipeline func(date string) (PipelineConfig[M], error)
+	// Observer is set on every day's pipeline that has no Observer of its own. Default nil = none.
+	Observer Observer
+}
+
+// NewBackfillRunner Instantiates a new BackfillRunner
+func NewBackfillRunner[M any](config BackfillRunnerConfig[M]) *BackfillRunner[M] {
+	return &BackfillRunner[M]{
+		StartDate:   config.StartDate,
+		EndDate:     config.EndDate,
+		Concurrency: config.Concurrency,
+		Pipeline:    config.Pipeline,
+		Observer:    config.Observer,
+	}
+}
+
+// BackfillRunner runs a Pipeline for every day of a date range. Days without
+// matchups (off-days) are skipped by the Pipeline without running its stages.
+type BackfillRunner[M any] struct {
+	StartDate   string
+	EndDate     string
+	Concurrency int
+	Pipeline    func(date string) (PipelineConfig[M], error)
+	Observer    Observer
+}
+
+// Run scrapes every day from StartDate to EndDate. A failing day does not stop
+// the others; their errors are joined. Cancelling ctx stops scraping new days.
+func (b *BackfillRunner[M]) Run(ctx context.Context) error {
+	dates, err := DateRange(b.StartDate, b.EndDate)
+	if err != nil {
+		return err
+	}
+	concurrency := max(b.Concurrency, 1)
+	log.Printf("Backfilling %d day(s) from %s to %s\n", len(dates), b.StartDate, b.EndDate)
+	start := time.Now().UTC()
+
+	var mu sync.Mutex
+	var errs error
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for _, date := range dates {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := b.runDate(ctx, date); err != nil {
+				mu.Lock()
+				errs = errors.Join(errs, fmt.Errorf("%s: %w", date, err))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return errors.Join(errs, fmt.Errorf("backfill cancelled: %w", err))
+	}
+	log.Printf("Backfill of %d day(s) completed in %s\n", len(dates), time.Now().UTC().Sub(start))
+	return errs
+}
+
+func (b *BackfillRunner[M]) runDate(ctx context.Context, date string) error {
+	config, err := b.Pipeline(date)
+	if err != nil {
+		return err
+	}
+	if config.Observer == nil {
+		config.Observer = b.Observer
+	}
+	return NewPipeline(config).Run(ctx)
+}
+
+// DateRange returns every day (YYYY-MM-DD) from start to end, both inclusive.
+func DateRange(start, end string) ([]string, error) {
+	from, err := util.DateStrToTime(start)
+	if err != nil {
+		return nil, err
+	}
+	to, err := util.DateStrToTime(end)
+	if err != nil {
+		return nil, err
+	}
+	if to.Before(from) {
+		return nil, fmt.Errorf("%w: %s < %s", ErrInvalidDateRange, end, start)
+	}
+	var dates []string
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		dates = append(dates, day.Format(time.DateOnly))
+	}
+	return dates, nil
+}
@@ -0,0 +1,139 @@
+//go:build unit
+
+package runner
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/lightning-dabbler/sportscrape"
+	"github.com/lightning-dabbler/sportscrape/internal/mocks/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDateRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    string
+		end      string
+		expected []string
+		err      error
+	}{
+		{name: "single day", start: "2025-02-28", end: "2025-02-28", expected: []string{"2025-02-28"}},
+		{name: "month boundary", start: "2025-02-27", end: "2025-03-02", expected: []string{"2025-02-27", "2025-02-28", "2025-03-01", "2025-03-02"}},
+		{name: "reversed", start: "2025-03-02", end: "2025-02-27", err: ErrInvalidDateRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dates, err := DateRange(tt.start, tt.end)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, dates)
+		})
+	}
+	_, err := DateRange("2025-2-27", "2025-03-02")
+	assert.Error(t, err)
+}
+
+func TestBackfillRunner(t *testing.T) {
+	type fakeMatchup struct{ Date string }
+	type fakeEvent struct{ Date string }
+	matchupsByDate := map[string][]fakeMatchup{
+		"2025-04-01": {{"2025-04-01"}, {"2025-04-01"}},
+		"2025-04-02": nil, // off-day
+		"2025-04-03": {{"2025-04-03"}},
+	}
+
+	var mu sync.Mutex
+	var sunk []fakeEvent
+	backfill := NewBackfillRunner(
+		BackfillRunnerConfig[fakeMatchup]{
+			StartDate:   "2025-04-01",
+			EndDate:     "2025-04-03",
+			Concurrency: 2,
+			Pipeline: func(date string) (PipelineConfig[fakeMatchup], error) {
+				matchupscraper := scraper.NewMockMatchupScraper[fakeMatchup](t)
+				matchupscraper.EXPECT().Init().Return(nil)
+				matchupscraper.EXPECT().Scrape(mock.Anything).Return(sportscrape.MatchupOutput[fakeMatchup]{
+					Output: matchupsByDate[date],
+				}).Once()
+				matchupscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+				matchupscraper.EXPECT().Provider().Return(sportscrape.DummyProvider)
+				matchupscraper.EXPECT().Close().Once()
+
+				eventscraper := scraper.NewMockEventDataScraper[fakeMatchup, fakeEvent](t)
+				// Stages never start on an off-day
+				if len(matchupsByDate[date]) > 0 {
+					eventscraper.EXPECT().Init().Return(nil)
+					eventscraper.EXPECT().Close().Once()
+					eventscraper.EXPECT().Scrape(mock.Anything, mock.Anything).RunAndReturn(
+						func(ctx context.Context, m fakeMatchup) sportscrape.EventDataOutput[fakeEvent] {
+							return sportscrape.EventDataOutput[fakeEvent]{Output: []fakeEvent{{m.Date}}}
+						},
+					).Times(len(matchupsByDate[date]))
+					eventscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+					eventscraper.EXPECT().Provider().Return(sportscrape.DummyProvider)
+				}
+				return PipelineConfig[fakeMatchup]{
+					Scraper: matchupscraper,
+					Stages: []Stage[fakeMatchup]{
+						NewStage(
+							EventDataRunnerConfig[fakeMatchup, fakeEvent]{Concurrency: 1, Scraper: eventscraper},
+							func(ctx context.Context, records []fakeEvent) error {
+								mu.Lock()
+								defer mu.Unlock()
+								sunk = append(sunk, records...)
+								return nil
+							},
+						),
+					},
+				}, nil
+			},
+		},
+	)
+	assert.NoError(t, backfill.Run(context.Background()))
+	sort.Slice(sunk, func(i, j int) bool { return sunk[i].Date < sunk[j].Date })
+	assert.Equal(t, []fakeEvent{{"2025-04-01"}, {"2025-04-01"}, {"2025-04-03"}}, sunk)
+}
+
+func TestBackfillRunnerErrors(t *testing.T) {
+	var mu sync.Mutex
+	var dates []string
+	backfill := NewBackfillRunner(
+		BackfillRunnerConfig[int]{
+			StartDate: "2025-04-01",
+			EndDate:   "2025-04-02",
+			Pipeline: func(date string) (PipelineConfig[int], error) {
+				mu.Lock()
+				defer mu.Unlock()
+				dates = append(dates, date)
+				return PipelineConfig[int]{}, assert.AnError
+			},
+		},
+	)
+	err := backfill.Run(context.Background())
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.ErrorContains(t, err, "2025-04-01: ")
+	assert.ErrorContains(t, err, "2025-04-02: ")
+	assert.Equal(t, []string{"2025-04-01", "2025-04-02"}, dates)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = NewBackfillRunner(
+		BackfillRunnerConfig[int]{
+			StartDate: "2025-04-01",
+			EndDate:   "2025-04-02",
+			Pipeline: func(date string) (PipelineConfig[int], error) {
+				t.Errorf("unexpected pipeline for %s", date)
+				return PipelineConfig[int]{}, nil
+			},
+		},
+	).Run(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,181 @@
+package runner
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape"
+)
+
+// CheckpointKey identifies a completed event in a CheckpointStore.
+type CheckpointKey struct {
+	Feed sportscrape.Feed `json:"feed"`
+	// EventID is the string form of EventDataContext.EventID.
+	EventID string `json:"event_id"`
+	// Period distinguishes scrapes of the same feed for different periods (e.g. nba.Q1). Empty when not applicable.
+	Period string `json:"period"`
+}
+
+// NewCheckpointKey builds the CheckpointKey of eventID.
+func NewCheckpointKey(feed sportscrape.Feed, eventID any, period string) CheckpointKey {
+	return CheckpointKey{Feed: feed, EventID: fmt.Sprint(eventID), Period: period}
+}
+
+// CheckpointStore records events whose data was scraped successfully so
+// reruns can skip them. Implementations must be safe for concurrent use.
+type CheckpointStore interface {
+	// Completed reports whether key was recorded by Complete.
+	Completed(key CheckpointKey) (bool, error)
+	// Complete records key.
+	Complete(key CheckpointKey) error
+}
+
+// Checkpoint configures an EventDataRunner to skip events recorded in Store
+// and to record the events it completes.
+type Checkpoint[M any] struct {
+	Store CheckpointStore
+	// Event returns the event ID of matchup and whether the event was final
+	// (e.g. the game ended) when the matchup was scraped. Events that are not
+	// final are never recorded, so they stay eligible for rescraping.
+	Event func(matchup M) (eventID any, final bool)
+	// Period is set on every CheckpointKey, see CheckpointKey.Period.
+	Period string
+}
+
+// pending returns the matchups not yet completed and the IDs of the final events among them.
+func (c *Checkpoint[M]) pending(feed sportscrape.Feed, matchups []M) ([]M, map[string]bool) {
+	var remaining []M
+	final := map[string]bool{}
+	for _, matchup := range matchups {
+		eventID, isFinal := c.Event(matchup)
+		key := NewCheckpointKey(feed, eventID, c.Period)
+		done, err := c.Store.Completed(key)
+		if err != nil {
+			log.Printf("warning: checkpoint lookup of %s %s failed, scraping it: %v\n", feed, key.EventID, err)
+		}
+		if done {
+			continue
+		}
+		if isFinal {
+			final[key.EventID] = true
+		}
+		remaining = append(remaining, matchup)
+	}
+	if skipped := len(matchups) - len(remaining); skipped != 0 {
+		log.Printf("Skipping %d already scraped event(s) of %s\n", skipped, feed)
+	}
+	return remaining, final
+}
+
+// complete records eventID if its event was final.
+func (c *Checkpoint[M]) complete(feed sportscrape.Feed, final map[string]bool, eventID any) {
+	key := NewCheckpointKey(feed, eventID, c.Period)
+	if !final[key.EventID] {
+		return
+	}
+	if err := c.Store.Complete(key); err != nil {
+		log.Printf("warning: checkpoint of %s %s failed: %v\n", feed, key.EventID, err)
+	}
+}
+
+// FileCheckpointStore is a CheckpointStore appending completed events to a
+// JSON lines file. Create one with OpenFileCheckpointStore.
+type FileCheckpointStore struct {
+	mu        sync.Mutex
+	file      *os.File
+	completed map[CheckpointKey]bool
+}
+
+type checkpointEntry struct {
+	CheckpointKey
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+// OpenFileCheckpointStore opens the checkpoint file at path, creating it if
+// needed, and loads the events it records.
+func OpenFileCheckpointStore(path string) (*FileCheckpointStore, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	s := &FileCheckpointStore{file: file, completed: map[CheckpointKey]bool{}}
+	reader := bufio.NewReader(file)
+	var offset int64
+	for line := 1; ; line++ {
+		b, err := reader.ReadBytes('\n')
+		if len(b) != 0 {
+			var entry checkpointEntry
+			if jsonErr := json.Unmarshal(b, &entry); jsonErr != nil {
+				if !errors.Is(err, io.EOF) {
+					file.Close()
+					return nil, fmt.Errorf("reading checkpoint %s line %d: %w", path, line, jsonErr)
+				}
+				// A partially written last line is expected after a crash, drop it
+				if err := file.Truncate(offset); err != nil {
+					file.Close()
+					return nil, err
+				}
+				break
+			}
+			s.completed[entry.CheckpointKey] = true
+			offset += int64(len(b))
+			if b[len(b)-1] != '\n' {
+				// Keep the next entry off the unterminated last line
+				if _, err := file.Write([]byte{'\n'}); err != nil {
+					file.Close()
+					return nil, err
+				}
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *FileCheckpointStore) Completed(key CheckpointKey) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.completed[key], nil
+}
+
+func (s *FileCheckpointStore) Complete(key CheckpointKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.completed[key] {
+		return nil
+	}
+	b, err := json.Marshal(checkpointEntry{CheckpointKey: key, CompletedAt: time.Now().UTC()})
+	if err != nil {
+		return err
+	}
+	if _, err := s.file.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	s.completed[key] = true
+	return nil
+}
+
+// Len returns the number of completed events recorded.
+func (s *FileCheckpointStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.completed)
+}
+
+// Close closes the checkpoint file.
+func (s *FileCheckpointStore) Close() error {
+	return s.file.Close()
+}
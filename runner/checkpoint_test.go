@@ -0,0 +1,152 @@
+//go:build unit
+
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lightning-dabbler/sportscrape"
+	"github.com/lightning-dabbler/sportscrape/internal/mocks/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFileCheckpointStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.jsonl")
+	key := NewCheckpointKey(sportscrape.DummyFeed, int64(42), "Q1")
+	assert.Equal(t, "42", key.EventID)
+
+	store, err := OpenFileCheckpointStore(path)
+	assert.NoError(t, err)
+	done, err := store.Completed(key)
+	assert.NoError(t, err)
+	assert.False(t, done)
+	assert.NoError(t, store.Complete(key))
+	assert.NoError(t, store.Complete(key))
+	assert.NoError(t, store.Close())
+
+	// Simulate a crash in the middle of writing an entry
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+	assert.NoError(t, err)
+	_, err = f.WriteString(`{"feed":"dummy`)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	store, err = OpenFileCheckpointStore(path)
+	assert.NoError(t, err)
+	done, err = store.Completed(key)
+	assert.NoError(t, err)
+	assert.True(t, done)
+	other := NewCheckpointKey(sportscrape.DummyFeed, int64(42), "Q2")
+	done, err = store.Completed(other)
+	assert.NoError(t, err)
+	assert.False(t, done)
+	assert.NoError(t, store.Complete(other))
+	assert.NoError(t, store.Close())
+
+	store, err = OpenFileCheckpointStore(path)
+	assert.NoError(t, err)
+	done, err = store.Completed(other)
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.NoError(t, store.Close())
+}
+
+type checkpointMatchup struct {
+	ID    int
+	Final bool
+}
+
+func checkpointEvent(m checkpointMatchup) (any, bool) {
+	return m.ID, m.Final
+}
+
+func TestEventDataRunnerCheckpoint(t *testing.T) {
+	type fakeEvent struct{ ID int }
+	store, err := OpenFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.jsonl"))
+	assert.NoError(t, err)
+	defer store.Close()
+	assert.NoError(t, store.Complete(NewCheckpointKey(sportscrape.DummyFeed, 3, "")))
+
+	mockscraper := scraper.NewMockEventDataScraper[checkpointMatchup, fakeEvent](t)
+	mockscraper.EXPECT().Init().Return(nil)
+	mockscraper.EXPECT().Scrape(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, m checkpointMatchup) sportscrape.EventDataOutput[fakeEvent] {
+			assert.NotEqual(t, 3, m.ID, "completed event scraped")
+			return sportscrape.EventDataOutput[fakeEvent]{
+				Context: sportscrape.EventDataContext{EventID: m.ID},
+				Output:  []fakeEvent{{m.ID}},
+			}
+		},
+	).Twice()
+	mockscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+	mockscraper.EXPECT().Provider().Return(sportscrape.DummyProvider)
+	mockscraper.EXPECT().Close().Once()
+
+	data, err := NewEventDataRunner(
+		EventDataRunnerConfig[checkpointMatchup, fakeEvent]{
+			Concurrency: 2,
+			Scraper:     mockscraper,
+			Checkpoint:  &Checkpoint[checkpointMatchup]{Store: store, Event: checkpointEvent},
+		},
+	).Run([]checkpointMatchup{{ID: 1, Final: true}, {ID: 2, Final: false}, {ID: 3, Final: true}})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []fakeEvent{{1}, {2}}, data)
+
+	for id, expected := range map[int]bool{1: true, 2: false, 3: true} {
+		done, err := store.Completed(NewCheckpointKey(sportscrape.DummyFeed, id, ""))
+		assert.NoError(t, err)
+		assert.Equal(t, expected, done, "event %d", id)
+	}
+}
+
+func TestPipelineCheckpointAfterSink(t *testing.T) {
+	type fakeEvent struct{ ID int }
+	store, err := OpenFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.jsonl"))
+	assert.NoError(t, err)
+	defer store.Close()
+
+	for _, sinkErr := range []error{assert.AnError, nil} {
+		matchupscraper := scraper.NewMockMatchupScraper[checkpointMatchup](t)
+		matchupscraper.EXPECT().Init().Return(nil)
+		matchupscraper.EXPECT().Scrape(mock.Anything).Return(sportscrape.MatchupOutput[checkpointMatchup]{
+			Output: []checkpointMatchup{{ID: 1, Final: true}},
+		}).Once()
+		matchupscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+		matchupscraper.EXPECT().Provider().Return(sportscrape.DummyProvider)
+		matchupscraper.EXPECT().Close().Once()
+
+		eventscraper := scraper.NewMockEventDataScraper[checkpointMatchup, fakeEvent](t)
+		eventscraper.EXPECT().Init().Return(nil)
+		eventscraper.EXPECT().Scrape(mock.Anything, mock.Anything).Return(sportscrape.EventDataOutput[fakeEvent]{
+			Context: sportscrape.EventDataContext{EventID: 1},
+			Output:  []fakeEvent{{1}},
+		}).Once()
+		eventscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+		eventscraper.EXPECT().Provider().Return(sportscrape.DummyProvider)
+		eventscraper.EXPECT().Close().Once()
+
+		err := NewPipeline(
+			PipelineConfig[checkpointMatchup]{
+				Scraper: matchupscraper,
+				Stages: []Stage[checkpointMatchup]{
+					NewStage(
+						EventDataRunnerConfig[checkpointMatchup, fakeEvent]{
+							Scraper:    eventscraper,
+							Checkpoint: &Checkpoint[checkpointMatchup]{Store: store, Event: checkpointEvent},
+						},
+						func(ctx context.Context, records []fakeEvent) error { return sinkErr },
+					),
+				},
+			},
+		).Run(context.Background())
+		assert.ErrorIs(t, err, sinkErr)
+
+		done, err := store.Completed(NewCheckpointKey(sportscrape.DummyFeed, 1, ""))
+		assert.NoError(t, err)
+		assert.Equal(t, sinkErr == nil, done)
+	}
+}
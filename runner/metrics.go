@@ -0,0 +1,243 @@
+package runner
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape"
+)
+
+// DefaultBuckets are the upper bounds, in seconds, of the Metrics latency histograms.
+var DefaultBuckets = []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300}
+
+// Metrics is an Observer collecting counters and latency histograms per
+// provider and feed, exposed in the Prometheus text exposition format
+// (e.g. for the node_exporter textfile collector). The zero value is not
+// usable; create one with NewMetrics.
+type Metrics struct {
+	mu      sync.Mutex
+	buckets []float64
+	series  map[seriesKey]*series
+}
+
+type seriesKey struct {
+	provider sportscrape.Provider
+	feed     sportscrape.Feed
+}
+
+type series struct {
+	runs          map[string]uint64
+	runDuration   *histogram
+	events        map[string]uint64
+	eventDuration *histogram
+	records       uint64
+	retries       uint64
+	inFlight      int64
+	lastRun       time.Time
+	lastSuccess   time.Time
+}
+
+type histogram struct {
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+func (h *histogram) observe(buckets []float64, v float64) {
+	for i, upper := range buckets {
+		if v <= upper {
+			h.counts[i]++
+		}
+	}
+	h.sum += v
+	h.count++
+}
+
+// NewMetrics creates an empty Metrics observer. buckets overrides DefaultBuckets.
+func NewMetrics(buckets ...float64) *Metrics {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	buckets = slices.Clone(buckets)
+	slices.Sort(buckets)
+	return &Metrics{buckets: buckets, series: map[seriesKey]*series{}}
+}
+
+// get returns the series of run, creating it if needed. m.mu must be held.
+func (m *Metrics) get(run RunInfo) *series {
+	key := seriesKey{provider: run.Provider, feed: run.Feed}
+	s, ok := m.series[key]
+	if !ok {
+		s = &series{
+			runs:          map[string]uint64{},
+			runDuration:   &histogram{counts: make([]uint64, len(m.buckets))},
+			events:        map[string]uint64{},
+			eventDuration: &histogram{counts: make([]uint64, len(m.buckets))},
+		}
+		m.series[key] = s
+	}
+	return s
+}
+
+func (m *Metrics) OnRunStart(ctx context.Context, run RunInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.get(run)
+}
+
+func (m *Metrics) OnEventStart(ctx context.Context, run RunInfo, matchup any) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.get(run).inFlight++
+}
+
+func (m *Metrics) OnEventDone(ctx context.Context, run RunInfo, event sportscrape.EventDataContext, records int, err error, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := m.get(run)
+	s.inFlight--
+	s.events[status(err)]++
+	s.eventDuration.observe(m.buckets, duration.Seconds())
+	if event.Attempts > 1 {
+		s.retries += uint64(event.Attempts - 1)
+	}
+	if err == nil {
+		s.records += uint64(records)
+	}
+}
+
+func (m *Metrics) OnRunEnd(ctx context.Context, run RunInfo, records int, err error, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := m.get(run)
+	s.runs[status(err)]++
+	s.runDuration.observe(m.buckets, duration.Seconds())
+	s.lastRun = time.Now()
+	if err == nil {
+		s.lastSuccess = s.lastRun
+	}
+	// A MatchupRunner has no events; count its records here instead.
+	if run.Events == 0 {
+		s.records += uint64(records)
+	}
+}
+
+func status(err error) string {
+	if err != nil {
+		return "failure"
+	}
+	return "success"
+}
+
+// WriteTo writes every metric to w in the Prometheus text exposition format.
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	keys := make([]seriesKey, 0, len(m.series))
+	for k := range m.series {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b seriesKey) int {
+		return cmp.Or(cmp.Compare(a.provider, b.provider), cmp.Compare(a.feed, b.feed))
+	})
+
+	var b bytes.Buffer
+	header := func(name, kind, help string) {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+	}
+	statuses := func(name string, counts func(*series) map[string]uint64) {
+		for _, k := range keys {
+			for _, st := range []string{"success", "failure"} {
+				fmt.Fprintf(&b, "%s{%s,status=%q} %d\n", name, labels(k), st, counts(m.series[k])[st])
+			}
+		}
+	}
+	histograms := func(name string, h func(*series) *histogram) {
+		for _, k := range keys {
+			hist := h(m.series[k])
+			for i, upper := range m.buckets {
+				fmt.Fprintf(&b, "%s_bucket{%s,le=%q} %d\n", name, labels(k), strconv.FormatFloat(upper, 'g', -1, 64), hist.counts[i])
+			}
+			fmt.Fprintf(&b, "%s_bucket{%s,le=\"+Inf\"} %d\n", name, labels(k), hist.count)
+			fmt.Fprintf(&b, "%s_sum{%s} %s\n", name, labels(k), strconv.FormatFloat(hist.sum, 'g', -1, 64))
+			fmt.Fprintf(&b, "%s_count{%s} %d\n", name, labels(k), hist.count)
+		}
+	}
+	values := func(name string, v func(*series) string) {
+		for _, k := range keys {
+			fmt.Fprintf(&b, "%s{%s} %s\n", name, labels(k), v(m.series[k]))
+		}
+	}
+	timestamp := func(t time.Time) string {
+		if t.IsZero() {
+			return "0"
+		}
+		return strconv.FormatFloat(float64(t.UnixMilli())/1000, 'f', 3, 64)
+	}
+
+	header("sportscrape_runs_total", "counter", "Runner runs by outcome.")
+	statuses("sportscrape_runs_total", func(s *series) map[string]uint64 { return s.runs })
+	header("sportscrape_run_duration_seconds", "histogram", "Runner run latency.")
+	histograms("sportscrape_run_duration_seconds", func(s *series) *histogram { return s.runDuration })
+	header("sportscrape_events_total", "counter", "Scraped events by outcome.")
+	statuses("sportscrape_events_total", func(s *series) map[string]uint64 { return s.events })
+	header("sportscrape_event_duration_seconds", "histogram", "Event scrape latency, retries included.")
+	histograms("sportscrape_event_duration_seconds", func(s *series) *histogram { return s.eventDuration })
+	header("sportscrape_records_total", "counter", "Records scraped.")
+	values("sportscrape_records_total", func(s *series) string { return strconv.FormatUint(s.records, 10) })
+	header("sportscrape_event_retries_total", "counter", "Event scrape retries.")
+	values("sportscrape_event_retries_total", func(s *series) string { return strconv.FormatUint(s.retries, 10) })
+	header("sportscrape_events_in_flight", "gauge", "Events being scraped.")
+	values("sportscrape_events_in_flight", func(s *series) string { return strconv.FormatInt(s.inFlight, 10) })
+	header("sportscrape_last_run_timestamp_seconds", "gauge", "Unix time the last run ended.")
+	values("sportscrape_last_run_timestamp_seconds", func(s *series) string { return timestamp(s.lastRun) })
+	header("sportscrape_last_success_timestamp_seconds", "gauge", "Unix time the last successful run ended.")
+	values("sportscrape_last_success_timestamp_seconds", func(s *series) string { return timestamp(s.lastSuccess) })
+
+	return b.WriteTo(w)
+}
+
+// WriteFile atomically replaces path with the metrics, so a textfile collector
+// never reads a partially written file.
+func (m *Metrics) WriteFile(path string) error {
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := m.WriteTo(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(0o644); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// labels renders the provider and feed labels of k.
+func labels(k seriesKey) string {
+	return fmt.Sprintf("provider=\"%s\",feed=\"%s\"", escape(string(k.provider)), escape(string(k.feed)))
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// escape escapes a label value per the Prometheus text format.
+func escape(v string) string {
+	return labelEscaper.Replace(v)
+}
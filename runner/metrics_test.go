@@ -0,0 +1,147 @@
+//go:build unit
+
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape"
+	"github.com/lightning-dabbler/sportscrape/internal/mocks/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type recordingObserver struct {
+	BaseObserver
+	mu     sync.Mutex
+	events []string
+	run    RunInfo
+	err    error
+}
+
+func (o *recordingObserver) OnRunStart(ctx context.Context, run RunInfo) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.events = append(o.events, "run start")
+	o.run = run
+}
+
+func (o *recordingObserver) OnEventDone(ctx context.Context, run RunInfo, event sportscrape.EventDataContext, records int, err error, duration time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.events = append(o.events, "event done")
+}
+
+func (o *recordingObserver) OnRunEnd(ctx context.Context, run RunInfo, records int, err error, duration time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.events = append(o.events, "run end")
+	o.err = err
+}
+
+func TestEventDataRunnerObserver(t *testing.T) {
+	type fakeMatchup struct{ ID int }
+	type fakeEvent struct{ ID int }
+	matchups := []fakeMatchup{{1}, {2}, {3}}
+	mockscraper := scraper.NewMockEventDataScraper[fakeMatchup, fakeEvent](t)
+	mockscraper.EXPECT().Init().Return(nil)
+	mockscraper.EXPECT().Scrape(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, m fakeMatchup) sportscrape.EventDataOutput[fakeEvent] {
+			ow := sportscrape.EventDataOutput[fakeEvent]{Context: sportscrape.EventDataContext{EventID: m.ID}}
+			if m.ID == 2 {
+				ow.Error = assert.AnError
+				return ow
+			}
+			ow.Output = []fakeEvent{{ID: m.ID}, {ID: m.ID}}
+			return ow
+		},
+	).Times(len(matchups))
+	mockscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+	mockscraper.EXPECT().Provider().Return(sportscrape.DummyProvider).Once()
+	mockscraper.EXPECT().Close().Once()
+
+	recorder := &recordingObserver{}
+	metrics := NewMetrics()
+	eventDataRunner := NewEventDataRunner(
+		EventDataRunnerConfig[fakeMatchup, fakeEvent]{
+			Concurrency:      2,
+			Scraper:          mockscraper,
+			PartialResults:   true,
+			FailureThreshold: 50,
+			Observer:         Observers(recorder, nil, metrics),
+		},
+	)
+	_, err := eventDataRunner.RunContext(context.Background(), matchups)
+	assert.NoError(t, err)
+
+	assert.Equal(t, RunInfo{Provider: sportscrape.DummyProvider, Feed: sportscrape.DummyFeed, Events: 3}, recorder.run)
+	assert.Equal(t, []string{"run start", "event done", "event done", "event done", "run end"}, recorder.events)
+	assert.ErrorIs(t, recorder.err, assert.AnError)
+
+	var b strings.Builder
+	_, err = metrics.WriteTo(&b)
+	assert.NoError(t, err)
+	out := b.String()
+	labels := `provider="dummy provider",feed="dummy provider dummy feed"`
+	for _, line := range []string{
+		"# TYPE sportscrape_runs_total counter",
+		`sportscrape_runs_total{` + labels + `,status="failure"} 1`,
+		`sportscrape_runs_total{` + labels + `,status="success"} 0`,
+		`sportscrape_events_total{` + labels + `,status="success"} 2`,
+		`sportscrape_events_total{` + labels + `,status="failure"} 1`,
+		`sportscrape_event_duration_seconds_bucket{` + labels + `,le="+Inf"} 3`,
+		`sportscrape_event_duration_seconds_count{` + labels + `} 3`,
+		`sportscrape_run_duration_seconds_count{` + labels + `} 1`,
+		`sportscrape_records_total{` + labels + `} 4`,
+		`sportscrape_events_in_flight{` + labels + `} 0`,
+		`sportscrape_last_success_timestamp_seconds{` + labels + `} 0`,
+	} {
+		assert.Contains(t, out, line+"\n")
+	}
+}
+
+func TestMatchupRunnerObserver(t *testing.T) {
+	mockscraper := scraper.NewMockMatchupScraper[any](t)
+	mockscraper.EXPECT().Init().Return(nil)
+	mockscraper.EXPECT().Scrape(mock.Anything).Return(sportscrape.MatchupOutput[any]{Output: []any{1, 2}}).Once()
+	mockscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+	mockscraper.EXPECT().Provider().Return(sportscrape.DummyProvider).Once()
+	mockscraper.EXPECT().Close().Once()
+
+	recorder := &recordingObserver{}
+	_, err := NewMatchupRunner(
+		MatchupRunnerConfig[any]{Scraper: mockscraper, Observer: recorder},
+	).Run()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"run start", "run end"}, recorder.events)
+	assert.NoError(t, recorder.err)
+}
+
+func TestMetricsWriteFile(t *testing.T) {
+	metrics := NewMetrics(1, 0.5)
+	run := RunInfo{Provider: sportscrape.DummyProvider, Feed: `a "quoted" feed`}
+	metrics.OnRunStart(context.Background(), run)
+	metrics.OnRunEnd(context.Background(), run, 5, nil, 750*time.Millisecond)
+
+	path := filepath.Join(t.TempDir(), "sportscrape.prom")
+	assert.NoError(t, metrics.WriteFile(path))
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	out := string(data)
+	labels := `provider="dummy provider",feed="a \"quoted\" feed"`
+	assert.Contains(t, out, `sportscrape_run_duration_seconds_bucket{`+labels+`,le="0.5"} 0`+"\n")
+	assert.Contains(t, out, `sportscrape_run_duration_seconds_bucket{`+labels+`,le="1"} 1`+"\n")
+	assert.Contains(t, out, `sportscrape_run_duration_seconds_sum{`+labels+`} 0.75`+"\n")
+	assert.Contains(t, out, `sportscrape_records_total{`+labels+`} 5`+"\n")
+	assert.NotContains(t, out, `sportscrape_last_success_timestamp_seconds{`+labels+`} 0`+"\n")
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
@@ -0,0 +1,90 @@
+package runner
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape"
+)
+
+// RunInfo identifies the runner an Observer hook was fired from.
+type RunInfo struct {
+	Provider sportscrape.Provider
+	Feed     sportscrape.Feed
+	// Events is the number of matchups handed to an EventDataRunner; always 0 for a MatchupRunner.
+	Events int
+}
+
+// Observer receives lifecycle notifications from MatchupRunner and EventDataRunner.
+// Hooks are called synchronously, OnEventStart and OnEventDone from concurrent
+// workers, so implementations must be safe for concurrent use and return quickly.
+type Observer interface {
+	// OnRunStart is called before the scraper is initialized.
+	OnRunStart(ctx context.Context, run RunInfo)
+	// OnEventStart is called by an EventDataRunner worker before scraping matchup.
+	OnEventStart(ctx context.Context, run RunInfo, matchup any)
+	// OnEventDone is called by an EventDataRunner worker once matchup was scraped,
+	// including retries. event.Attempts holds the number of attempts made.
+	OnEventDone(ctx context.Context, run RunInfo, event sportscrape.EventDataContext, records int, err error, duration time.Duration)
+	// OnRunEnd is called once the run completed. err joins the run error with
+	// every event error, so it is non-nil when any event failed.
+	OnRunEnd(ctx context.Context, run RunInfo, records int, err error, duration time.Duration)
+}
+
+// BaseObserver implements every Observer hook as a no-op. Embed it to only
+// implement the hooks of interest.
+type BaseObserver struct{}
+
+func (BaseObserver) OnRunStart(context.Context, RunInfo)        {}
+func (BaseObserver) OnEventStart(context.Context, RunInfo, any) {}
+func (BaseObserver) OnEventDone(context.Context, RunInfo, sportscrape.EventDataContext, int, error, time.Duration) {
+}
+func (BaseObserver) OnRunEnd(context.Context, RunInfo, int, error, time.Duration) {}
+
+// Observers fans every hook out to each of observers in order. nil observers are skipped.
+func Observers(observers ...Observer) Observer {
+	var o multiObserver
+	for _, observer := range observers {
+		if observer != nil {
+			o = append(o, observer)
+		}
+	}
+	return o
+}
+
+type multiObserver []Observer
+
+func (m multiObserver) OnRunStart(ctx context.Context, run RunInfo) {
+	for _, o := range m {
+		o.OnRunStart(ctx, run)
+	}
+}
+
+func (m multiObserver) OnEventStart(ctx context.Context, run RunInfo, matchup any) {
+	for _, o := range m {
+		o.OnEventStart(ctx, run, matchup)
+	}
+}
+
+func (m multiObserver) OnEventDone(ctx context.Context, run RunInfo, event sportscrape.EventDataContext, records int, err error, duration time.Duration) {
+	for _, o := range m {
+		o.OnEventDone(ctx, run, event, records, err, duration)
+	}
+}
+
+func (m multiObserver) OnRunEnd(ctx context.Context, run RunInfo, records int, err error, duration time.Duration) {
+	for _, o := range m {
+		o.OnRunEnd(ctx, run, records, err, duration)
+	}
+}
+
+// observerOrNop returns o, or a no-op Observer when o is nil.
+func observerOrNop(o Observer) Observer {
+	if o == nil {
+		return BaseObserver{}
+	}
+	return o
+}
+
+// runInfoKey carries the RunInfo of an EventDataRunner run to its workers.
+type runInfoKey struct{}
@@ -0,0 +1,200 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/lightning-dabbler/sportscrape"
+	"github.com/lightning-dabbler/sportscrape/scraper"
+	"github.com/lightning-dabbler/sportscrape/util/request"
+)
+
+// Sink consumes the records produced by a pipeline stage, e.g. by writing them
+// to a file or forwarding them to another system.
+type Sink[T any] func(ctx context.Context, records []T) error
+
+// lifecycle is the subset of the scraper interfaces the pipeline manages.
+type lifecycle interface {
+	Init() error
+	Close()
+	Feed() sportscrape.Feed
+	Provider() sportscrape.Provider
+}
+
+// Stage is an event data feed driven by the matchups of a Pipeline.
+// Create one with NewStage.
+type Stage[M any] interface {
+	// Feed is the feed scraped by the stage.
+	Feed() sportscrape.Feed
+	scraper() lifecycle
+	run(ctx context.Context, matchups []M, observer Observer) error
+}
+
+type stage[M, E any] struct {
+	config EventDataRunnerConfig[M, E]
+	sink   Sink[E]
+}
+
+// NewStage creates a pipeline Stage that scrapes event data with config and
+// hands the records to sink. config.KeepAlive is ignored; the pipeline manages
+// the scraper's lifecycle.
+func NewStage[M, E any](config EventDataRunnerConfig[M, E], sink Sink[E]) Stage[M] {
+	config.KeepAlive = true
+	return &stage[M, E]{config: config, sink: sink}
+}
+
+func (s *stage[M, E]) Feed() sportscrape.Feed {
+	return s.config.Scraper.Feed()
+}
+
+func (s *stage[M, E]) scraper() lifecycle {
+	return s.config.Scraper
+}
+
+func (s *stage[M, E]) run(ctx context.Context, matchups []M, observer Observer) error {
+	config := s.config
+	if config.Observer == nil {
+		config.Observer = observer
+	}
+	runner := NewEventDataRunner(config)
+	// Only record events once the sink wrote them
+	runner.deferCheckpoint = true
+	records, report, err := runner.RunWithReport(ctx, matchups)
+	if err != nil {
+		return err
+	}
+	if s.sink != nil {
+		if err := s.sink(ctx, records); err != nil {
+			return err
+		}
+	}
+	runner.checkpoint(report)
+	return nil
+}
+
+// PipelineConfig
+type PipelineConfig[M any] struct {
+	// Scraper retrieves the matchups fed to every stage.
+	Scraper scraper.MatchupScraper[M]
+	// RetryPolicy retries a failed matchup scrape. Default nil = no retries.
+	RetryPolicy *RetryPolicy
+	// MatchupSink, when set, receives the scraped matchups.
+	MatchupSink Sink[M]
+	// Stages are run one after another over the same matchups.
+	Stages []Stage[M]
+	// Observer is notified of the matchup run and of every stage without an
+	// Observer of its own. Default nil = none.
+	Observer Observer
+}
+
+// NewPipeline Instantiates a new Pipeline
+func NewPipeline[M any](config PipelineConfig[M]) *Pipeline[M] {
+	return &Pipeline[M]{
+		Scraper:     config.Scraper,
+		RetryPolicy: config.RetryPolicy,
+		MatchupSink: config.MatchupSink,
+		Stages:      config.Stages,
+		Observer:    config.Observer,
+	}
+}
+
+// Pipeline scrapes matchups once and fans them out to any number of event data
+// stages, each writing to its own sink. Scrapers backed by a DocumentRetrieverV2
+// (see scraper.DocumentScraper) share a single browser session, and every
+// scraper is closed when Run returns.
+type Pipeline[M any] struct {
+	Scraper     scraper.MatchupScraper[M]
+	RetryPolicy *RetryPolicy
+	MatchupSink Sink[M]
+	Stages      []Stage[M]
+	Observer    Observer
+}
+
+// Run scrapes the matchups, writes them to MatchupSink and runs every stage.
+// A failing stage does not stop the stages after it; their errors are joined.
+// A failed matchup scrape or MatchupSink aborts the run, and stages are skipped
+// when there are no matchups (e.g. on an off-day).
+func (p *Pipeline[M]) Run(ctx context.Context) error {
+	session := &browserSession{}
+	defer session.close()
+
+	session.attach(p.Scraper)
+	matchups, err := NewMatchupRunner(
+		MatchupRunnerConfig[M]{
+			Scraper:     p.Scraper,
+			KeepAlive:   true,
+			RetryPolicy: p.RetryPolicy,
+			Observer:    p.Observer,
+		},
+	).RunContext(ctx)
+	session.adopt(p.Scraper)
+	if err != nil {
+		return err
+	}
+	if p.MatchupSink != nil {
+		if err := p.MatchupSink(ctx, matchups); err != nil {
+			return fmt.Errorf("%s: %w", p.Scraper.Feed(), err)
+		}
+	}
+	if len(matchups) == 0 {
+		log.Printf("No matchups for %s, skipping %d stage(s)\n", p.Scraper.Feed(), len(p.Stages))
+		return nil
+	}
+
+	var errs error
+	for _, stage := range p.Stages {
+		if err := ctx.Err(); err != nil {
+			return errors.Join(errs, err)
+		}
+		s := stage.scraper()
+		session.attach(s)
+		err := stage.run(ctx, matchups, p.Observer)
+		session.adopt(s)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", stage.Feed(), err))
+		}
+	}
+	return errs
+}
+
+// browserSession tracks the scrapers of a pipeline run and the
+// DocumentRetrieverV2 they share, so the browser is launched and closed once.
+type browserSession struct {
+	retriever *request.DocumentRetrieverV2
+	owner     lifecycle
+	scrapers  []lifecycle
+}
+
+// attach registers s and hands it the shared retriever, if there is one.
+func (b *browserSession) attach(s lifecycle) {
+	b.scrapers = append(b.scrapers, s)
+	if ds, ok := s.(scraper.DocumentScraper); ok && b.retriever != nil {
+		ds.SetDocumentRetriever(b.retriever)
+	}
+}
+
+// adopt takes the retriever created by s's Init as the shared one if none is
+// shared yet.
+func (b *browserSession) adopt(s lifecycle) {
+	if b.retriever != nil {
+		return
+	}
+	if ds, ok := s.(scraper.DocumentScraper); ok && ds.GetDocumentRetriever() != nil {
+		b.retriever = ds.GetDocumentRetriever()
+		b.owner = s
+	}
+}
+
+// close closes every scraper in reverse order. Scrapers borrowing the shared
+// retriever are detached first so only its owner tears the browser down.
+func (b *browserSession) close() {
+	for i := len(b.scrapers) - 1; i >= 0; i-- {
+		s := b.scrapers[i]
+		if ds, ok := s.(scraper.DocumentScraper); ok && s != b.owner && ds.GetDocumentRetriever() == b.retriever {
+			ds.SetDocumentRetriever(nil)
+		}
+		s.Close()
+	}
+}
@@ -0,0 +1,154 @@
+//go:build unit
+
+package runner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightning-dabbler/sportscrape"
+	"github.com/lightning-dabbler/sportscrape/internal/mocks/scraper"
+	basescraper "github.com/lightning-dabbler/sportscrape/scraper"
+	"github.com/lightning-dabbler/sportscrape/util/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPipeline(t *testing.T) {
+	type fakeMatchup struct{ ID int }
+	type fakeEventA struct{ ID int }
+	type fakeEventB struct{ ID int }
+
+	matchupscraper := scraper.NewMockMatchupScraper[fakeMatchup](t)
+	matchupscraper.EXPECT().Init().Return(nil)
+	matchupscraper.EXPECT().Scrape(mock.Anything).Return(sportscrape.MatchupOutput[fakeMatchup]{
+		Output: []fakeMatchup{{1}, {2}},
+	}).Once()
+	matchupscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+	matchupscraper.EXPECT().Provider().Return(sportscrape.DummyProvider)
+	matchupscraper.EXPECT().Close().Once()
+
+	scraperA := scraper.NewMockEventDataScraper[fakeMatchup, fakeEventA](t)
+	scraperA.EXPECT().Init().Return(nil)
+	scraperA.EXPECT().Scrape(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, m fakeMatchup) sportscrape.EventDataOutput[fakeEventA] {
+			return sportscrape.EventDataOutput[fakeEventA]{Output: []fakeEventA{{m.ID}}}
+		},
+	).Twice()
+	scraperA.EXPECT().Feed().Return(sportscrape.DummyFeed)
+	scraperA.EXPECT().Provider().Return(sportscrape.DummyProvider)
+	scraperA.EXPECT().Close().Once()
+
+	scraperB := scraper.NewMockEventDataScraper[fakeMatchup, fakeEventB](t)
+	scraperB.EXPECT().Init().Return(nil)
+	scraperB.EXPECT().Scrape(mock.Anything, mock.Anything).Return(sportscrape.EventDataOutput[fakeEventB]{
+		Error: assert.AnError,
+	}).Twice()
+	scraperB.EXPECT().Feed().Return(sportscrape.DummyFeed)
+	scraperB.EXPECT().Provider().Return(sportscrape.DummyProvider)
+	scraperB.EXPECT().Close().Once()
+
+	var sunkMatchups []fakeMatchup
+	var sunkA []fakeEventA
+	sinkBCalled := false
+	pipeline := NewPipeline(
+		PipelineConfig[fakeMatchup]{
+			Scraper: matchupscraper,
+			MatchupSink: func(ctx context.Context, records []fakeMatchup) error {
+				sunkMatchups = records
+				return nil
+			},
+			Stages: []Stage[fakeMatchup]{
+				NewStage(
+					EventDataRunnerConfig[fakeMatchup, fakeEventB]{Concurrency: 1, Scraper: scraperB},
+					func(ctx context.Context, records []fakeEventB) error {
+						sinkBCalled = true
+						return nil
+					},
+				),
+				NewStage(
+					EventDataRunnerConfig[fakeMatchup, fakeEventA]{Concurrency: 2, Scraper: scraperA},
+					func(ctx context.Context, records []fakeEventA) error {
+						sunkA = records
+						return nil
+					},
+				),
+			},
+		},
+	)
+	err := pipeline.Run(context.Background())
+	assert.ErrorIs(t, err, assert.AnError, "failing stage error is returned")
+	assert.Equal(t, []fakeMatchup{{1}, {2}}, sunkMatchups)
+	assert.False(t, sinkBCalled, "sink is skipped when its stage fails")
+	assert.ElementsMatch(t, []fakeEventA{{1}, {2}}, sunkA, "later stages run after a failing stage")
+}
+
+type fakeDocumentMatchupScraper struct {
+	basescraper.BaseDocumentScraper
+	closed int
+}
+
+func (s *fakeDocumentMatchupScraper) Init() error {
+	if s.DocumentRetriever == nil {
+		s.DocumentRetriever = &request.DocumentRetrieverV2{}
+	}
+	return nil
+}
+func (s *fakeDocumentMatchupScraper) Close() {
+	s.closed++
+	s.BaseDocumentScraper.Close()
+}
+func (s *fakeDocumentMatchupScraper) Feed() sportscrape.Feed { return sportscrape.DummyFeed }
+func (s *fakeDocumentMatchupScraper) Provider() sportscrape.Provider {
+	return sportscrape.DummyProvider
+}
+func (s *fakeDocumentMatchupScraper) Scrape(ctx context.Context) sportscrape.MatchupOutput[int] {
+	return sportscrape.MatchupOutput[int]{Output: []int{1}}
+}
+
+type fakeDocumentEventScraper struct {
+	basescraper.BaseDocumentScraper
+	closed int
+	seen   *request.DocumentRetrieverV2
+}
+
+func (s *fakeDocumentEventScraper) Init() error {
+	if s.DocumentRetriever == nil {
+		s.DocumentRetriever = &request.DocumentRetrieverV2{}
+	}
+	return nil
+}
+func (s *fakeDocumentEventScraper) Close() {
+	s.closed++
+	s.BaseDocumentScraper.Close()
+}
+func (s *fakeDocumentEventScraper) Feed() sportscrape.Feed         { return sportscrape.DummyFeed }
+func (s *fakeDocumentEventScraper) Provider() sportscrape.Provider { return sportscrape.DummyProvider }
+func (s *fakeDocumentEventScraper) Scrape(ctx context.Context, matchup int) sportscrape.EventDataOutput[int] {
+	s.seen = s.DocumentRetriever
+	return sportscrape.EventDataOutput[int]{Output: []int{matchup}}
+}
+
+func TestPipelineSharesDocumentRetriever(t *testing.T) {
+	matchupscraper := &fakeDocumentMatchupScraper{}
+	first := &fakeDocumentEventScraper{}
+	second := &fakeDocumentEventScraper{}
+	pipeline := NewPipeline(
+		PipelineConfig[int]{
+			Scraper: matchupscraper,
+			Stages: []Stage[int]{
+				NewStage(EventDataRunnerConfig[int, int]{Scraper: first}, nil),
+				NewStage(EventDataRunnerConfig[int, int]{Scraper: second}, nil),
+			},
+		},
+	)
+	assert.NoError(t, pipeline.Run(context.Background()))
+	assert.NotNil(t, first.seen)
+	assert.Same(t, first.seen, second.seen, "stages share one browser session")
+	for _, closed := range []int{matchupscraper.closed, first.closed, second.closed} {
+		assert.Equal(t, 1, closed, "every scraper is closed exactly once")
+	}
+	assert.Nil(t, matchupscraper.DocumentRetriever)
+	assert.Nil(t, first.DocumentRetriever)
+	assert.Nil(t, second.DocumentRetriever)
+}
@@ -0,0 +1,131 @@
+package runner
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape"
+)
+
+// RawExtension is the file extension of an encoded RawEvent: gzipped JSON.
+const RawExtension = ".json.gz"
+
+// RawPayload is a payload fetched while scraping an event: the JSON or HTML
+// its records were parsed from.
+type RawPayload struct {
+	URL  string `json:"url"`
+	Body string `json:"body"`
+}
+
+// RawEvent holds every payload fetched for an event scrape, so its records can
+// be re-derived after the site changes.
+type RawEvent struct {
+	Provider      sportscrape.Provider `json:"provider"`
+	Feed          sportscrape.Feed     `json:"feed"`
+	EventID       string               `json:"event_id"`
+	PullTimestamp time.Time            `json:"pull_timestamp"`
+	// URL is the source URL of the event (EventDataContext.URL)
+	URL string `json:"url"`
+	// Matchup is the JSON of the matchup the event was scraped for
+	Matchup json.RawMessage `json:"matchup"`
+	// Payloads are in fetch order
+	Payloads []RawPayload `json:"payloads"`
+}
+
+// Key identifies the event scrape by provider, feed, event ID and pull
+// timestamp as a slash separated path, e.g.
+// nba/nba-play-by-play/0022500249/20251119T210412.123Z
+func (e RawEvent) Key() string {
+	return path.Join(RawKeySegment(string(e.Provider)), RawKeySegment(string(e.Feed)), RawKeySegment(e.EventID), e.PullTimestamp.UTC().Format("20060102T150405.000Z"))
+}
+
+// RawKeySegment makes s safe to use as a segment of a path or object key.
+func RawKeySegment(s string) string {
+	return strings.NewReplacer(" ", "-", "/", "-").Replace(s)
+}
+
+// EncodeRawEvent writes event to w as gzipped JSON.
+func EncodeRawEvent(w io.Writer, event RawEvent) error {
+	gz := gzip.NewWriter(w)
+	if err := json.NewEncoder(gz).Encode(event); err != nil {
+		gz.Close()
+		return err
+	}
+	return gz.Close()
+}
+
+// DecodeRawEvent reads a RawEvent written by EncodeRawEvent from r.
+func DecodeRawEvent(r io.Reader) (RawEvent, error) {
+	var event RawEvent
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return event, err
+	}
+	defer gz.Close()
+	err = json.NewDecoder(gz).Decode(&event)
+	return event, err
+}
+
+// RawSink archives the payloads of scraped events, see
+// EventDataRunnerConfig.RawSink. Implementations must be safe for concurrent use.
+type RawSink interface {
+	WriteRaw(ctx context.Context, event RawEvent) error
+}
+
+// rawRecorder collects the payloads fetched during the current scrape attempt of an event.
+type rawRecorder struct {
+	mu       sync.Mutex
+	payloads []RawPayload
+}
+
+func (r *rawRecorder) record(url string, payload []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.payloads = append(r.payloads, RawPayload{URL: url, Body: string(payload)})
+}
+
+func (r *rawRecorder) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.payloads = nil
+}
+
+// archive hands the payloads recorded while scraping matchup to sink. Events
+// are archived even when their scrape failed, as their payload is most useful
+// then. A failed archival is logged and does not fail the event.
+func archive[M, E any](ctx context.Context, sink RawSink, provider sportscrape.Provider, feed sportscrape.Feed, matchup M, ow sportscrape.EventDataOutput[E], recorder *rawRecorder) {
+	recorder.mu.Lock()
+	payloads := recorder.payloads
+	recorder.mu.Unlock()
+	if len(payloads) == 0 {
+		return
+	}
+	event := RawEvent{
+		Provider:      provider,
+		Feed:          feed,
+		EventID:       fmt.Sprint(ow.Context.EventID),
+		PullTimestamp: ow.Context.PullTimestamp,
+		URL:           ow.Context.URL,
+		Payloads:      payloads,
+	}
+	if event.PullTimestamp.IsZero() {
+		event.PullTimestamp = time.Now().UTC()
+	}
+	matchupJSON, err := json.Marshal(matchup)
+	if err != nil {
+		log.Printf("warning: archiving raw payload of %s %s failed: %v\n", feed, event.EventID, err)
+		return
+	}
+	event.Matchup = matchupJSON
+	if err := sink.WriteRaw(ctx, event); err != nil {
+		log.Printf("warning: archiving raw payload of %s %s failed: %v\n", feed, event.EventID, err)
+	}
+}
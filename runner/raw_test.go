@@ -0,0 +1,113 @@
+//go:build unit
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape"
+	"github.com/lightning-dabbler/sportscrape/internal/mocks/scraper"
+	"github.com/lightning-dabbler/sportscrape/util/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryRawSink struct {
+	mu     sync.Mutex
+	events map[string]RawEvent
+}
+
+func (s *memoryRawSink) WriteRaw(ctx context.Context, event RawEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events[event.EventID] = event
+	return nil
+}
+
+func TestRawEventEncoding(t *testing.T) {
+	event := RawEvent{
+		Provider:      sportscrape.NBA,
+		Feed:          sportscrape.NBAPlayByPlay,
+		EventID:       "0022500249",
+		PullTimestamp: time.Date(2025, 11, 19, 21, 4, 12, 123e6, time.UTC),
+		URL:           "https://www.nba.com/game/chi-vs-uta-0022500249/play-by-play",
+		Matchup:       json.RawMessage(`{"EventID":"0022500249"}`),
+		Payloads:      []RawPayload{{URL: "https://www.nba.com/game/chi-vs-uta-0022500249/play-by-play", Body: `<script id="__NEXT_DATA__">{}</script>`}},
+	}
+	assert.Equal(t, "nba/nba-play-by-play/0022500249/20251119T210412.123Z", event.Key())
+
+	var buf bytes.Buffer
+	require.NoError(t, EncodeRawEvent(&buf, event))
+	decoded, err := DecodeRawEvent(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, event, decoded)
+}
+
+func TestEventDataRunnerRawSink(t *testing.T) {
+	type fakeEvent struct{ ID int }
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"path":"` + r.URL.Path + `"}`))
+	}))
+	defer ts.Close()
+	pulled := time.Date(2025, 11, 19, 21, 4, 12, 0, time.UTC)
+
+	mockscraper := scraper.NewMockEventDataScraper[int, fakeEvent](t)
+	mockscraper.EXPECT().Init().Return(nil)
+	mockscraper.EXPECT().Scrape(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, id int) sportscrape.EventDataOutput[fakeEvent] {
+			output := sportscrape.EventDataOutput[fakeEvent]{
+				Context: sportscrape.EventDataContext{EventID: id, URL: ts.URL + "/source", PullTimestamp: pulled},
+			}
+			if id == 3 {
+				// Nothing fetched
+				output.Error = assert.AnError
+				return output
+			}
+			resp, err := request.GetContext(ctx, ts.URL+"/event")
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			_, err = io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			if id == 2 {
+				output.Error = assert.AnError
+				return output
+			}
+			output.Output = []fakeEvent{{id}}
+			return output
+		},
+	).Times(3)
+	mockscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+	mockscraper.EXPECT().Provider().Return(sportscrape.DummyProvider)
+	mockscraper.EXPECT().Close().Once()
+
+	sink := &memoryRawSink{events: map[string]RawEvent{}}
+	_, report, err := NewEventDataRunner(
+		EventDataRunnerConfig[int, fakeEvent]{
+			Concurrency: 2,
+			Scraper:     mockscraper,
+			RawSink:     sink,
+		},
+	).RunWithReport(context.Background(), []int{1, 2, 3})
+	assert.Error(t, err)
+	assert.Len(t, report.Events, 3)
+
+	require.Len(t, sink.events, 2, "events without payloads are not archived")
+	for _, id := range []string{"1", "2"} {
+		event := sink.events[id]
+		assert.Equal(t, sportscrape.DummyProvider, event.Provider)
+		assert.Equal(t, sportscrape.DummyFeed, event.Feed)
+		assert.Equal(t, pulled, event.PullTimestamp)
+		assert.Equal(t, ts.URL+"/source", event.URL)
+		assert.JSONEq(t, id, string(event.Matchup))
+		assert.Equal(t, []RawPayload{{URL: ts.URL + "/event", Body: `{"path":"/event"}`}}, event.Payloads)
+	}
+}
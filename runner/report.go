@@ -0,0 +1,91 @@
+package runner
+
+import (
+	"errors"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape"
+)
+
+// ErrFailureThresholdExceeded is returned by EventDataRunner when PartialResults
+// is enabled and the share of failed events exceeds FailureThreshold.
+var ErrFailureThresholdExceeded = errors.New("failure threshold exceeded")
+
+// EventStatus is the outcome of scraping a single event.
+type EventStatus string
+
+const (
+	EventSucceeded EventStatus = "succeeded"
+	EventFailed    EventStatus = "failed"
+)
+
+// EventReport describes the outcome of scraping a single event.
+type EventReport struct {
+	Status   EventStatus
+	URL      string
+	Context  sportscrape.EventDataContext
+	Error    error
+	Duration time.Duration
+	Records  int
+}
+
+// RunReport summarizes an EventDataRunner run, one EventReport per scraped
+// event in completion order.
+type RunReport struct {
+	Feed     sportscrape.Feed
+	Start    time.Time
+	Duration time.Duration
+	Events   []EventReport
+	// final holds the IDs of the events that were final when scraped (see Checkpoint).
+	final map[string]bool
+}
+
+// record appends the outcome of a single scraped event to r.
+func record[E any](r *RunReport, ow sportscrape.EventDataOutput[E], err error) {
+	e := EventReport{
+		Status:   EventSucceeded,
+		URL:      ow.Context.URL,
+		Context:  ow.Context,
+		Duration: ow.Context.Duration,
+		Records:  len(ow.Output),
+	}
+	if err != nil {
+		e.Status = EventFailed
+		e.Error = err
+		e.Records = 0
+	}
+	r.Events = append(r.Events, e)
+}
+
+// Succeeded returns the number of events scraped without error.
+func (r *RunReport) Succeeded() int {
+	return len(r.Events) - r.Failed()
+}
+
+// Failed returns the number of events that errored.
+func (r *RunReport) Failed() int {
+	var n int
+	for _, e := range r.Events {
+		if e.Status == EventFailed {
+			n++
+		}
+	}
+	return n
+}
+
+// Records returns the total number of records produced by successful events.
+func (r *RunReport) Records() int {
+	var n int
+	for _, e := range r.Events {
+		n += e.Records
+	}
+	return n
+}
+
+// FailureRate returns the percentage (0-100) of events that errored.
+func (r *RunReport) FailureRate() float64 {
+	if len(r.Events) == 0 {
+		return 0
+	}
+	return float64(r.Failed()) / float64(len(r.Events)) * 100
+}
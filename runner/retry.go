@@ -0,0 +1,128 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape/util/request"
+)
+
+// RetryPolicy configures how a runner retries a failed scrape.
+// A nil *RetryPolicy, or one with MaxAttempts <= 1, disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	MaxAttempts int
+	// InitialBackoff is the delay before the second attempt. Default 1s.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts. Zero means no cap.
+	MaxBackoff time.Duration
+	// Multiplier grows the delay after each attempt. Default 2.
+	Multiplier float64
+	// Jitter randomizes each delay by up to ±Jitter of its value (0-1).
+	Jitter float64
+	// Retryable classifies errors as retryable. Default DefaultRetryable.
+	Retryable func(error) bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy of 3 attempts with exponential
+// backoff starting at 1s, capped at 30s, with 20% jitter.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 1 * time.Second,
+		MaxBackoff:     30 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		Retryable:      DefaultRetryable,
+	}
+}
+
+// DefaultRetryable reports whether err is worth retrying: 5xx, 408 and 429
+// responses, network errors, unexpected EOFs, per-call deadlines and chromedp
+// page load errors. Cancellation and other 4xx responses are not retried.
+func DefaultRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var statusErr *request.StatusError
+	if errors.As(err, &statusErr) {
+		switch statusErr.StatusCode {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests:
+			return true
+		}
+		return statusErr.StatusCode >= 500
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	return strings.Contains(err.Error(), "page load error")
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) retryable(err error) bool {
+	if p.Retryable == nil {
+		return DefaultRetryable(err)
+	}
+	return p.Retryable(err)
+}
+
+// backoff returns the delay to wait after the given (1-based) failed attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := float64(p.InitialBackoff)
+	if delay <= 0 {
+		delay = float64(time.Second)
+	}
+	multiplier := p.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+	for i := 1; i < attempt; i++ {
+		delay *= multiplier
+	}
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		delay += delay * p.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(delay)
+}
+
+// retry calls fn until it succeeds, the policy's attempts are exhausted, the
+// error is not retryable or ctx is done. It returns the last result and the
+// number of attempts made.
+func retry[T any](ctx context.Context, p *RetryPolicy, fn func() (T, error)) (T, int) {
+	maxAttempts := p.maxAttempts()
+	for attempt := 1; ; attempt++ {
+		out, err := fn()
+		if err == nil || attempt >= maxAttempts || ctx.Err() != nil || !p.retryable(err) {
+			return out, attempt
+		}
+		delay := p.backoff(attempt)
+		log.Printf("attempt %d/%d failed, retrying in %s: %v\n", attempt, maxAttempts, delay, err)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return out, attempt
+		case <-timer.C:
+		}
+	}
+}
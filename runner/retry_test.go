@@ -0,0 +1,106 @@
+//go:build unit
+
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape"
+	"github.com/lightning-dabbler/sportscrape/internal/mocks/scraper"
+	"github.com/lightning-dabbler/sportscrape/util/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDefaultRetryable(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{name: "nil", err: nil, retryable: false},
+		{name: "500", err: &request.StatusError{StatusCode: 500, Status: "500 Internal Server Error"}, retryable: true},
+		{name: "wrapped 503", err: fmt.Errorf("fetch: %w", &request.StatusError{StatusCode: 503}), retryable: true},
+		{name: "429", err: &request.StatusError{StatusCode: 429}, retryable: true},
+		{name: "404", err: &request.StatusError{StatusCode: 404}, retryable: false},
+		{name: "canceled", err: fmt.Errorf("fetch: %w", context.Canceled), retryable: false},
+		{name: "deadline", err: fmt.Errorf("error fetching document: %w", context.DeadlineExceeded), retryable: true},
+		{name: "network", err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}, retryable: true},
+		{name: "page load", err: errors.New("page load error net::ERR_HTTP2_PROTOCOL_ERROR"), retryable: true},
+		{name: "parse", err: errors.New("unexpected end of JSON input"), retryable: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.retryable, DefaultRetryable(tt.err))
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond, Multiplier: 2}
+	assert.Equal(t, 100*time.Millisecond, p.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, p.backoff(2))
+	assert.Equal(t, 300*time.Millisecond, p.backoff(3))
+
+	p.Jitter = 0.5
+	for range 20 {
+		d := p.backoff(1)
+		assert.GreaterOrEqual(t, d, 50*time.Millisecond)
+		assert.LessOrEqual(t, d, 150*time.Millisecond)
+	}
+}
+
+func TestEventDataRunnerRetry(t *testing.T) {
+	type fakeMatchup struct{}
+	type fakeEvent struct{}
+	mockscraper := scraper.NewMockEventDataScraper[fakeMatchup, fakeEvent](t)
+	mockscraper.EXPECT().Init().Return(nil)
+	mockscraper.EXPECT().Scrape(mock.Anything, fakeMatchup{}).Return(sportscrape.EventDataOutput[fakeEvent]{
+		Error: &request.StatusError{StatusCode: 502, Status: "502 Bad Gateway"},
+	}).Twice()
+	mockscraper.EXPECT().Scrape(mock.Anything, fakeMatchup{}).Return(sportscrape.EventDataOutput[fakeEvent]{
+		Output: []fakeEvent{{}},
+	}).Once()
+	mockscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+	mockscraper.EXPECT().Provider().Return(sportscrape.DummyProvider).Once()
+	mockscraper.EXPECT().Close().Once()
+	eventDataRunner := NewEventDataRunner(
+		EventDataRunnerConfig[fakeMatchup, fakeEvent]{
+			Concurrency: 1,
+			Scraper:     mockscraper,
+			RetryPolicy: &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+		},
+	)
+	data, report, err := eventDataRunner.RunWithReport(context.Background(), []fakeMatchup{{}})
+	assert.NoError(t, err)
+	assert.Len(t, data, 1)
+	assert.Equal(t, 3, report.Events[0].Context.Attempts)
+}
+
+func TestEventDataRunnerRetryNotRetryable(t *testing.T) {
+	type fakeMatchup struct{}
+	type fakeEvent struct{}
+	mockscraper := scraper.NewMockEventDataScraper[fakeMatchup, fakeEvent](t)
+	mockscraper.EXPECT().Init().Return(nil)
+	mockscraper.EXPECT().Scrape(mock.Anything, fakeMatchup{}).Return(sportscrape.EventDataOutput[fakeEvent]{
+		Error: &request.StatusError{StatusCode: 404, Status: "404 Not Found"},
+	}).Once()
+	mockscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+	mockscraper.EXPECT().Provider().Return(sportscrape.DummyProvider).Once()
+	mockscraper.EXPECT().Close().Once()
+	eventDataRunner := NewEventDataRunner(
+		EventDataRunnerConfig[fakeMatchup, fakeEvent]{
+			Concurrency: 1,
+			Scraper:     mockscraper,
+			RetryPolicy: &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+		},
+	)
+	_, report, err := eventDataRunner.RunWithReport(context.Background(), []fakeMatchup{{}})
+	assert.Error(t, err)
+	assert.Equal(t, 1, report.Events[0].Context.Attempts)
+}
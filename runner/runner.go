@@ -1,8 +1,10 @@
 package runner
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"log"
 	"runtime"
 	"sync"
@@ -10,6 +12,9 @@ import (
 
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/scraper"
+	"github.com/lightning-dabbler/sportscrape/util/ratelimit"
+	"github.com/lightning-dabbler/sportscrape/util/request"
+	"github.com/lightning-dabbler/sportscrape/validate"
 )
 
 // EventDataRunnerConfig
@@ -20,6 +25,33 @@ type EventDataRunnerConfig[M, E any] struct {
 	// KeepAlive, when true, skips calling Close() after Run() — the caller is
 	// responsible for closing the scraper. Default false = runner closes automatically.
 	KeepAlive bool
+	// PartialResults, when true, returns the records of successful events even
+	// if other events fail. Default false = any failure discards all records.
+	PartialResults bool
+	// FailureThreshold is the percentage (0-100) of failed events tolerated
+	// when PartialResults is enabled before the run returns an error.
+	FailureThreshold float64
+	// RetryPolicy retries failed event scrapes. Default nil = no retries.
+	RetryPolicy *RetryPolicy
+	// RateLimit overrides the provider's process-wide rate limit
+	// (see ratelimit.Defaults), shared with every other runner of the provider;
+	// prefer ratelimit.Set once at process setup. Default nil = keep the current limit.
+	RateLimit *ratelimit.Limit
+	// Observer is notified of run and event lifecycle events. Default nil = none.
+	Observer Observer
+	// Checkpoint skips events completed by a previous run and records the
+	// final events completed by this one. Default nil = scrape every matchup.
+	Checkpoint *Checkpoint[M]
+	// RawSink archives the payloads fetched for every event (HTTP responses
+	// and browser documents, see request.ContextWithPayloadRecorder). Default nil = none.
+	RawSink RawSink
+	// Validation checks the records of every successful event against
+	// Rules: validate.Warn logs violations, validate.Fail fails the event.
+	// Default validate.Off = no validation.
+	Validation validate.Mode
+	// Rules are the validation rules of the feed. Default nil = the built-in
+	// rules of the scraper's feed (see validate.For).
+	Rules validate.Rules[M, E]
 }
 
 func NewEventDataRunner[M, E any](config EventDataRunnerConfig[M, E]) *EventDataRunner[M, E] {
@@ -27,17 +59,40 @@ func NewEventDataRunner[M, E any](config EventDataRunnerConfig[M, E]) *EventData
 		config.Concurrency = 1
 	}
 	r := &EventDataRunner[M, E]{
-		Concurrency: config.Concurrency,
-		Scraper:     config.Scraper,
-		KeepAlive:   config.KeepAlive,
+		Concurrency:      config.Concurrency,
+		Scraper:          config.Scraper,
+		KeepAlive:        config.KeepAlive,
+		PartialResults:   config.PartialResults,
+		FailureThreshold: config.FailureThreshold,
+		RetryPolicy:      config.RetryPolicy,
+		RateLimit:        config.RateLimit,
+		Observer:         config.Observer,
+		Checkpoint:       config.Checkpoint,
+		RawSink:          config.RawSink,
+		Validation:       config.Validation,
+		Rules:            config.Rules,
+	}
+	if r.Validation != validate.Off && r.Rules == nil {
+		r.Rules = validate.For[M, E](config.Scraper.Feed())
 	}
 	return r
 }
 
 type EventDataRunner[M, E any] struct {
-	Concurrency int
-	Scraper     scraper.EventDataScraper[M, E]
-	KeepAlive   bool
+	Concurrency      int
+	Scraper          scraper.EventDataScraper[M, E]
+	KeepAlive        bool
+	PartialResults   bool
+	FailureThreshold float64
+	RetryPolicy      *RetryPolicy
+	RateLimit        *ratelimit.Limit
+	Observer         Observer
+	Checkpoint       *Checkpoint[M]
+	RawSink          RawSink
+	Validation       validate.Mode
+	Rules            validate.Rules[M, E]
+	// deferCheckpoint leaves recording completed events to the caller (see stage.run).
+	deferCheckpoint bool
 }
 
 // Deprecated is a deprecation check for the feed/provider
@@ -50,15 +105,176 @@ func (t *EventDataRunner[M, E]) Deprecated() bool {
 	return feed.Deprecated()
 }
 
+// Run scrapes event data for every matchup. It is equivalent to RunContext
+// with context.Background().
 func (t *EventDataRunner[M, E]) Run(matchups []M) ([]E, error) {
-	if t.Deprecated() {
-		return nil, t.Scraper.Feed().Deprecation()
+	return t.RunContext(context.Background(), matchups)
+}
+
+// RunContext scrapes event data for every matchup, stopping early when ctx is
+// cancelled. Matchups not yet dispatched when ctx is done are never scraped and
+// in-flight scrapes are aborted through the ctx handed to the scraper.
+// All records are buffered and returned once the last worker finishes; use
+// Stream to consume them one event at a time. See RunWithReport for how
+// PartialResults changes the returned records and error.
+func (t *EventDataRunner[M, E]) RunContext(ctx context.Context, matchups []M) ([]E, error) {
+	output, _, err := t.RunWithReport(ctx, matchups)
+	return output, err
+}
+
+// RunWithReport scrapes event data for every matchup and returns a RunReport
+// describing the outcome of each event alongside the records.
+//
+// By default any failed event discards all records and the joined scrape
+// errors are returned. When PartialResults is enabled the records of every
+// successful event are always returned; an error wrapping
+// ErrFailureThresholdExceeded is returned as well only if the percentage of
+// failed events exceeds FailureThreshold.
+//
+// With a Checkpoint, completed events are skipped and the final events are
+// recorded once the run returns without error.
+func (t *EventDataRunner[M, E]) RunWithReport(ctx context.Context, matchups []M) ([]E, *RunReport, error) {
+	report := &RunReport{Start: time.Now().UTC()}
+	if t.Checkpoint != nil {
+		matchups, report.final = t.Checkpoint.pending(t.Scraper.Feed(), matchups)
+	}
+	var output []E
+	var outputErr error
+	err := t.stream(ctx, matchups, func(ow sportscrape.EventDataOutput[E], err error) bool {
+		record(report, ow, err)
+		if err != nil {
+			outputErr = errors.Join(outputErr, err)
+			return true
+		}
+		log.Printf("%v (%s vs %s) scraped for %v record(s) for %s at url: %s\n", ow.Context.EventID, ow.Context.AwayTeam, ow.Context.HomeTeam, len(ow.Output), t.Scraper.Feed(), ow.Context.URL)
+		output = append(output, ow.Output...)
+		return true
+	})
+	report.Duration = time.Now().UTC().Sub(report.Start)
+	if err != nil {
+		return nil, report, errors.Join(err, outputErr)
+	}
+	report.Feed = t.Scraper.Feed()
+	outputCount := len(output)
+	if failed := report.Failed(); failed != 0 {
+		log.Printf("error: %d/%d events errored out\n", failed, len(matchups))
+		if !t.PartialResults {
+			return nil, report, outputErr
+		}
+		if report.FailureRate() > t.FailureThreshold {
+			return output, report, fmt.Errorf("%w: %.2f%% of events failed (threshold %.2f%%): %w", ErrFailureThresholdExceeded, report.FailureRate(), t.FailureThreshold, outputErr)
+		}
+	}
+	log.Printf("Scraping of %s with %d record(s) completed in %s\n", report.Feed, outputCount, report.Duration)
+	if !t.deferCheckpoint {
+		t.checkpoint(report)
+	}
+	return output, report, nil
+}
+
+// Stream scrapes event data for every matchup and yields each event's output as
+// soon as its worker finishes, in completion order. A failed scrape is yielded
+// alongside a non-nil error describing the event; scraping of the remaining
+// matchups continues. Deprecation and cancellation of ctx are reported as a
+// final pair with a zero EventDataOutput.
+//
+// The scraper is initialized when iteration starts and closed (unless KeepAlive)
+// when it ends. Breaking out of the loop cancels outstanding scrapes.
+//
+// With a Checkpoint, completed events are skipped and a final event is recorded
+// once the loop body handling it returns.
+func (t *EventDataRunner[M, E]) Stream(ctx context.Context, matchups []M) iter.Seq2[sportscrape.EventDataOutput[E], error] {
+	return func(yield func(sportscrape.EventDataOutput[E], error) bool) {
+		if t.Checkpoint != nil {
+			feed := t.Scraper.Feed()
+			var final map[string]bool
+			matchups, final = t.Checkpoint.pending(feed, matchups)
+			inner := yield
+			yield = func(ow sportscrape.EventDataOutput[E], err error) bool {
+				if !inner(ow, err) {
+					return false
+				}
+				if err == nil {
+					t.Checkpoint.complete(feed, final, ow.Context.EventID)
+				}
+				return true
+			}
+		}
+		if err := t.stream(ctx, matchups, yield); err != nil {
+			yield(sportscrape.EventDataOutput[E]{}, err)
+		}
+	}
+}
+
+// checkpoint records the final events that succeeded in report.
+func (t *EventDataRunner[M, E]) checkpoint(report *RunReport) {
+	if t.Checkpoint == nil {
+		return
+	}
+	for _, event := range report.Events {
+		if event.Status == EventSucceeded {
+			t.Checkpoint.complete(report.Feed, report.final, event.Context.EventID)
+		}
+	}
+}
+
+// stream drives the scraper lifecycle and hands every event output to yield.
+// It returns an error only for failures that end the run as a whole
+// (deprecation, cancellation), never for individual events.
+func (t *EventDataRunner[M, E]) stream(ctx context.Context, matchups []M, yield func(sportscrape.EventDataOutput[E], error) bool) (err error) {
+	provider := t.Scraper.Provider()
+	feed := t.Scraper.Feed()
+	run := RunInfo{Provider: provider, Feed: feed, Events: len(matchups)}
+	observer := observerOrNop(t.Observer)
+	observer.OnRunStart(ctx, run)
+	start := time.Now()
+	var records int
+	var eventErrs error
+	defer func() {
+		observer.OnRunEnd(ctx, run, records, errors.Join(err, eventErrs), time.Since(start))
+	}()
+
+	if provider.Deprecated() || feed.Deprecated() {
+		return feed.Deprecation()
+	}
+	if err := t.Scraper.Init(); err != nil {
+		return fmt.Errorf("initializing %s: %w", feed, err)
 	}
-	t.Scraper.Init()
 	if !t.KeepAlive {
 		defer t.Scraper.Close()
 	}
-	start := time.Now().UTC()
+	ctx = request.ContextWithLimiter(ctx, limiter(provider, t.RateLimit))
+	parent := ctx
+	ctx, cancel := context.WithCancel(context.WithValue(ctx, runInfoKey{}, run))
+	defer cancel()
+
+	eventData := t.dispatch(ctx, matchups)
+	for ow := range eventData {
+		var err error
+		if ow.Error != nil {
+			err = fmt.Errorf("issue Scraping %v (%s vs %s) at url: '%s': %w", ow.Context.EventID, ow.Context.AwayTeam, ow.Context.HomeTeam, ow.Context.URL, ow.Error)
+			eventErrs = errors.Join(eventErrs, err)
+		} else {
+			records += len(ow.Output)
+		}
+		if !yield(ow, err) {
+			cancel()
+			// Drain so workers can exit before the scraper is closed.
+			for range eventData {
+			}
+			return nil
+		}
+	}
+	if err := parent.Err(); err != nil {
+		return fmt.Errorf("scraping of %s cancelled: %w", feed, err)
+	}
+	return nil
+}
+
+// dispatch fans matchups out to Concurrency workers and returns the channel
+// their outputs are delivered on. The channel is closed once every dispatched
+// matchup has been scraped; dispatching stops early when ctx is done.
+func (t *EventDataRunner[M, E]) dispatch(ctx context.Context, matchups []M) <-chan sportscrape.EventDataOutput[E] {
 	concurrency := t.Concurrency
 	if concurrency <= 0 {
 		concurrency = runtime.NumCPU()
@@ -66,71 +282,113 @@ func (t *EventDataRunner[M, E]) Run(matchups []M) ([]E, error) {
 
 	var wg sync.WaitGroup
 	workerMatchups := make(chan M, concurrency)
-	eventData := make(chan sportscrape.EventDataOutput[E], len(matchups))
+	eventData := make(chan sportscrape.EventDataOutput[E], concurrency)
 
 	// Start worker goroutines
 	for i := 0; i < cap(workerMatchups); i++ {
-		go t.Worker(&wg, workerMatchups, eventData)
+		go t.Worker(ctx, &wg, workerMatchups, eventData)
 	}
 
-	// Send matchups to workers
-	matchupsCount := len(matchups)
-	log.Printf("Processing %d matchup(s)", matchupsCount)
-	for _, matchup := range matchups {
-		wg.Add(1)
-		workerMatchups <- matchup
-	}
-
-	wg.Wait()
-	close(workerMatchups)
-	close(eventData)
-
-	// Collect results
-	var errCnt int
-	var output []E
-	var outputErr error
-	for ow := range eventData {
-		if ow.Error != nil {
-			errCnt += 1
-			outputErr = errors.Join(outputErr, fmt.Errorf("issue Scraping %v (%s vs %s) at url: '%s': %w", ow.Context.EventID, ow.Context.AwayTeam, ow.Context.HomeTeam, ow.Context.URL, ow.Error))
-			continue
-		} else {
-			log.Printf("%v (%s vs %s) scraped for %v record(s) for %s at url: %s\n", ow.Context.EventID, ow.Context.AwayTeam, ow.Context.HomeTeam, len(ow.Output), t.Scraper.Feed(), ow.Context.URL)
+	go func() {
+		// Send matchups to workers
+		log.Printf("Processing %d matchup(s)", len(matchups))
+	send:
+		for _, matchup := range matchups {
+			if ctx.Err() != nil {
+				break
+			}
+			wg.Add(1)
+			select {
+			case workerMatchups <- matchup:
+			case <-ctx.Done():
+				wg.Done()
+				break send
+			}
 		}
-		output = append(output, ow.Output...)
-	}
-	outputCount := len(output)
-	if errCnt != 0 {
-		log.Printf("error: %d/%d events errored out\n", errCnt, matchupsCount)
-		return nil, outputErr
-	}
-	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of %s with %d record(s) completed in %s\n", t.Scraper.Feed(), outputCount, diff)
-
-	return output, nil
+		wg.Wait()
+		close(workerMatchups)
+		close(eventData)
+	}()
+	return eventData
 }
 
-func (t *EventDataRunner[M, E]) Worker(wg *sync.WaitGroup, workerMatchups <-chan M, eventData chan<- sportscrape.EventDataOutput[E]) {
+func (t *EventDataRunner[M, E]) Worker(ctx context.Context, wg *sync.WaitGroup, workerMatchups <-chan M, eventData chan<- sportscrape.EventDataOutput[E]) {
 	for matchup := range workerMatchups {
-		ow := t.Scraper.Scrape(matchup)
+		if ctx.Err() != nil {
+			// Cancelled after dispatch; skip without scraping.
+			wg.Done()
+			continue
+		}
+		run, _ := ctx.Value(runInfoKey{}).(RunInfo)
+		observer := observerOrNop(t.Observer)
+		observer.OnEventStart(ctx, run, matchup)
+		start := time.Now()
+		scrapeCtx := ctx
+		var recorder *rawRecorder
+		if t.RawSink != nil {
+			recorder = &rawRecorder{}
+			scrapeCtx = request.ContextWithPayloadRecorder(ctx, recorder.record)
+		}
+		ow, attempts := retry(ctx, t.RetryPolicy, func() (sportscrape.EventDataOutput[E], error) {
+			if recorder != nil {
+				// Only archive the payloads of the last attempt
+				recorder.reset()
+			}
+			ow := t.Scraper.Scrape(scrapeCtx, matchup)
+			return ow, ow.Error
+		})
+		ow.Context.Duration = time.Since(start)
+		ow.Context.Attempts = attempts
+		if recorder != nil {
+			archive(ctx, t.RawSink, t.Scraper.Provider(), t.Scraper.Feed(), matchup, ow, recorder)
+		}
+		if ow.Error == nil {
+			ow.Error = t.validate(matchup, ow)
+		}
+		observer.OnEventDone(ctx, run, ow.Context, len(ow.Output), ow.Error, ow.Context.Duration)
 		eventData <- ow
 		wg.Done()
 	}
 }
 
+// validate checks the records of ow against the runner's rules. It returns
+// the violations only in validate.Fail mode; validate.Warn logs them.
+func (t *EventDataRunner[M, E]) validate(matchup M, ow sportscrape.EventDataOutput[E]) error {
+	if t.Validation == validate.Off || len(t.Rules) == 0 {
+		return nil
+	}
+	err := t.Rules.Validate(matchup, ow.Output)
+	if err == nil || t.Validation == validate.Fail {
+		return err
+	}
+	log.Printf("warning: %v (%s vs %s) of %s failed validation: %v\n", ow.Context.EventID, ow.Context.AwayTeam, ow.Context.HomeTeam, t.Scraper.Feed(), err)
+	return nil
+}
+
 // MatchupRunnerConfig
 type MatchupRunnerConfig[M any] struct {
 	Scraper scraper.MatchupScraper[M]
 	// KeepAlive, when true, skips calling Close() after Run() — the caller is
 	// responsible for closing the scraper. Default false = runner closes automatically.
 	KeepAlive bool
+	// RetryPolicy retries a failed matchup scrape. Default nil = no retries.
+	RetryPolicy *RetryPolicy
+	// RateLimit overrides the provider's process-wide rate limit
+	// (see ratelimit.Defaults), shared with every other runner of the provider;
+	// prefer ratelimit.Set once at process setup. Default nil = keep the current limit.
+	RateLimit *ratelimit.Limit
+	// Observer is notified of run lifecycle events. Default nil = none.
+	Observer Observer
 }
 
 // NewMatchupRunner Instantiates a new MatchupRunner
 func NewMatchupRunner[M any](config MatchupRunnerConfig[M]) *MatchupRunner[M] {
 	r := &MatchupRunner[M]{
-		Scraper:   config.Scraper,
-		KeepAlive: config.KeepAlive,
+		Scraper:     config.Scraper,
+		KeepAlive:   config.KeepAlive,
+		RetryPolicy: config.RetryPolicy,
+		RateLimit:   config.RateLimit,
+		Observer:    config.Observer,
 	}
 	return r
 }
@@ -138,8 +396,11 @@ func NewMatchupRunner[M any](config MatchupRunnerConfig[M]) *MatchupRunner[M] {
 // MatchupRunner is a general matchup runner for scraping NBA, MLB, NCAAB, etc. matchup data.
 type MatchupRunner[M any] struct {
 	// Scraper
-	Scraper   scraper.MatchupScraper[M]
-	KeepAlive bool
+	Scraper     scraper.MatchupScraper[M]
+	KeepAlive   bool
+	RetryPolicy *RetryPolicy
+	RateLimit   *ratelimit.Limit
+	Observer    Observer
 }
 
 // Deprecated is a deprecation check for the feed/provider
@@ -152,27 +413,62 @@ func (r *MatchupRunner[M]) Deprecated() bool {
 	return feed.Deprecated()
 }
 
-// Run gets all matchups
+// Run gets all matchups. It is equivalent to RunContext with context.Background().
 func (r *MatchupRunner[M]) Run() ([]M, error) {
-	if r.Deprecated() {
-		return nil, r.Scraper.Feed().Deprecation()
+	return r.RunContext(context.Background())
+}
+
+// RunContext gets all matchups, aborting the scrape when ctx is cancelled.
+func (r *MatchupRunner[M]) RunContext(ctx context.Context) (output []M, err error) {
+	provider := r.Scraper.Provider()
+	feed := r.Scraper.Feed()
+	run := RunInfo{Provider: provider, Feed: feed}
+	observer := observerOrNop(r.Observer)
+	observer.OnRunStart(ctx, run)
+	runStart := time.Now()
+	defer func() {
+		observer.OnRunEnd(ctx, run, len(output), err, time.Since(runStart))
+	}()
+
+	if provider.Deprecated() || feed.Deprecated() {
+		return nil, feed.Deprecation()
+	}
+	if err := r.Scraper.Init(); err != nil {
+		return nil, fmt.Errorf("initializing %s: %w", feed, err)
 	}
-	r.Scraper.Init()
 	if !r.KeepAlive {
 		defer r.Scraper.Close()
 	}
+	ctx = request.ContextWithLimiter(ctx, limiter(provider, r.RateLimit))
 	start := time.Now().UTC()
-	ou := r.Scraper.Scrape()
+	ou, _ := retry(ctx, r.RetryPolicy, func() (sportscrape.MatchupOutput[M], error) {
+		ou := r.Scraper.Scrape(ctx)
+		return ou, ou.Error
+	})
 	if ou.Context.Errors != 0 {
 		log.Printf("error: %d event(s) errored out\n", ou.Context.Errors)
 	}
 	if ou.Error != nil {
 		return nil, ou.Error
 	}
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("scraping of %s cancelled: %w", feed, err)
+	}
 	diff := time.Now().UTC().Sub(start)
-	log.Printf("Scraping of %s with %d record(s) completed in %s\n", r.Scraper.Feed(), len(ou.Output), diff)
+	log.Printf("Scraping of %s with %d record(s) completed in %s\n", feed, len(ou.Output), diff)
 	if ou.Context.Skips != 0 {
 		log.Printf("WARNING: %d event(s) skipped\n", ou.Context.Skips)
 	}
 	return ou.Output, nil
 }
+
+// limiter returns the process-wide rate limiter for provider, applying override
+// first if it differs from the current limit. The limiter keeps its tokens, so
+// runners sharing an override do not each get a fresh burst.
+func limiter(provider sportscrape.Provider, override *ratelimit.Limit) *ratelimit.Limiter {
+	l := ratelimit.ForProvider(provider)
+	if override != nil && l.Limit() != *override {
+		l.SetLimit(*override)
+	}
+	return l
+}
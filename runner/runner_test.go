@@ -3,11 +3,21 @@
 package runner
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/lightning-dabbler/sportscrape"
 	"github.com/lightning-dabbler/sportscrape/internal/mocks/scraper"
+	basescraper "github.com/lightning-dabbler/sportscrape/scraper"
+	"github.com/lightning-dabbler/sportscrape/util/ratelimit"
+	"github.com/lightning-dabbler/sportscrape/util/request"
+	"github.com/lightning-dabbler/sportscrape/validate"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestMatchupRunner(t *testing.T) {
@@ -15,8 +25,8 @@ func TestMatchupRunner(t *testing.T) {
 	dummyoutput := sportscrape.MatchupOutput[any]{
 		Context: sportscrape.MatchupContext{},
 	}
-	mockscraper.EXPECT().Init().Return()
-	mockscraper.EXPECT().Scrape().Return(dummyoutput).Once()
+	mockscraper.EXPECT().Init().Return(nil)
+	mockscraper.EXPECT().Scrape(mock.Anything).Return(dummyoutput).Once()
 	mockscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
 	mockscraper.EXPECT().Provider().Return(sportscrape.DummyProvider).Once()
 	mockscraper.EXPECT().Close().Once()
@@ -35,8 +45,8 @@ func TestEventDataRunner(t *testing.T) {
 	dummyoutput := sportscrape.EventDataOutput[fakeEvent]{
 		Context: sportscrape.EventDataContext{},
 	}
-	mockscraper.EXPECT().Init().Return()
-	mockscraper.EXPECT().Scrape(fakeMatchup{}).Return(dummyoutput).Once()
+	mockscraper.EXPECT().Init().Return(nil)
+	mockscraper.EXPECT().Scrape(mock.Anything, fakeMatchup{}).Return(dummyoutput).Once()
 	mockscraper.EXPECT().Feed().Return(sportscrape.DummyFeed).Times(3)
 	mockscraper.EXPECT().Provider().Return(sportscrape.DummyProvider).Once()
 	mockscraper.EXPECT().Close().Once()
@@ -50,3 +60,287 @@ func TestEventDataRunner(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Empty(t, data)
 }
+
+func TestEventDataRunnerCancelled(t *testing.T) {
+	type fakeMatchup struct{}
+	type fakeEvent struct{}
+	mockscraper := scraper.NewMockEventDataScraper[fakeMatchup, fakeEvent](t)
+	mockscraper.EXPECT().Init().Return(nil)
+	mockscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+	mockscraper.EXPECT().Provider().Return(sportscrape.DummyProvider).Once()
+	mockscraper.EXPECT().Close().Once()
+	eventDataRunner := NewEventDataRunner(
+		EventDataRunnerConfig[fakeMatchup, fakeEvent]{
+			Concurrency: 1,
+			Scraper:     mockscraper,
+		},
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	data, err := eventDataRunner.RunContext(ctx, []fakeMatchup{{}, {}, {}})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, data)
+}
+
+func TestEventDataRunnerStream(t *testing.T) {
+	type fakeMatchup struct{ ID int }
+	type fakeEvent struct{ ID int }
+	mockscraper := scraper.NewMockEventDataScraper[fakeMatchup, fakeEvent](t)
+	mockscraper.EXPECT().Init().Return(nil)
+	mockscraper.EXPECT().Scrape(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, m fakeMatchup) sportscrape.EventDataOutput[fakeEvent] {
+			if m.ID == 2 {
+				return sportscrape.EventDataOutput[fakeEvent]{Error: assert.AnError}
+			}
+			return sportscrape.EventDataOutput[fakeEvent]{Output: []fakeEvent{{ID: m.ID}}}
+		},
+	).Times(3)
+	mockscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+	mockscraper.EXPECT().Provider().Return(sportscrape.DummyProvider).Once()
+	mockscraper.EXPECT().Close().Once()
+	eventDataRunner := NewEventDataRunner(
+		EventDataRunnerConfig[fakeMatchup, fakeEvent]{
+			Concurrency: 2,
+			Scraper:     mockscraper,
+		},
+	)
+	var ids []int
+	var errs int
+	for ow, err := range eventDataRunner.Stream(context.Background(), []fakeMatchup{{1}, {2}, {3}}) {
+		if err != nil {
+			assert.ErrorIs(t, err, assert.AnError)
+			errs++
+			continue
+		}
+		for _, e := range ow.Output {
+			ids = append(ids, e.ID)
+		}
+	}
+	assert.Equal(t, 1, errs)
+	assert.ElementsMatch(t, []int{1, 3}, ids)
+}
+
+func TestEventDataRunnerStreamBreak(t *testing.T) {
+	type fakeMatchup struct{}
+	type fakeEvent struct{}
+	mockscraper := scraper.NewMockEventDataScraper[fakeMatchup, fakeEvent](t)
+	mockscraper.EXPECT().Init().Return(nil)
+	mockscraper.EXPECT().Scrape(mock.Anything, mock.Anything).Return(sportscrape.EventDataOutput[fakeEvent]{}).Maybe()
+	mockscraper.EXPECT().Feed().Return(sportscrape.DummyFeed).Once()
+	mockscraper.EXPECT().Provider().Return(sportscrape.DummyProvider).Once()
+	mockscraper.EXPECT().Close().Once()
+	eventDataRunner := NewEventDataRunner(
+		EventDataRunnerConfig[fakeMatchup, fakeEvent]{
+			Concurrency: 1,
+			Scraper:     mockscraper,
+		},
+	)
+	var yielded int
+	for range eventDataRunner.Stream(context.Background(), make([]fakeMatchup, 10)) {
+		yielded++
+		break
+	}
+	assert.Equal(t, 1, yielded)
+}
+
+func TestEventDataRunnerPartialResults(t *testing.T) {
+	type fakeMatchup struct{ ID int }
+	type fakeEvent struct{ ID int }
+	matchups := []fakeMatchup{{1}, {2}, {3}, {4}}
+	tests := []struct {
+		name      string
+		partial   bool
+		threshold float64
+		records   int
+		isError   bool
+	}{
+		{name: "partial results disabled", partial: false, records: 0, isError: true},
+		{name: "under threshold", partial: true, threshold: 25, records: 3, isError: false},
+		{name: "over threshold", partial: true, threshold: 10, records: 3, isError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockscraper := scraper.NewMockEventDataScraper[fakeMatchup, fakeEvent](t)
+			mockscraper.EXPECT().Init().Return(nil)
+			mockscraper.EXPECT().Scrape(mock.Anything, mock.Anything).RunAndReturn(
+				func(ctx context.Context, m fakeMatchup) sportscrape.EventDataOutput[fakeEvent] {
+					ow := sportscrape.EventDataOutput[fakeEvent]{Context: sportscrape.EventDataContext{EventID: m.ID}}
+					if m.ID == 2 {
+						ow.Error = assert.AnError
+						return ow
+					}
+					ow.Output = []fakeEvent{{ID: m.ID}}
+					return ow
+				},
+			).Times(len(matchups))
+			mockscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+			mockscraper.EXPECT().Provider().Return(sportscrape.DummyProvider).Once()
+			mockscraper.EXPECT().Close().Once()
+			eventDataRunner := NewEventDataRunner(
+				EventDataRunnerConfig[fakeMatchup, fakeEvent]{
+					Concurrency:      2,
+					Scraper:          mockscraper,
+					PartialResults:   tt.partial,
+					FailureThreshold: tt.threshold,
+				},
+			)
+			data, report, err := eventDataRunner.RunWithReport(context.Background(), matchups)
+			if tt.isError {
+				assert.ErrorIs(t, err, assert.AnError)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.partial && tt.isError {
+				assert.ErrorIs(t, err, ErrFailureThresholdExceeded)
+			}
+			assert.Len(t, data, tt.records)
+			assert.Len(t, report.Events, len(matchups))
+			assert.Equal(t, 1, report.Failed())
+			assert.Equal(t, 3, report.Succeeded())
+			assert.Equal(t, 3, report.Records())
+			assert.Equal(t, 25.0, report.FailureRate())
+			for _, e := range report.Events {
+				if e.Context.EventID == 2 {
+					assert.Equal(t, EventFailed, e.Status)
+					assert.Error(t, e.Error)
+				} else {
+					assert.Equal(t, EventSucceeded, e.Status)
+					assert.Equal(t, 1, e.Records)
+				}
+			}
+		})
+	}
+}
+
+func TestEventDataRunnerValidation(t *testing.T) {
+	type fakeMatchup struct{ ID int }
+	type fakeEvent struct{ Points int }
+	matchups := []fakeMatchup{{1}, {2}, {3}}
+	rules := validate.Rules[fakeMatchup, fakeEvent]{{
+		Name: "non-negative-points",
+		Check: func(m fakeMatchup, records []fakeEvent) error {
+			for _, r := range records {
+				if r.Points < 0 {
+					return fmt.Errorf("event %d has negative points", m.ID)
+				}
+			}
+			return nil
+		},
+	}}
+	tests := []struct {
+		name    string
+		mode    validate.Mode
+		records int
+		failed  int
+	}{
+		{name: "off", mode: validate.Off, records: 3},
+		{name: "warn", mode: validate.Warn, records: 3},
+		{name: "fail", mode: validate.Fail, records: 2, failed: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockscraper := scraper.NewMockEventDataScraper[fakeMatchup, fakeEvent](t)
+			mockscraper.EXPECT().Init().Return(nil)
+			mockscraper.EXPECT().Scrape(mock.Anything, mock.Anything).RunAndReturn(
+				func(ctx context.Context, m fakeMatchup) sportscrape.EventDataOutput[fakeEvent] {
+					points := m.ID
+					if m.ID == 2 {
+						points = -1
+					}
+					return sportscrape.EventDataOutput[fakeEvent]{
+						Context: sportscrape.EventDataContext{EventID: m.ID},
+						Output:  []fakeEvent{{Points: points}},
+					}
+				},
+			).Times(len(matchups))
+			mockscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+			mockscraper.EXPECT().Provider().Return(sportscrape.DummyProvider)
+			mockscraper.EXPECT().Close().Once()
+			eventDataRunner := NewEventDataRunner(
+				EventDataRunnerConfig[fakeMatchup, fakeEvent]{
+					Scraper:        mockscraper,
+					PartialResults: true,
+					// 1 of 3 events fails validation in fail mode
+					FailureThreshold: 50,
+					Validation:       tt.mode,
+					Rules:            rules,
+				},
+			)
+			data, report, err := eventDataRunner.RunWithReport(context.Background(), matchups)
+			assert.NoError(t, err)
+			assert.Len(t, data, tt.records)
+			assert.Equal(t, tt.failed, report.Failed())
+			for _, e := range report.Events {
+				if e.Status == EventFailed {
+					assert.ErrorIs(t, e.Error, validate.ErrInvalid)
+					assert.Equal(t, 2, e.Context.EventID)
+				}
+			}
+		})
+	}
+}
+
+func TestRunnersInitError(t *testing.T) {
+	type fakeMatchup struct{}
+	type fakeEvent struct{}
+
+	matchupscraper := scraper.NewMockMatchupScraper[fakeMatchup](t)
+	matchupscraper.EXPECT().Init().Return(basescraper.ErrMissingDate).Once()
+	matchupscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+	matchupscraper.EXPECT().Provider().Return(sportscrape.DummyProvider).Once()
+	matchups, err := NewMatchupRunner(
+		MatchupRunnerConfig[fakeMatchup]{Scraper: matchupscraper},
+	).Run()
+	assert.ErrorIs(t, err, basescraper.ErrMissingDate)
+	assert.ErrorIs(t, err, basescraper.ErrInvalidConfig)
+	assert.Nil(t, matchups)
+
+	eventscraper := scraper.NewMockEventDataScraper[fakeMatchup, fakeEvent](t)
+	eventscraper.EXPECT().Init().Return(basescraper.ErrUndefinedPeriod).Once()
+	eventscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+	eventscraper.EXPECT().Provider().Return(sportscrape.DummyProvider).Once()
+	data, err := NewEventDataRunner(
+		EventDataRunnerConfig[fakeMatchup, fakeEvent]{Concurrency: 1, Scraper: eventscraper},
+	).Run([]fakeMatchup{{}})
+	assert.ErrorIs(t, err, basescraper.ErrUndefinedPeriod)
+	assert.Nil(t, data)
+}
+
+func TestEventDataRunnerRateLimitSharedAcrossRuns(t *testing.T) {
+	type fakeMatchup struct{ ID int }
+	type fakeEvent struct{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	t.Cleanup(func() { ratelimit.Set(sportscrape.DummyProvider, ratelimit.Limit{}) })
+
+	// 20 requests per second with a burst of 2: 6 requests take at least 4 * 50ms
+	limit := ratelimit.Limit{RequestsPerSecond: 20, Burst: 2}
+	matchups := []fakeMatchup{{ID: 1}, {ID: 2}, {ID: 3}}
+	start := time.Now()
+	for range 2 {
+		mockscraper := scraper.NewMockEventDataScraper[fakeMatchup, fakeEvent](t)
+		mockscraper.EXPECT().Init().Return(nil)
+		mockscraper.EXPECT().Feed().Return(sportscrape.DummyFeed)
+		mockscraper.EXPECT().Provider().Return(sportscrape.DummyProvider)
+		mockscraper.EXPECT().Close()
+		mockscraper.EXPECT().Scrape(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, matchup fakeMatchup) sportscrape.EventDataOutput[fakeEvent] {
+				resp, err := request.GetContext(ctx, server.URL)
+				if err == nil {
+					resp.Body.Close()
+				}
+				return sportscrape.EventDataOutput[fakeEvent]{Error: err}
+			},
+		)
+		_, err := NewEventDataRunner(
+			EventDataRunnerConfig[fakeMatchup, fakeEvent]{
+				Concurrency: 1,
+				Scraper:     mockscraper,
+				RateLimit:   &limit,
+			},
+		).Run(matchups)
+		assert.NoError(t, err)
+	}
+	// A fresh burst per run would finish in 2 * 50ms
+	assert.GreaterOrEqual(t, time.Since(start), 190*time.Millisecond)
+}
@@ -0,0 +1,1075 @@
+// Code generated by gendoc; DO NOT EDIT.
+
+package schema
+
+// descriptions holds the doc comments of the model structs ("" key) and their fields, by import path and type name.
+var descriptions = map[string]map[string]string{
+	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballreferencemlb/model.MLBBattingBoxScoreStats": {
+		"":                                 "MLBBattingBoxScoreStats represents the data model for MLB batting box score stats scraped from baseball-reference.com https://www.mlb.com/glossary/standard-stats https://www.mlb.com/glossary/advanced-stats",
+		"Assist":                           "Assist (A) [Defense] - https://www.mlb.com/glossary/standard-stats/assist",
+		"AtBat":                            "AtBat (AB) - https://www.mlb.com/glossary/standard-stats/at-bat",
+		"AverageChampionshipLeverageIndex": "AverageChampionshipLeverageIndex - the average pressure the batter saw in this game or season. 1.0 is average pressure, below 1.0 is low pressure and above 1.0 is high pressure. https://www.mlb.com/glossary/advanced-stats/leverage-index",
+		"AverageLeverageIndex":             "AverageLeverageIndex - the average pressure the batter saw in this game or season. 1.0 is average pressure, below 1.0 is low pressure and above 1.0 is high pressure. https://www.mlb.com/glossary/advanced-stats/leverage-index",
+		"BaseOutRunsAdded":                 "BaseOutRunsAdded (RE24) - Given the bases occupied/out situation, how many runs did the batter or baserunner add in the resulting play. Compared to average, so 0 is average, and above 0 is better than average",
+		"BattingAverage":                   "BattingAverage (BA) - https://www.mlb.com/glossary/standard-stats/batting-average",
+		"ChampionshipWinProbabilityAdded":  "ChampionshipWinProbabilityAdded (cWPA) in percentage notation- https://www.reddit.com/r/baseball/comments/1agut0c/comment/kojk7c4",
+		"EventDate":                        "EventDate is the timestamp associated with a given event",
+		"EventDateParquet":                 "EventDateParquet is the timestamp associated with a given event (in days)",
+		"EventID":                          "EventID is the parsed event id from the box score link of the matchup",
+		"Hits":                             "Hits (H) - https://www.mlb.com/glossary/standard-stats/hit",
+		"OnBasePercentage":                 "OnBasePercentage (OBP) - https://www.mlb.com/glossary/standard-stats/on-base-percentage",
+		"OnBasePlusSlugging":               "OnBasePlusSlugging (OPS) - https://www.mlb.com/glossary/standard-stats/on-base-plus-slugging",
+		"Opponent":                         "Opponent is the opposing team name",
+		"OpponentID":                       "OpponentID",
+		"PitchesPerPlateAppearance":        "Pitches Per Plate Appearance (P/PA) - https://www.mlb.com/glossary/advanced-stats/pitches-per-plate-appearance",
+		"PlateAppearances":                 "PlateAppearances (PA) - https://www.mlb.com/glossary/standard-stats/plate-appearance",
+		"Player":                           "Player is the player's name",
+		"PlayerID":                         "PlayerID is the player's id",
+		"PlayerLink":                       "PlayerLink is the link to the player's baseball-reference profile",
+		"Position":                         "Position is the player's position",
+		"PullTimestamp":                    "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet":             "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"Putout":                           "Putout (PO) [Defense]- https://www.mlb.com/glossary/standard-stats/putout",
+		"Runs":                             "Runs (R) - https://www.mlb.com/glossary/standard-stats/run",
+		"RunsBattedIn":                     "RunsBattedIn (RBI) - https://www.mlb.com/glossary/standard-stats/runs-batted-in",
+		"SluggingPercentage":               "SluggingPercentage (SLG) - https://www.mlb.com/glossary/standard-stats/slugging-percentage",
+		"Strikeouts":                       "Strikeouts (SO) - https://www.mlb.com/glossary/standard-stats/strikeout",
+		"Strikes":                          "Strikes - includes both pitches in the zone and those swung at out of the zone.",
+		"SumNegativeWinProbabilityAdded":   "SumNegativeWinProbabilityAdded (WPA-) - Sum of negative events for batter",
+		"SumPositiveWinProbabilityAdded":   "SumPositiveWinProbabilityAdded (WPA+) - Sum of positive events for batter",
+		"Team":                             "Team is the player's team name e.g. Atlanta Braves",
+		"TeamID":                           "TeamID",
+		"Walks":                            "Walks (BB) - https://www.mlb.com/glossary/standard-stats/walk",
+		"WinProbabilityAdded":              "WinProbabilityAdded (WPA) - https://www.mlb.com/glossary/advanced-stats/win-probability-added",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballreferencemlb/model.MLBMatchup": {
+		"":                     "MLBMatchup represents the data model for MLB matchups scraped from baseball-reference.com",
+		"AwayScore":            "AwayScore is the away team's final score",
+		"AwayTeam":             "AwayTeam is the away team's name",
+		"AwayTeamID":           "AwayTeamID",
+		"AwayTeamLink":         "AwayTeamLink is the link to the away team's baseball-reference profile page",
+		"BoxScoreLink":         "BoxScoreLink is the link to box score for related to the event",
+		"EventDate":            "EventDate is the timestamp associated with a given event",
+		"EventDateParquet":     "EventDateParquet is the timestamp associated with a given event (in days)",
+		"EventID":              "EventID is the parsed event id from the box score link of the matchup",
+		"HomeScore":            "HomeScore is the home team's final score",
+		"HomeTeam":             "HomeTeam is the home team's name",
+		"HomeTeamID":           "HomeTeamID",
+		"HomeTeamLink":         "HomeTeamLink is the link to the home team's baseball-reference profile page",
+		"Loser":                "Loser is the losing team's name",
+		"PlayoffMatch":         "PlayoffMatch is whether or not the matchup was a playoff game",
+		"PullTimestamp":        "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet": "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballreferencemlb/model.MLBPitchingBoxScoreStats": {
+		"":                                 "MLBBattingBoxScoreStats represents the data model for MLB batting box score stats scraped from baseball-reference.com https://www.mlb.com/glossary/standard-stats https://www.mlb.com/glossary/advanced-stats",
+		"AverageChampionshipLeverageIndex": "AverageChampionshipLeverageIndex - the average pressure the pitcher saw in this game or season. 1.0 is average pressure, below 1.0 is low pressure and above 1.0 is high pressure. https://www.mlb.com/glossary/advanced-stats/leverage-index",
+		"AverageLeverageIndex":             "AverageLeverageIndex - the average pressure the pitcher saw in this game or season. 1.0 is average pressure, below 1.0 is low pressure and above 1.0 is high pressure. https://www.mlb.com/glossary/advanced-stats/leverage-index",
+		"BaseOutRunsSaved":                 "BaseOutRunsSaved (RE24) - Given the bases occupied/out situation, how many runs did the pitcher save in the resulting play. Compared to average, so 0 is average, and above 0 is better than average",
+		"BattersFaced":                     "BattersFaced (BF) - https://www.mlb.com/glossary/standard-stats/batters-faced",
+		"ChampionshipWinProbabilityAdded":  "ChampionshipWinProbabilityAdded (cWPA) in percentage notation- https://www.reddit.com/r/baseball/comments/1agut0c/comment/kojk7c4",
+		"EarnedRunAverage":                 "EarnedRunAverage (ERA) - https://www.mlb.com/glossary/standard-stats/earned-run-average",
+		"EarnedRunsAllowed":                "EarnedRunsAllowed (ER) - https://www.mlb.com/glossary/standard-stats/earned-run",
+		"EventDate":                        "EventDate is the timestamp associated with a given event",
+		"EventDateParquet":                 "EventDateParquet is the timestamp associated with a given event (in days)",
+		"EventID":                          "EventID is the parsed event id from the box score link of the matchup",
+		"FlyBalls":                         "FlyBalls - Includes Fly Balls, Line Drives, and Pop-Ups.",
+		"GameScore":                        "GameScore - https://www.mlb.com/glossary/advanced-stats/game-score",
+		"GroundBalls":                      "GroundBalls - Includes bunts and all other ground balls.",
+		"HitsAllowed":                      "HitsAllowed (H) - https://www.mlb.com/glossary/standard-stats/hit",
+		"HomeRunsAllowed":                  "HomeRunsAllowed (HR) - https://www.mlb.com/glossary/standard-stats/home-run",
+		"InheritedRunners":                 "InheritedRunners (IR) - https://www.mlb.com/glossary/standard-stats/inherited-runner",
+		"InheritedScore":                   "InheritedScore refers to runs scored by inherited runners against a relief pitcher, meaning runners on base when a relief pitcher enters the game. These runs are not charged to the relief pitcher's ERA but are tracked in statistics like Inherited Runs Allowed (IR-A) and Inherited Runs Allowed Percentage (IR-A%).",
+		"InningsPitched":                   "InningsPitched (IP) - https://www.mlb.com/glossary/standard-stats/innings-pitched",
+		"LineDrives":                       "LineDrives - These are double-counted in Fly Balls as well.",
+		"Opponent":                         "Opponent is the opposing team name",
+		"OpponentID":                       "OpponentID",
+		"PitchesPerPlateAppearance":        "Pitches Per Plate Appearance (P/PA) - https://www.mlb.com/glossary/advanced-stats/pitches-per-plate-appearance",
+		"PitchingOrder":                    "PitchingOrder - The sequence of pitchers who played during the event per team (starting from 1)",
+		"Player":                           "Player is the player's name",
+		"PlayerID":                         "PlayerID is the player's id extracted from the player's baseball-reference profile url",
+		"PlayerLink":                       "PlayerLink is the link to the player's baseball-reference profile",
+		"PullTimestamp":                    "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet":             "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"RunsAllowed":                      "RunsAllowed (R) - https://www.mlb.com/glossary/standard-stats/run",
+		"Strikeouts":                       "StrikeOuts (SO) - https://www.mlb.com/glossary/standard-stats/strikeout",
+		"Strikes":                          "Strikes - includes both pitches in the zone and those swung at out of the zone.",
+		"StrikesByContact":                 "StrikesByContact - Strikes from foul balls or balls put into play",
+		"StrikesLooking":                   "StrikesLooking - Strikes called by the umpire",
+		"StrikesSwinging":                  "StrikesSwinging - Strikes due to a swing and a miss",
+		"Team":                             "Team is the player's team name",
+		"TeamID":                           "TeamID",
+		"UnknownBattedBallType":            "UnknownBattedBallType - A ball in play for which we don’t know the type.",
+		"Walks":                            "Walks (BB) - https://www.mlb.com/glossary/standard-stats/walk",
+		"WinProbabilityAdded":              "WinProbabilityAdded (WPA) - https://www.mlb.com/glossary/advanced-stats/win-probability-added",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model.BattingBoxScore": {
+		"":                     "BattingBoxScore represents the data model for MLB batting box score stats scraped from baseballsavant.mlb.com",
+		"AirOuts":              "AirOuts - refers to a batted ball that is hit in the air, either a fly ball or a line drive, and is caught by a fielder for an out",
+		"AtBats":               "AtBats - https://www.mlb.com/glossary/standard-stats/at-bat",
+		"AtBatsPerHomeRun":     "AtBatsPerHomeRun - https://en.wikipedia.org/wiki/At_bats_per_home_run",
+		"CatchersInterference": "CatchersInterference - https://www.mlb.com/glossary/rules/catcher-interference",
+		"CaughtStealing":       "CaughtStealing - https://www.mlb.com/glossary/standard-stats/caught-stealing",
+		"Doubles":              "Doubles - https://www.mlb.com/glossary/standard-stats/double",
+		"EventID":              "EventID is a unique ID that maps to the matchup e.g. 86833",
+		"EventTime":            "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":     "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"FlyOuts":              "FlyOuts - https://www.mlb.com/glossary/standard-stats/flyout",
+		"GroundIntoDoublePlay": "GroundIntoDoublePlay - https://www.mlb.com/glossary/standard-stats/ground-into-double-play",
+		"GroundIntoTriplePlay": "GroundIntoTriplePlay - https://www.mlb.com/glossary/standard-stats/triple-play",
+		"GroundOuts":           "GroundOuts - https://www.mlb.com/glossary/standard-stats/groundout",
+		"HitByPitch":           "HitByPitch - https://www.mlb.com/glossary/standard-stats/hit-by-pitch",
+		"Hits":                 "Hits - https://www.mlb.com/glossary/standard-stats/hit",
+		"HomeRuns":             "HomeRuns - https://www.mlb.com/glossary/standard-stats/home-run",
+		"IntentionalWalks":     "IntentionalWalks - https://www.mlb.com/glossary/standard-stats/intentional-walk",
+		"LeftOnBase":           "LeftOnBase - https://www.mlb.com/glossary/standard-stats/left-on-base",
+		"LineOuts":             "LineOuts - a batter hits a line drive and a fielder catches the ball before it hits the ground",
+		"Opponent":             "Opponent is the opposing team name",
+		"OpponentID":           "OpponentID",
+		"Pickoffs":             "Pickoffs - https://www.mlb.com/glossary/standard-stats/pickoff",
+		"PlateAppearances":     "PlateAppearances - https://www.mlb.com/glossary/standard-stats/plate-appearance",
+		"Player":               "Player is the pitcher's name",
+		"PlayerID":             "PlayerID",
+		"PopOuts":              "PopOuts - a pop fly that is caught for an out",
+		"PullTimestamp":        "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet": "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"RBI":                  "RBI - https://www.mlb.com/glossary/standard-stats/runs-batted-in",
+		"Runs":                 "Runs - https://www.mlb.com/glossary/standard-stats/run",
+		"SacBunts":             "SacBunts - https://www.mlb.com/glossary/standard-stats/sacrifice-bunt",
+		"SacFlies":             "SacFlies - https://www.mlb.com/glossary/standard-stats/sacrifice-fly",
+		"StolenBases":          "StolenBases - https://www.mlb.com/glossary/standard-stats/stolen-base",
+		"Strikeouts":           "Strikeouts - https://www.mlb.com/glossary/standard-stats/strikeout",
+		"Team":                 "Team is the player's team name",
+		"TeamID":               "TeamID",
+		"TotalBases":           "TotalBases - https://www.mlb.com/glossary/standard-stats/total-bases",
+		"Triples":              "Triples - https://www.mlb.com/glossary/standard-stats/triple",
+		"Walks":                "Walks - https://www.mlb.com/glossary/standard-stats/walk",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model.FieldingBoxScore": {
+		"":                     "FieldingBoxScore represents the data model for MLB fielding box score stats scraped from baseballsavant.mlb.com",
+		"Assists":              "Assists - https://www.mlb.com/glossary/standard-stats/assist",
+		"CaughtStealing":       "CaughtStealing - https://www.mlb.com/glossary/standard-stats/caught-stealing",
+		"Chances":              "Chances - https://www.mlb.com/glossary/standard-stats/total-chances",
+		"Errors":               "Errors - https://www.mlb.com/glossary/standard-stats/error",
+		"EventID":              "EventID is a unique ID that maps to the matchup e.g. 86833",
+		"EventTime":            "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":     "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"Opponent":             "Opponent is the opposing team name",
+		"OpponentID":           "OpponentID",
+		"PassedBall":           "PassedBall - https://www.mlb.com/glossary/standard-stats/passed-ball",
+		"Pickoffs":             "Pickoffs - https://www.mlb.com/glossary/standard-stats/pickoff",
+		"Player":               "Player is the pitcher's name",
+		"PlayerID":             "PlayerID",
+		"PullTimestamp":        "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet": "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"Putouts":              "Putouts - https://www.mlb.com/glossary/standard-stats/putout",
+		"StolenBases":          "StolenBases - https://www.mlb.com/glossary/standard-stats/stolen-base",
+		"Team":                 "Team is the player's team name",
+		"TeamID":               "TeamID",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model.Matchup": {
+		"":                             "Matchup represents the data model for MLB matchups scraped from baseballsavant.mlb.com",
+		"AwayLosses":                   "AwayLosses is the away team's number of losses",
+		"AwayScore":                    "AwayScore is the away team's score at time of request",
+		"AwayStartingPitcher":          "AwayStartingPitcher the name of the away team's starting pitcher",
+		"AwayStartingPitcherID":        "AwayStartingPitcherID is the player id for the away team's starting pitcher (nillable because it may not be yet announced when fetching a scheduled event)",
+		"AwayStartingPitcherPitchHand": "AwayStartingPitcherPitchHand - R for right, L for left",
+		"AwayTeamAbbreviation":         "AwayTeamAbbreviation is the abbreviation of the away team's name e.g. LAA",
+		"AwayTeamDivisionID":           "AwayTeamDivisionID",
+		"AwayTeamDivisionName":         "AwayTeamDivisionName",
+		"AwayTeamID":                   "AwayTeamID is the away team's ID e.g. 8",
+		"AwayTeamLeagueID":             "AwayTeamLeagueID",
+		"AwayTeamLeagueName":           "AwayTeamLeagueName",
+		"AwayTeamName":                 "AwayTeamName is the away team's full name",
+		"AwayWins":                     "AwayRecord is the away team's number of wins",
+		"EventID":                      "EventID is a unique ID that maps to the matchup e.g. 86833",
+		"EventTime":                    "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":             "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"GameType":                     "GameType is an abbreviation that indicates the type of event taking place (e.g. \"R\", \"S\", \"W\", etc.)",
+		"GamesInSeries":                "GamesInSeries is the number of games being played in this series of matchup",
+		"HomeLosses":                   "HomeLosses is the home team's number of losses",
+		"HomeScore":                    "HomeScore is the home team's score at time of request",
+		"HomeStartingPitcher":          "HomeStartingPitcher the name of the home team's starting pitcher",
+		"HomeStartingPitcherID":        "HomeStartingPitcherID is the player id for the home team's starting pitcher (nillable because it may not be yet announced when fetching a scheduled event)",
+		"HomeStartingPitcherPitchHand": "HomeStartingPitcherPitchHand - R for right, L for left",
+		"HomeTeamAbbreviation":         "HomeTeamAbbreviation is the abbreviation of the home team's name e.g. DET",
+		"HomeTeamDivisionID":           "HomeTeamDivisionID",
+		"HomeTeamDivisionName":         "HomeTeamDivisionName",
+		"HomeTeamID":                   "HomeTeamID is the home team's ID",
+		"HomeTeamLeagueID":             "HomeTeamLeagueID",
+		"HomeTeamLeagueName":           "HomeTeamLeagueName",
+		"HomeTeamName":                 "HomeTeamName is the home team's full name e.g. Detroit Tigers",
+		"HomeWins":                     "HomeRecord is the home team's number of wins",
+		"Loser":                        "Loser is an optional team id associated with the loser. Optional because some games could be live a loser will not be determined until the matchup is complete.",
+		"PullTimestamp":                "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet":         "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"Season":                       "Season - e.g. 2025",
+		"SeriesDescription":            "SeriesDescription acts as supplemental information for GameType (e.g. \"Regular Season\", \"Spring Training\", \"World Series\", etc.)",
+		"SeriesGameNumber":             "SeriesGameNumber is the series game number of the event",
+		"Status":                       "Status is the string representation of the event status e.g. Final",
+		"VenueID":                      "VenueID",
+		"VenueName":                    "VenueName",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model.PitchingBoxScore": {
+		"":                     "PitchingBoxScore represents the data model for MLB pitching box score stats scraped from baseballsavant.mlb.com",
+		"AirOuts":              "AirOuts - refers to a batted ball that is hit in the air, either a fly ball or a line drive, and is caught by a fielder for an out",
+		"AtBats":               "AtBats - https://www.mlb.com/glossary/standard-stats/at-bat",
+		"Balks":                "Balk - https://www.mlb.com/glossary/standard-stats/balk",
+		"Balls":                "Balls - pitches out of the strike zone",
+		"BattersFaced":         "BattersFaced - https://www.mlb.com/glossary/standard-stats/batters-faced",
+		"BlownSaves":           "BlownSaves - https://www.mlb.com/glossary/standard-stats/blown-save",
+		"CatchersInterference": "CatchersInterference - https://www.mlb.com/glossary/rules/catcher-interference",
+		"CaughtStealing":       "CaughtStealing - https://www.mlb.com/glossary/standard-stats/caught-stealing",
+		"Doubles":              "Doubles - https://www.mlb.com/glossary/standard-stats/double",
+		"EarnedRuns":           "EarnedRuns - https://www.mlb.com/glossary/standard-stats/earned-run",
+		"EventID":              "EventID is a unique ID that maps to the matchup e.g. 86833",
+		"EventTime":            "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":     "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"FlyOuts":              "FlyOuts - https://www.mlb.com/glossary/standard-stats/flyout",
+		"GroundOuts":           "GroundOuts - https://www.mlb.com/glossary/standard-stats/groundout",
+		"HitByPitch":           "HitByPitch - https://www.mlb.com/glossary/standard-stats/hit-by-pitch",
+		"Hits":                 "Hits - https://www.mlb.com/glossary/standard-stats/hit",
+		"HomeRuns":             "HomeRuns - https://www.mlb.com/glossary/standard-stats/home-run",
+		"InheritedRunners":     "InheritedRunners - https://www.mlb.com/glossary/standard-stats/inherited-runner",
+		"InningsPitched":       "InningsPitched - https://www.mlb.com/glossary/standard-stats/innings-pitched",
+		"IntentionalWalks":     "IntentionalWalks - https://www.mlb.com/glossary/standard-stats/intentional-walk",
+		"LineOuts":             "LineOuts - a batter hits a line drive and a fielder catches the ball before it hits the ground",
+		"NumberOfPitches":      "NumberOfPitches https://www.mlb.com/glossary/standard-stats/number-of-pitches",
+		"Opponent":             "Opponent is the opposing team name",
+		"OpponentID":           "OpponentID",
+		"Outs":                 "Outs - https://www.mlb.com/glossary/standard-stats/out",
+		"PassedBall":           "PassedBall -https://www.mlb.com/glossary/standard-stats/passed-ball",
+		"Pickoffs":             "Pickoffs - https://www.mlb.com/glossary/standard-stats/pickoff",
+		"Player":               "Player is the pitcher's name",
+		"PlayerID":             "PlayerID",
+		"PopOuts":              "PopOuts - a pop fly that is caught for an out",
+		"PullTimestamp":        "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet": "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"RBI":                  "RBI - https://www.mlb.com/glossary/standard-stats/runs-batted-in",
+		"Runs":                 "Runs - https://www.mlb.com/glossary/standard-stats/run",
+		"SacBunts":             "SacBunts - https://www.mlb.com/glossary/standard-stats/sacrifice-bunt",
+		"SacFlies":             "SacFlies - https://www.mlb.com/glossary/standard-stats/sacrifice-fly",
+		"Saves":                "Saves - https://www.mlb.com/glossary/standard-stats/save",
+		"Shutouts":             "Shutouts - https://www.mlb.com/glossary/standard-stats/shutout",
+		"StolenBases":          "StolenBases - https://www.mlb.com/glossary/standard-stats/stolen-base",
+		"Strikeouts":           "Strikeouts - https://www.mlb.com/glossary/standard-stats/strikeout",
+		"Strikes":              "Strikes - https://en.wikipedia.org/wiki/Glossary_of_baseball_terms#strike",
+		"Team":                 "Team is the player's team name",
+		"TeamID":               "TeamID",
+		"Triples":              "Triples - https://www.mlb.com/glossary/standard-stats/triple",
+		"Walks":                "Walks - https://www.mlb.com/glossary/standard-stats/walk",
+		"WildPitches":          "WildPitches - https://www.mlb.com/glossary/standard-stats/wild-pitch",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model.PlayByPlay": {
+		"":                               "PlayByPlay represents the data model for MLB event play by play scraped from baseballsavant.mlb.com",
+		"AtBatNum":                       "AtBatNum",
+		"Balls":                          "Balls",
+		"BatterID":                       "BatterID",
+		"BatterName":                     "BatterName",
+		"BatterStand":                    "BatterStand - the abbreviation of the side of the home plate the batter is standing R = the first-base side of the home plate, L = the third-base side of home plate",
+		"CallDescription":                "CallDescription",
+		"CallName":                       "CallName is the call on the play",
+		"EventID":                        "EventID is a unique ID that maps to the matchup e.g. 86833",
+		"EventTime":                      "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":               "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"GameTotalPitches":               "GameTotalPitches",
+		"HitDistance":                    "HitDistance",
+		"HitSpeed":                       "HitSpeed",
+		"Inning":                         "Inning",
+		"IsStrikeSwinging":               "IsStrikeSwinging",
+		"Outs":                           "Outs",
+		"PitchEndSpeed":                  "PitchEndSpeed",
+		"PitchName":                      "PitchName",
+		"PitchNumber":                    "PitchNumber",
+		"PitchStartSpeed":                "PitchStartSpeed",
+		"PitchType":                      "PitchType",
+		"PitcherID":                      "PitcherID",
+		"PitcherName":                    "PitcherName",
+		"PitcherThrow":                   "PitcherThrow - https://www.mlb.com/glossary/pitch-types",
+		"PitcherTotalPitches":            "PitcherTotalPitches",
+		"PitcherTotalPitchesByPitchType": "PitcherTotalPitchesByPitchType",
+		"PlayID":                         "PlayID",
+		"PreBalls":                       "PreBalls - the number of balls before the current play",
+		"PreStrikes":                     "PreStrikes - the number of strikes before the current play",
+		"PullTimestamp":                  "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet":           "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"Result":                         "Result - the terminal outcome of the batter",
+		"ResultDescription":              "ResultDescription extrapolates on Result",
+		"Strikes":                        "Strikes",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/basketballreferencenba/model.NBAAdvBoxScoreStats": {
+		"":                             "NBAAdvBoxScoreStats represents the data model for NBA advanced box score stats scraped from basketball-reference.com",
+		"AssistPercentage":             "AssistPercentage - An estimate of the percentage of teammate field goals a player assisted while they were on the floor (in percent notation).",
+		"BlockPercentage":              "BlockPercentage - An estimate of the percentage of opponent two-point field goal attempts blocked by the player while they were on the floor (in percent notation).",
+		"BoxPlusMinus":                 "BoxPlusMinus - A box score estimate of the points per 100 possessions a player contributed above a league-average player, translated to an average team.",
+		"DefensiveRating":              "DefensiveRating - An estimate of points allowed per 100 possessions",
+		"DefensiveReboundPercentage":   "DefensiveReboundPercentage - An estimate of the percentage of available defensive rebounds a player grabbed while they were on the floor (in percent notation).",
+		"EffectiveFieldGoalPercentage": "EffectiveFieldGoalPercentage - This statistic adjusts for the fact that a 3-point field goal is worth one more point than a 2-point field goal (in decimal notation).",
+		"EventDate":                    "EventDate is the timestamp associated with a given event",
+		"EventDateParquet":             "EventDateParquet is the timestamp associated with a given event (in days)",
+		"EventID":                      "EventID is the parsed event id from the box score link of the matchup",
+		"FreeThrowAttemptRate":         "FreeThrowAttemptRate - Number of FT Attempts Per FG Attempt (in decimal notation).",
+		"MinutesPlayed":                "MinutesPlayed is minutes played during the event",
+		"OffensiveRating":              "OffensiveRating - An estimate of points produced (players) or scored (teams) per 100 possessions",
+		"OffensiveReboundPercentage":   "OffensiveReboundPercentage - An estimate of the percentage of available offensive rebounds a player grabbed while they were on the floor (in percent notation).",
+		"Opponent":                     "Opponent is the opposing team name",
+		"OpponentID":                   "OpponentID",
+		"Player":                       "Player is the player's name",
+		"PlayerID":                     "PlayerID is the player's id extracted from the player's basketball-reference profile url",
+		"PlayerLink":                   "PlayerLink is the link to the player's basketball-reference profile",
+		"PullTimestamp":                "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet":         "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"Starter":                      "Starter is whether the player was apart of the team's starting five during the event or not",
+		"StealPercentage":              "StealPercentage - An estimate of the percentage of opponent possessions that end with a steal by the player while they were on the floor (in percent notation).",
+		"Team":                         "Team is the player's team name",
+		"TeamID":                       "TeamID",
+		"ThreePointAttemptRate":        "ThreePointAttemptRate - Percentage of FG Attempts from 3-Point Range (in decimal notation).",
+		"TotalReboundPercentage":       "TotalReboundPercentage - An estimate of the percentage of available rebounds a player grabbed while they were on the floor (in percent notation).",
+		"TrueShootingPercentage":       "TrueShootingPercentage - A measure of shooting efficiency that takes into account 2-point field goals, 3-point field goals, and free throws (in decimal notation).",
+		"TurnoverPercentage":           "TurnoverPercentage - An estimate of turnovers committed per 100 plays (in percent notation).",
+		"UsagePercentage":              "UsagePercentage - An estimate of the percentage of team plays used by a player while they were on the floor (in percent notation).",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/basketballreferencenba/model.NBABasicBoxScoreStats": {
+		"":                     "NBABasicBoxScoreStats represents the data model for NBA basic box score stats scraped from basketball-reference.com",
+		"Assists":              "Assists is the number of assists",
+		"Blocks":               "Blocks is the number of blocks",
+		"DefensiveRebounds":    "DefensiveRebounds is the number of defensive rebounds",
+		"EventDate":            "EventDate is the timestamp associated with a given event",
+		"EventDateParquet":     "EventDateParquet is the timestamp associated with a given event (in days)",
+		"EventID":              "EventID is the parsed event id from the box score link of the matchup",
+		"FieldGoalAttempts":    "FieldGoalAttempts is the number of field goals attempted",
+		"FieldGoalPercentage":  "FieldGoalPercentage is the field goal percentage",
+		"FieldGoalsMade":       "FieldGoalsMade is the number of field goals made",
+		"FreeThrowAttempts":    "FreeThrowAttempts is the number of free throw attempts",
+		"FreeThrowPercentage":  "FreeThrowPercentage is the free throw percentage",
+		"FreeThrowsMade":       "FreeThrowsMade is the number of free throws made",
+		"GameScore":            "GameScore is a metric used to evaluate how well a player performs in a single game",
+		"MinutesPlayed":        "MinutesPlayed is minutes played during the event",
+		"OffensiveRebounds":    "OffensiveRebounds is the number of offensive rebounds",
+		"Opponent":             "Opponent is the opposing team name",
+		"OpponentID":           "OpponentID",
+		"PersonalFouls":        "PersonalFouls is the number of personal fouls",
+		"Player":               "Player is the player's name",
+		"PlayerID":             "PlayerID is the player's id extracted from the player's basketball-reference profile url",
+		"PlayerLink":           "PlayerLink is the link to the player's basketball-reference profile",
+		"PlusMinus":            "PlusMinus is the plus-minus",
+		"Points":               "Points is the number of points",
+		"PullTimestamp":        "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet": "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"Starter":              "Starter is whether the player was apart of the team's starting five during the event or not",
+		"Steals":               "Steals is the number of steals",
+		"Team":                 "Team is the player's team name",
+		"TeamID":               "TeamID",
+		"ThreePointAttempts":   "ThreePointAttempts is the number of three point attempts",
+		"ThreePointPercentage": "ThreePointPercentage is the three point percentage",
+		"ThreePointsMade":      "ThreePointsMade is the number of three pointers made",
+		"TotalRebounds":        "TotalRebounds is the number of total rebounds",
+		"Turnovers":            "Turnovers is the number of turnovers",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/basketballreferencenba/model.NBAMatchup": {
+		"":                     "NBAMatchup represents the data model for NBA matchups scraped from basketball-reference.com",
+		"AwayQ1Total":          "AwayQ1Total is the away team's Q1 points scored",
+		"AwayQ2Total":          "AwayQ2Total is the away team's Q2 points scored",
+		"AwayQ3Total":          "AwayQ3Total is the away team's Q3 points scored",
+		"AwayQ4Total":          "AwayQ4Total is the away team's Q4 points scored",
+		"AwayScore":            "AwayScore is the away team's final score",
+		"AwayTeam":             "AwayTeam is the away team's name",
+		"AwayTeamID":           "AwayTeamID",
+		"AwayTeamLink":         "AwayTeamLink is the link to the away team's basketball-reference profile page",
+		"BoxScoreLink":         "BoxScoreLink is the link to box score for related to the event",
+		"EventDate":            "EventDate is the timestamp associated with a given event",
+		"EventDateParquet":     "EventDateParquet is the timestamp associated with a given event (in days)",
+		"EventID":              "EventID is the parsed event id from the box score link of the matchup",
+		"HomeQ1Total":          "HomeQ1Total is the home team's Q1 points scored",
+		"HomeQ2Total":          "HomeQ2Total is the home team's Q2 points scored",
+		"HomeQ3Total":          "HomeQ3Total is the home team's Q3 points scored",
+		"HomeQ4Total":          "HomeQ4Total is the home team's Q4 points scored",
+		"HomeScore":            "HomeScore is the home team's final score",
+		"HomeTeam":             "HomeTeam is the home team's name",
+		"HomeTeamID":           "HomeTeamID",
+		"HomeTeamLink":         "HomeTeamLink is the link to the home team's basketball-reference profile page",
+		"Loser":                "Loser is the losing team's name",
+		"PullTimestamp":        "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet": "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/espn/mma/model.FightDetails": {
+		"AwayBetsProviderID":   "Away Bets (flattened)",
+		"AwayBodyImage":        "Away (flattened Fighter)",
+		"AwayStatsBodyTotal":   "Away Stats (flattened)",
+		"EventTime":            "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":     "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"HomeBetsProviderID":   "Home Bets (flattened)",
+		"HomeBodyImage":        "Home (flattened Fighter)",
+		"HomeStatsBodyTotal":   "Home Stats (flattened)",
+		"PullTimestamp":        "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet": "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/espn/mma/model.Matchup": {
+		"EventID":              "EventID is a unique ID that maps to the matchup e.g. 600044733",
+		"EventTime":            "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":     "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"PullTimestamp":        "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet": "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model.MLBBattingBoxScoreStats": {
+		"":                     "MLBBattingBoxScoreStats - data model for MLB batting box score stats",
+		"AtBat":                "AtBat (AB) - https://www.mlb.com/glossary/standard-stats/at-bat",
+		"BattingAverage":       "BattingAverage (AVG) - https://www.mlb.com/glossary/standard-stats/batting-average",
+		"EventID":              "EventID is a unique ID that maps to the matchup e.g. 86833",
+		"EventTime":            "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":     "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"Hits":                 "Hits (H) - https://www.mlb.com/glossary/standard-stats/hit",
+		"LeftOnBase":           "LeftOnBase (LOB) - https://www.mlb.com/glossary/standard-stats/left-on-base",
+		"Opponent":             "Opponent is the opposing team name",
+		"OpponentID":           "OpponentID is the opposing team's team id",
+		"Player":               "Player is the player's name",
+		"PlayerID":             "PlayerID is the player's id",
+		"Position":             "Position is the player's position",
+		"PullTimestamp":        "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet": "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"Runs":                 "Runs (R) - https://www.mlb.com/glossary/standard-stats/run",
+		"RunsBattedIn":         "RunsBattedIn (RBI) - https://www.mlb.com/glossary/standard-stats/runs-batted-in",
+		"Strikeouts":           "Strikeouts (SO) - https://www.mlb.com/glossary/standard-stats/strikeout",
+		"Team":                 "Team is the player's team name e.g. Atlanta Braves",
+		"TeamID":               "TeamID is the player's team's ID e.g. 21",
+		"Walks":                "Walks (BB) - https://www.mlb.com/glossary/standard-stats/walk",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model.MLBOddsMoneyLine": {
+		"":                     "MLBOddsMoneyLine - data model for MLB money line bettings odds",
+		"AwayTeamID":           "AwayTeamID is the away team's ID e.g. 8",
+		"AwayTeamNameFull":     "AwayTeamNameFull is the away team's full name e.g. Los Angeles Angels",
+		"AwayTeamOdds":         "AwayTeamOdds",
+		"EventID":              "EventID is a unique ID that maps to the matchup e.g. 86833",
+		"EventTime":            "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":     "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"HomeTeamID":           "HomeTeamID is the home team's ID e.g. 21",
+		"HomeTeamNameFull":     "HomeTeamNameFull is the home team's full name e.g. Atlanta Braves",
+		"HomeTeamOdds":         "HomeTeamOdds",
+		"PullTimestamp":        "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet": "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model.MLBOddsTotal": {
+		"":                     "MLBOddsTotal - data model for MLB total bettings odds",
+		"AwayTeamID":           "AwayTeamID is the away team's ID e.g. 8",
+		"AwayTeamNameFull":     "AwayTeamNameFull is the away team's full name e.g. Los Angeles Angels",
+		"EventID":              "EventID is a unique ID that maps to the matchup e.g. 86833",
+		"EventTime":            "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":     "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"HomeTeamID":           "HomeTeamID is the home team's ID e.g. 21",
+		"HomeTeamNameFull":     "HomeTeamNameFull is the home team's full name e.g. Atlanta Braves",
+		"OverOdds":             "OverOdds",
+		"PullTimestamp":        "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet": "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"TotalLine":            "TotalLine",
+		"UnderOdds":            "UnderOdds",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model.MLBPitchingBoxScoreStats": {
+		"":                     "MLBPitchingBoxScoreStats - data model for MLB pitching box score stats",
+		"EarnedRunAverage":     "EarnedRunAverage (ERA) - https://www.mlb.com/glossary/standard-stats/earned-run-average",
+		"EarnedRunsAllowed":    "EarnedRunsAllowed (ER) - https://www.mlb.com/glossary/standard-stats/earned-run",
+		"EventID":              "EventID is a unique ID that maps to the matchup e.g. 86833",
+		"EventTime":            "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":     "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"HitsAllowed":          "HitsAllowed (H) - https://www.mlb.com/glossary/standard-stats/hit",
+		"HomeRunsAllowed":      "HomeRunsAllowed (HR) - https://www.mlb.com/glossary/standard-stats/home-run",
+		"InningsPitched":       "InningsPitched (IP) - https://www.mlb.com/glossary/standard-stats/innings-pitched",
+		"Opponent":             "Opponent is the opposing team name",
+		"OpponentID":           "OpponentID is the opposing team's team id",
+		"PitchingOrder":        "PitchingOrder - The sequence of pitchers who played during the event per team (starting from 1)",
+		"Player":               "Player is the player's name",
+		"PlayerID":             "PlayerID is the player's id",
+		"PullTimestamp":        "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet": "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"Record":               "Record is starting pitcher's record",
+		"RunsAllowed":          "RunsAllowed (R) - https://www.mlb.com/glossary/standard-stats/run",
+		"Strikeouts":           "StrikeOuts (SO) - https://www.mlb.com/glossary/standard-stats/strikeout",
+		"Team":                 "Team is the player's team name e.g. Atlanta Braves",
+		"TeamID":               "TeamID is the player's team's ID e.g. 21",
+		"Walks":                "Walks (BB) - https://www.mlb.com/glossary/standard-stats/walk",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model.MLBProbableStartingPitcher": {
+		"":                      "MLBProbableStartingPitcher - data model for MLB probable starting pitcher",
+		"EventID":               "EventID is a unique ID that maps to the matchup e.g. 86833",
+		"EventTime":             "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":      "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"PullTimestamp":         "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet":  "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"StartingPitcher":       "StartingPitcher the name of the team's starting pitcher",
+		"StartingPitcherERA":    "StartingPitcherERA is the team's starting pitcher's earned run average - https://www.mlb.com/glossary/standard-stats/earned-run-average Please note: the API does not provide PIT starting pitcher ERA! This field is not reliable for PIT calculations.",
+		"StartingPitcherID":     "StartingPitcherID is the player id for the team's starting pitcher",
+		"StartingPitcherRecord": "StartingPitcherRecord is the record of the team's starting pitcher Please note: the API does not provide PIT starting pitcher record! This field is not reliable for PIT calculations.",
+		"TeamID":                "TeamID is the team's ID e.g. 21",
+		"TeamNameFull":          "TeamNameFull is the team's full name e.g. Atlanta Braves",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model.Matchup": {
+		"":                     "Matchup represents the data model for NBA, MLB, NFL, and NCAAB matchups scraped from foxsports.com",
+		"AwayRank":             "AwayRank is an optional rank. The values are expected for some NCAAB teams",
+		"AwayRecord":           "AwayRecord is the away team's record at time of request e.g. 56-53",
+		"AwayScore":            "AwayScore is the away team's score at time of request e.g. 5",
+		"AwayTeamAbbreviation": "AwayTeamAbbreviation is the abbreviation of the away team's name e.g. LAA",
+		"AwayTeamID":           "AwayTeamID is the away team's ID e.g. 8",
+		"AwayTeamNameFull":     "AwayTeamNameFull is the away team's full name e.g. Los Angeles Angels",
+		"AwayTeamNameLong":     "AwayTeamNameLong is the away team's longer name but not the full name e.g. Angels",
+		"EventID":              "EventID is a unique ID that maps to the matchup e.g. 86833",
+		"EventStatus":          "EventStatus the numerical representation of the event status e.g. 3",
+		"EventTime":            "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":     "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"HomeRank":             "HomeRank is an optional rank. The values are expected for some NCAAB teams",
+		"HomeRecord":           "HomeRecord is the home team's record at time of request e.g. 69-37",
+		"HomeScore":            "HomeScore is the home team's score at time of request e.g. 12",
+		"HomeTeamAbbreviation": "HomeTeamAbbreviation is the abbreviation of the home team's name e.g. ATL",
+		"HomeTeamID":           "HomeTeamID is the home team's ID e.g. 21",
+		"HomeTeamNameFull":     "HomeTeamNameFull is the home team's full name e.g. Atlanta Braves",
+		"HomeTeamNameLong":     "HomeTeamNameLong is the home team's longer name but not the full name e.g. Braves",
+		"IsPlayoff":            "IsPlayoff indicates whether the matchup is a playoff game",
+		"Loser":                "Loser is an optional team id associated with the loser. Optional because some games could be live a loser will not be determined until the matchup is complete.",
+		"PullTimestamp":        "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet": "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"StatusLine":           "StatusLine the string representation of the event status e.g. FINAL",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model.NBABoxScoreStats": {
+		"":                     "NBABoxScoreStats - data model for NBA box score stats",
+		"Assists":              "Assists is the number of assists",
+		"Blocks":               "Blocks is the number of blocks",
+		"DefensiveRebounds":    "DefensiveRebounds is the number of defensive rebounds",
+		"EventID":              "EventID is a unique ID that maps to the matchup e.g. 43331",
+		"EventTime":            "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":     "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"FieldGoalAttempts":    "FieldGoalAttempts is the number of field goals attempted",
+		"FieldGoalsMade":       "FieldGoalsMade is the number of field goals made",
+		"FreeThrowAttempts":    "FreeThrowAttempts is the number of free throw attempts",
+		"FreeThrowsMade":       "FreeThrowsMade is the number of free throws made",
+		"MinutesPlayed":        "MinutesPlayed is minutes played during the event",
+		"OffensiveRebounds":    "OffensiveRebounds is the number of offensive rebounds",
+		"Opponent":             "Opponent is the opposing team name",
+		"OpponentID":           "OpponentID is the opposing team's team id",
+		"PersonalFouls":        "PersonalFouls is the number of personal fouls",
+		"Player":               "Player is the player's name",
+		"PlayerID":             "PlayerID is the player's id",
+		"Points":               "Points is the number of points",
+		"Position":             "Position is the player's position",
+		"PullTimestamp":        "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet": "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"Starter":              "Starter is whether the player was apart of the team's starting five during the event or not",
+		"Steals":               "Steals is the number of steals",
+		"Team":                 "Team is the player's team name e.g. Atlanta Hawks",
+		"TeamID":               "TeamID is the player's team's ID e.g. 8",
+		"ThreePointAttempts":   "ThreePointAttempts is the number of three point attempts",
+		"ThreePointsMade":      "ThreePointsMade is the number of three pointers made",
+		"TotalRebounds":        "TotalRebounds is the number of total rebounds",
+		"Turnovers":            "Turnovers is the number of turnovers",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model.BoxScoreAdvanced": {
+		"":                             "BoxScoreAdvanced - composite key: event_id, player_id",
+		"AssistPercentage":             "AssistPercentage",
+		"AssistRatio":                  "AssistRatio",
+		"AssistToTurnover":             "AssistToTurnover",
+		"DefensiveRating":              "DefensiveRating",
+		"DefensiveReboundPercentage":   "DefensiveReboundPercentage",
+		"EffectiveFieldGoalPercentage": "EffectiveFieldGoalPercentage",
+		"EstimatedDefensiveRating":     "EstimatedDefensiveRating",
+		"EstimatedNetRating":           "EstimatedNetRating",
+		"EstimatedOffensiveRating":     "EstimatedOffensiveRating",
+		"EstimatedPace":                "EstimatedPace",
+		"EstimatedUsagePercentage":     "EstimatedUsagePercentage",
+		"EventID":                      "EventID is a string ID that maps to the matchup e.g. 0022500249",
+		"EventStatus":                  "EventStatus the numerical representation of the event status e.g. 3 (1=pregame, 2=in progress, 3=final)",
+		"EventStatusText":              "EventStatusText (e.g. Final, Final/OT2, etc.)",
+		"EventTime":                    "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":             "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"Minutes":                      "Minutes",
+		"NetRating":                    "NetRating",
+		"OffensiveRating":              "OffensiveRating",
+		"OffensiveReboundPercentage":   "OffensiveReboundPercentage",
+		"OpponentID":                   "OpponentID",
+		"OpponentName":                 "OpponentName",
+		"OpponentNameFull":             "OpponentNameFull",
+		"PIE":                          "PIE",
+		"Pace":                         "Pace",
+		"PacePer40":                    "PacePer40",
+		"PlayerID":                     "PlayerID",
+		"PlayerName":                   "PlayerName",
+		"Position":                     "Position",
+		"Possessions":                  "Possessions",
+		"PullTimestamp":                "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet":         "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"ReboundPercentage":            "ReboundPercentage",
+		"Starter":                      "Starter",
+		"TeamID":                       "TeamID",
+		"TeamName":                     "TeamName",
+		"TeamNameFull":                 "TeamNameFull",
+		"TrueShootingPercentage":       "TrueShootingPercentage",
+		"TurnoverRatio":                "TurnoverRatio",
+		"UsagePercentage":              "UsagePercentage",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model.BoxScoreDefense": {
+		"":                              "BoxScoreDefense - composite key: event_id, player_id",
+		"Blocks":                        "Blocks",
+		"DefensiveRebounds":             "DefensiveRebounds",
+		"EventID":                       "EventID is a string ID that maps to the matchup e.g. 0022500249",
+		"EventStatus":                   "EventStatus the numerical representation of the event status e.g. 3 (1=pregame, 2=in progress, 3=final)",
+		"EventStatusText":               "EventStatusText (e.g. Final, Final/OT2, etc.)",
+		"EventTime":                     "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":              "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"MatchupAssists":                "MatchupAssists",
+		"MatchupFieldGoalPercentage":    "MatchupFieldGoalPercentage",
+		"MatchupFieldGoalsAttempted":    "MatchupFieldGoalsAttempted",
+		"MatchupFieldGoalsMade":         "MatchupFieldGoalsMade",
+		"MatchupMinutes":                "MatchupMinutes",
+		"MatchupThreePointerPercentage": "MatchupThreePointerPercentage",
+		"MatchupThreePointersAttempted": "MatchupThreePointersAttempted",
+		"MatchupThreePointersMade":      "MatchupThreePointersMade",
+		"MatchupTurnovers":              "MatchupTurnovers",
+		"OpponentID":                    "OpponentID",
+		"OpponentName":                  "OpponentName",
+		"OpponentNameFull":              "OpponentNameFull",
+		"PartialPossessions":            "PartialPossessions",
+		"PlayerID":                      "PlayerID",
+		"PlayerName":                    "PlayerName",
+		"PlayerPoints":                  "PlayerPoints",
+		"Position":                      "Position",
+		"PullTimestamp":                 "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet":          "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"Starter":                       "Starter",
+		"Steals":                        "Steals",
+		"SwitchesOn":                    "SwitchesOn",
+		"TeamID":                        "TeamID",
+		"TeamName":                      "TeamName",
+		"TeamNameFull":                  "TeamNameFull",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model.BoxScoreFourFactors": {
+		"":                                "BoxScoreFourFactors - composite key: event_id, player_id",
+		"EffectiveFieldGoalPercentage":    "EffectiveFieldGoalPercentage",
+		"EventID":                         "EventID is a string ID that maps to the matchup e.g. 0022500249",
+		"EventStatus":                     "EventStatus the numerical representation of the event status e.g. 3 (1=pregame, 2=in progress, 3=final)",
+		"EventStatusText":                 "EventStatusText (e.g. Final, Final/OT2, etc.)",
+		"EventTime":                       "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":                "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"FreeThrowAttemptRate":            "FreeThrowAttemptRate",
+		"Minutes":                         "Minutes",
+		"OffensiveReboundPercentage":      "OffensiveReboundPercentage",
+		"OppEffectiveFieldGoalPercentage": "OppEffectiveFieldGoalPercentage",
+		"OppFreeThrowAttemptRate":         "OppFreeThrowAttemptRate",
+		"OppOffensiveReboundPercentage":   "OppOffensiveReboundPercentage",
+		"OppTeamTurnoverPercentage":       "OppTeamTurnoverPercentage",
+		"OpponentID":                      "OpponentID",
+		"OpponentName":                    "OpponentName",
+		"OpponentNameFull":                "OpponentNameFull",
+		"PlayerID":                        "PlayerID",
+		"PlayerName":                      "PlayerName",
+		"Position":                        "Position",
+		"PullTimestamp":                   "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet":            "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"Starter":                         "Starter",
+		"TeamID":                          "TeamID",
+		"TeamName":                        "TeamName",
+		"TeamNameFull":                    "TeamNameFull",
+		"TeamTurnoverPercentage":          "TeamTurnoverPercentage",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model.BoxScoreHustle": {
+		"":                             "BoxScoreHustle - composite key: event_id, player_id",
+		"BoxOutPlayerRebounds":         "BoxOutPlayerRebounds",
+		"BoxOutPlayerTeamRebounds":     "BoxOutPlayerTeamRebounds",
+		"BoxOuts":                      "BoxOuts",
+		"ChargesDrawn":                 "ChargesDrawn",
+		"ContestedShots":               "ContestedShots",
+		"ContestedShots2pt":            "ContestedShots2pt",
+		"ContestedShots3pt":            "ContestedShots3pt",
+		"DefensiveBoxOuts":             "DefensiveBoxOuts",
+		"Deflections":                  "Deflections",
+		"EventID":                      "EventID is a string ID that maps to the matchup e.g. 0022500249",
+		"EventStatus":                  "EventStatus the numerical representation of the event status e.g. 3 (1=pregame, 2=in progress, 3=final)",
+		"EventStatusText":              "EventStatusText (e.g. Final, Final/OT2, etc.)",
+		"EventTime":                    "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":             "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"LooseBallsRecoveredDefensive": "LooseBallsRecoveredDefensive",
+		"LooseBallsRecoveredOffensive": "LooseBallsRecoveredOffensive",
+		"LooseBallsRecoveredTotal":     "LooseBallsRecoveredTotal",
+		"Minutes":                      "Minutes",
+		"OffensiveBoxOuts":             "OffensiveBoxOuts",
+		"OpponentID":                   "OpponentID",
+		"OpponentName":                 "OpponentName",
+		"OpponentNameFull":             "OpponentNameFull",
+		"PlayerID":                     "PlayerID",
+		"PlayerName":                   "PlayerName",
+		"Points":                       "Points",
+		"Position":                     "Position",
+		"PullTimestamp":                "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet":         "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"ScreenAssistPoints":           "ScreenAssistPoints",
+		"ScreenAssists":                "ScreenAssists",
+		"Starter":                      "Starter",
+		"TeamID":                       "TeamID",
+		"TeamName":                     "TeamName",
+		"TeamNameFull":                 "TeamNameFull",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model.BoxScoreLive": {
+		"":                        "BoxScoreLive - composite key: event_id, player_id",
+		"Assists":                 "Assists",
+		"Blocks":                  "Blocks",
+		"BlocksReceived":          "BlocksReceived",
+		"EventID":                 "EventID is a string ID that maps to the matchup e.g. 0022500249",
+		"EventStatus":             "EventStatus the numerical representation of the event status e.g. 3 (1=pregame, 2=in progress, 3=final)",
+		"EventStatusText":         "EventStatusText (e.g. Final, Final/OT2, etc.)",
+		"EventTime":               "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":        "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"FieldGoalsAttempted":     "FieldGoalsAttempted",
+		"FieldGoalsMade":          "FieldGoalsMade",
+		"FieldGoalsPercentage":    "FieldGoalsPercentage",
+		"FoulsDrawn":              "FoulsDrawn",
+		"FoulsOffensive":          "FoulsOffensive",
+		"FoulsPersonal":           "FoulsPersonal",
+		"FoulsTechnical":          "FoulsTechnical",
+		"FreeThrowsAttempted":     "FreeThrowsAttempted",
+		"FreeThrowsMade":          "FreeThrowsMade",
+		"FreeThrowsPercentage":    "FreeThrowsPercentage",
+		"Minus":                   "Minus",
+		"Minutes":                 "Minutes",
+		"MinutesCalculated":       "MinutesCalculated",
+		"OpponentID":              "OpponentID",
+		"OpponentName":            "OpponentName",
+		"OpponentNameFull":        "OpponentNameFull",
+		"PlayerID":                "PlayerID",
+		"PlayerName":              "PlayerName",
+		"Plus":                    "Plus",
+		"PlusMinusPoints":         "PlusMinusPoints",
+		"Points":                  "Points",
+		"PointsFastBreak":         "PointsFastBreak",
+		"PointsInThePaint":        "PointsInThePaint",
+		"PointsSecondChance":      "PointsSecondChance",
+		"Position":                "Position",
+		"PullTimestamp":           "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet":    "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"ReboundsDefensive":       "ReboundsDefensive",
+		"ReboundsOffensive":       "ReboundsOffensive",
+		"ReboundsTotal":           "ReboundsTotal",
+		"Starter":                 "Starter",
+		"Status":                  "Status",
+		"Steals":                  "Steals",
+		"TeamID":                  "TeamID",
+		"TeamName":                "TeamName",
+		"TeamNameFull":            "TeamNameFull",
+		"ThreePointersAttempted":  "ThreePointersAttempted",
+		"ThreePointersMade":       "ThreePointersMade",
+		"ThreePointersPercentage": "ThreePointersPercentage",
+		"Turnovers":               "Turnovers",
+		"TwoPointersAttempted":    "TwoPointersAttempted",
+		"TwoPointersMade":         "TwoPointersMade",
+		"TwoPointersPercentage":   "TwoPointersPercentage",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model.BoxScoreMatchups": {
+		"":                               "BoxScoreMatchups - composite key: event_id, player_id, opponent_player_id",
+		"EventID":                        "EventID is a string ID that maps to the matchup e.g. 0022500249",
+		"EventStatus":                    "EventStatus the numerical representation of the event status e.g. 3 (1=pregame, 2=in progress, 3=final)",
+		"EventStatusText":                "EventStatusText (e.g. Final, Final/OT2, etc.)",
+		"EventTime":                      "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":               "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"HelpBlocks":                     "HelpBlocks",
+		"HelpFieldGoalsAttempted":        "HelpFieldGoalsAttempted",
+		"HelpFieldGoalsMade":             "HelpFieldGoalsMade",
+		"HelpFieldGoalsPercentage":       "HelpFieldGoalsPercentage",
+		"MatchupAssists":                 "MatchupAssists",
+		"MatchupBlocks":                  "MatchupBlocks",
+		"MatchupFieldGoalsAttempted":     "MatchupFieldGoalsAttempted",
+		"MatchupFieldGoalsMade":          "MatchupFieldGoalsMade",
+		"MatchupFieldGoalsPercentage":    "MatchupFieldGoalsPercentage",
+		"MatchupFreeThrowsAttempted":     "MatchupFreeThrowsAttempted",
+		"MatchupFreeThrowsMade":          "MatchupFreeThrowsMade",
+		"MatchupMinutes":                 "MatchupMinutes",
+		"MatchupMinutesSort":             "MatchupMinutesSort",
+		"MatchupPotentialAssists":        "MatchupPotentialAssists",
+		"MatchupThreePointersAttempted":  "MatchupThreePointersAttempted",
+		"MatchupThreePointersMade":       "MatchupThreePointersMade",
+		"MatchupThreePointersPercentage": "MatchupThreePointersPercentage",
+		"MatchupTurnovers":               "MatchupTurnovers",
+		"OpponentID":                     "OpponentID",
+		"OpponentName":                   "OpponentName",
+		"OpponentNameFull":               "OpponentNameFull",
+		"OpponentPlayerID":               "OpponentPlayerID",
+		"OpponentPlayerName":             "OpponentPlayerName",
+		"PartialPossessions":             "PartialPossessions",
+		"PercentageDefenderTotalTime":    "PercentageDefenderTotalTime",
+		"PercentageOffensiveTotalTime":   "PercentageOffensiveTotalTime",
+		"PercentageTotalTimeBothOn":      "PercentageTotalTimeBothOn",
+		"PlayerID":                       "PlayerID",
+		"PlayerName":                     "PlayerName",
+		"PlayerPoints":                   "PlayerPoints",
+		"Position":                       "Position",
+		"PullTimestamp":                  "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet":           "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"ShootingFouls":                  "ShootingFouls",
+		"Starter":                        "Starter",
+		"SwitchesOn":                     "SwitchesOn",
+		"TeamID":                         "TeamID",
+		"TeamName":                       "TeamName",
+		"TeamNameFull":                   "TeamNameFull",
+		"TeamPoints":                     "TeamPoints",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model.BoxScoreMisc": {
+		"":                      "BoxScoreMisc - composite key: event_id, player_id",
+		"Blocks":                "Blocks",
+		"BlocksAgainst":         "BlocksAgainst",
+		"EventID":               "EventID is a string ID that maps to the matchup e.g. 0022500249",
+		"EventStatus":           "EventStatus the numerical representation of the event status e.g. 3 (1=pregame, 2=in progress, 3=final)",
+		"EventStatusText":       "EventStatusText (e.g. Final, Final/OT2, etc.)",
+		"EventTime":             "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":      "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"FoulsDrawn":            "FoulsDrawn",
+		"FoulsPersonal":         "FoulsPersonal",
+		"Minutes":               "Minutes",
+		"OppPointsFastBreak":    "OppPointsFastBreak",
+		"OppPointsOffTurnovers": "OppPointsOffTurnovers",
+		"OppPointsPaint":        "OppPointsPaint",
+		"OppPointsSecondChance": "OppPointsSecondChance",
+		"OpponentID":            "OpponentID",
+		"OpponentName":          "OpponentName",
+		"OpponentNameFull":      "OpponentNameFull",
+		"PlayerID":              "PlayerID",
+		"PlayerName":            "PlayerName",
+		"PointsFastBreak":       "PointsFastBreak",
+		"PointsOffTurnovers":    "PointsOffTurnovers",
+		"PointsPaint":           "PointsPaint",
+		"PointsSecondChance":    "PointsSecondChance",
+		"Position":              "Position",
+		"PullTimestamp":         "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet":  "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"Starter":               "Starter",
+		"TeamID":                "TeamID",
+		"TeamName":              "TeamName",
+		"TeamNameFull":          "TeamNameFull",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model.BoxScoreScoring": {
+		"":                                 "BoxScoreScoring - composite key: event_id, player_id",
+		"EventID":                          "EventID is a string ID that maps to the matchup e.g. 0022500249",
+		"EventStatus":                      "EventStatus the numerical representation of the event status e.g. 3 (1=pregame, 2=in progress, 3=final)",
+		"EventStatusText":                  "EventStatusText (e.g. Final, Final/OT2, etc.)",
+		"EventTime":                        "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":                 "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"Minutes":                          "Minutes",
+		"OpponentID":                       "OpponentID",
+		"OpponentName":                     "OpponentName",
+		"OpponentNameFull":                 "OpponentNameFull",
+		"PercentageAssisted2pt":            "PercentageAssisted2pt",
+		"PercentageAssisted3pt":            "PercentageAssisted3pt",
+		"PercentageAssistedFGM":            "PercentageAssistedFGM",
+		"PercentageFieldGoalsAttempted2pt": "PercentageFieldGoalsAttempted2pt",
+		"PercentageFieldGoalsAttempted3pt": "PercentageFieldGoalsAttempted3pt",
+		"PercentagePoints2pt":              "PercentagePoints2pt",
+		"PercentagePoints3pt":              "PercentagePoints3pt",
+		"PercentagePointsFastBreak":        "PercentagePointsFastBreak",
+		"PercentagePointsFreeThrow":        "PercentagePointsFreeThrow",
+		"PercentagePointsMidrange2pt":      "PercentagePointsMidrange2pt",
+		"PercentagePointsOffTurnovers":     "PercentagePointsOffTurnovers",
+		"PercentagePointsPaint":            "PercentagePointsPaint",
+		"PercentageUnassisted2pt":          "PercentageUnassisted2pt",
+		"PercentageUnassisted3pt":          "PercentageUnassisted3pt",
+		"PercentageUnassistedFGM":          "PercentageUnassistedFGM",
+		"PlayerID":                         "PlayerID",
+		"PlayerName":                       "PlayerName",
+		"Position":                         "Position",
+		"PullTimestamp":                    "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet":             "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"Starter":                          "Starter",
+		"TeamID":                           "TeamID",
+		"TeamName":                         "TeamName",
+		"TeamNameFull":                     "TeamNameFull",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model.BoxScoreTracking": {
+		"":                                 "BoxScoreTracking - composite key: event_id, player_id",
+		"Assists":                          "Assists",
+		"ContestedFieldGoalPercentage":     "ContestedFieldGoalPercentage",
+		"ContestedFieldGoalsAttempted":     "ContestedFieldGoalsAttempted",
+		"ContestedFieldGoalsMade":          "ContestedFieldGoalsMade",
+		"DefendedAtRimFieldGoalPercentage": "DefendedAtRimFieldGoalPercentage",
+		"DefendedAtRimFieldGoalsAttempted": "DefendedAtRimFieldGoalsAttempted",
+		"DefendedAtRimFieldGoalsMade":      "DefendedAtRimFieldGoalsMade",
+		"Distance":                         "Distance",
+		"EventID":                          "EventID is a string ID that maps to the matchup e.g. 0022500249",
+		"EventStatus":                      "EventStatus the numerical representation of the event status e.g. 3 (1=pregame, 2=in progress, 3=final)",
+		"EventStatusText":                  "EventStatusText (e.g. Final, Final/OT2, etc.)",
+		"EventTime":                        "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":                 "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"FieldGoalPercentage":              "FieldGoalPercentage",
+		"FreeThrowAssists":                 "FreeThrowAssists",
+		"Minutes":                          "Minutes",
+		"OpponentID":                       "OpponentID",
+		"OpponentName":                     "OpponentName",
+		"OpponentNameFull":                 "OpponentNameFull",
+		"Passes":                           "Passes",
+		"PlayerID":                         "PlayerID",
+		"PlayerName":                       "PlayerName",
+		"Position":                         "Position",
+		"PullTimestamp":                    "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet":             "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"ReboundChancesDefensive":          "ReboundChancesDefensive",
+		"ReboundChancesOffensive":          "ReboundChancesOffensive",
+		"ReboundChancesTotal":              "ReboundChancesTotal",
+		"SecondaryAssists":                 "SecondaryAssists",
+		"Speed":                            "Speed",
+		"Starter":                          "Starter",
+		"TeamID":                           "TeamID",
+		"TeamName":                         "TeamName",
+		"TeamNameFull":                     "TeamNameFull",
+		"Touches":                          "Touches",
+		"UncontestedFieldGoalsAttempted":   "UncontestedFieldGoalsAttempted",
+		"UncontestedFieldGoalsMade":        "UncontestedFieldGoalsMade",
+		"UncontestedFieldGoalsPercentage":  "UncontestedFieldGoalsPercentage",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model.BoxScoreTraditional": {
+		"":                        "BoxScoreTraditional - composite key: event_id, player_id",
+		"Assists":                 "Assists",
+		"Blocks":                  "Blocks",
+		"EventID":                 "EventID is a string ID that maps to the matchup e.g. 0022500249",
+		"EventStatus":             "EventStatus the numerical representation of the event status e.g. 3 (1=pregame, 2=in progress, 3=final)",
+		"EventStatusText":         "EventStatusText (e.g. Final, Final/OT2, etc.)",
+		"EventTime":               "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":        "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"FieldGoalsAttempted":     "FieldGoalsAttempted",
+		"FieldGoalsMade":          "FieldGoalsMade",
+		"FieldGoalsPercentage":    "FieldGoalsPercentage",
+		"FoulsPersonal":           "FoulsPersonal",
+		"FreeThrowsAttempted":     "FreeThrowsAttempted",
+		"FreeThrowsMade":          "FreeThrowsMade",
+		"FreeThrowsPercentage":    "FreeThrowsPercentage",
+		"Minutes":                 "Minutes",
+		"OpponentID":              "OpponentID",
+		"OpponentName":            "OpponentName",
+		"OpponentNameFull":        "OpponentNameFull",
+		"PlayerID":                "PlayerID",
+		"PlayerName":              "PlayerName",
+		"PlusMinusPoints":         "PlusMinusPoints",
+		"Points":                  "Points",
+		"Position":                "Position",
+		"PullTimestamp":           "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet":    "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"ReboundsDefensive":       "ReboundsDefensive",
+		"ReboundsOffensive":       "ReboundsOffensive",
+		"ReboundsTotal":           "ReboundsTotal",
+		"Starter":                 "Starter",
+		"Steals":                  "Steals",
+		"TeamID":                  "TeamID",
+		"TeamName":                "TeamName",
+		"TeamNameFull":            "TeamNameFull",
+		"ThreePointersAttempted":  "ThreePointersAttempted",
+		"ThreePointersMade":       "ThreePointersMade",
+		"ThreePointersPercentage": "ThreePointersPercentage",
+		"Turnovers":               "Turnovers",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model.BoxScoreUsage": {
+		"":                                 "BoxScoreUsage - composite key: event_id, player_id",
+		"EventID":                          "EventID is a string ID that maps to the matchup e.g. 0022500249",
+		"EventStatus":                      "EventStatus the numerical representation of the event status e.g. 3 (1=pregame, 2=in progress, 3=final)",
+		"EventStatusText":                  "EventStatusText (e.g. Final, Final/OT2, etc.)",
+		"EventTime":                        "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":                 "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"Minutes":                          "Minutes",
+		"OpponentID":                       "OpponentID",
+		"OpponentName":                     "OpponentName",
+		"OpponentNameFull":                 "OpponentNameFull",
+		"PercentageAssists":                "PercentageAssists",
+		"PercentageBlocks":                 "PercentageBlocks",
+		"PercentageBlocksAllowed":          "PercentageBlocksAllowed",
+		"PercentageFieldGoalsAttempted":    "PercentageFieldGoalsAttempted",
+		"PercentageFieldGoalsMade":         "PercentageFieldGoalsMade",
+		"PercentageFreeThrowsAttempted":    "PercentageFreeThrowsAttempted",
+		"PercentageFreeThrowsMade":         "PercentageFreeThrowsMade",
+		"PercentagePersonalFouls":          "PercentagePersonalFouls",
+		"PercentagePersonalFoulsDrawn":     "PercentagePersonalFoulsDrawn",
+		"PercentagePoints":                 "PercentagePoints",
+		"PercentageReboundsDefensive":      "PercentageReboundsDefensive",
+		"PercentageReboundsOffensive":      "PercentageReboundsOffensive",
+		"PercentageReboundsTotal":          "PercentageReboundsTotal",
+		"PercentageSteals":                 "PercentageSteals",
+		"PercentageThreePointersAttempted": "PercentageThreePointersAttempted",
+		"PercentageThreePointersMade":      "PercentageThreePointersMade",
+		"PercentageTurnovers":              "PercentageTurnovers",
+		"PlayerID":                         "PlayerID",
+		"PlayerName":                       "PlayerName",
+		"Position":                         "Position",
+		"PullTimestamp":                    "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet":             "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"Starter":                          "Starter",
+		"TeamID":                           "TeamID",
+		"TeamName":                         "TeamName",
+		"TeamNameFull":                     "TeamNameFull",
+		"UsagePercentage":                  "UsagePercentage",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model.Matchup": {
+		"":                     "Matchup",
+		"AwayTeam":             "AwayTeam",
+		"AwayTeamAbbreviation": "AwayTeamAbbreviation",
+		"AwayTeamID":           "AwayTeamID",
+		"AwayTeamLosses":       "AwayTeamLosses",
+		"AwayTeamScore":        "AwayTeamScore - total away team game score",
+		"AwayTeamWins":         "AwayTeamWins",
+		"EventID":              "EventID is a unique ID that maps to the matchup e.g. 0022500249",
+		"EventStatus":          "EventStatus the numerical representation of the event status e.g. 3 (1=pregame, 2=in progress, 3=final)",
+		"EventStatusText":      "EventStatusText (e.g. Final, Final/OT2, etc.)",
+		"EventTime":            "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":     "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"HomeTeam":             "HomeTeam",
+		"HomeTeamAbbreviation": "HomeTeamAbbreviation",
+		"HomeTeamID":           "HomeTeamID",
+		"HomeTeamLosses":       "HomeTeamLosses",
+		"HomeTeamScore":        "HomeTeamScore - total home team game score",
+		"HomeTeamWins":         "HomeTeamWins",
+		"LeagueID":             "LeagueID",
+		"PullTimestamp":        "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet": "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"SeasonType":           "SeasonType (e.g. \"Regular Season\")",
+		"SeasonYear":           "SeasonYear (e.g 2025-26)",
+		"ShareURL":             "ShareURL (e.g. https://www.nba.com/game/gsw-vs-orl-0022500249)",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model.MatchupPeriods": {
+		"":                     "MatchupPeriods - scores per period per game. Composite key: event_id, period",
+		"AwayTeam":             "AwayTeam",
+		"AwayTeamAbbreviation": "AwayTeamAbbreviation",
+		"AwayTeamID":           "AwayTeamID",
+		"AwayTeamScore":        "AwayTeamScore with respect to period and away team",
+		"EventID":              "EventID is a string ID that maps to the matchup e.g. 0022500249",
+		"EventStatus":          "EventStatus the numerical representation of the event status e.g. 3 (1=pregame, 2=in progress, 3=final)",
+		"EventStatusText":      "EventStatusText (e.g. Final, Final/OT2, etc.)",
+		"EventTime":            "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":     "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"HomeTeam":             "HomeTeam",
+		"HomeTeamAbbreviation": "HomeTeamAbbreviation",
+		"HomeTeamID":           "HomeTeamID",
+		"HomeTeamScore":        "HomeTeamScore with respect to period and home team",
+		"LeagueID":             "LeagueID",
+		"Period":               "Period is the quarter and/or overtime number, 1-4 represents quarter number and >4 represents Overtime",
+		"PullTimestamp":        "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet": "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"SeasonType":           "SeasonType (e.g. \"Regular Season\")",
+		"SeasonYear":           "SeasonYear (e.g 2025-26)",
+	},
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model.PlayByPlay": {
+		"":                     "PlayByPlay - composite key: event_id, action_id",
+		"ActionID":             "ActionID",
+		"ActionNumber":         "ActionNumber",
+		"ActionType":           "ActionType",
+		"Clock":                "Clock",
+		"Description":          "Description",
+		"EventID":              "EventID is a string ID that maps to the matchup e.g. 0022500249",
+		"EventStatus":          "EventStatus the numerical representation of the event status e.g. 3 (1=pregame, 2=in progress, 3=final)",
+		"EventStatusText":      "EventStatusText (e.g. Final, Final/OT2, etc.)",
+		"EventTime":            "EventTime is the timestamp associated with the matchup",
+		"EventTimeParquet":     "EventTimeParquet is the timestamp associated with the matchup (in milliseconds)",
+		"IsFieldGoal":          "IsFieldGoal",
+		"Location":             "Location",
+		"Period":               "Period",
+		"PersonID":             "PersonID",
+		"PlayerName":           "PlayerName",
+		"PlayerNameInitial":    "PlayerNameInitial",
+		"PointsTotal":          "PointsTotal",
+		"PullTimestamp":        "PullTimestamp is the fetch timestamp for when the request was made to the API",
+		"PullTimestampParquet": "PullTimestampParquet is the fetch timestamp (in milliseconds)",
+		"ScoreAway":            "ScoreAway",
+		"ScoreHome":            "ScoreHome",
+		"ShotDistance":         "ShotDistance",
+		"ShotResult":           "ShotResult",
+		"ShotValue":            "ShotValue",
+		"SubType":              "SubType",
+		"TeamAbbreviation":     "TeamAbbreviation",
+		"TeamID":               "TeamID",
+	},
+}
@@ -0,0 +1,6 @@
+// Package schema derives the output schema of the sportscrape models from their
+// json and parquet struct tags and doc comments, and renders it as JSON Schema,
+// a Parquet message or an Avro record schema.
+package schema
+
+//go:generate go run ./internal/gendoc
@@ -0,0 +1,137 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/lightning-dabbler/sportscrape"
+	baseballreferencemlb "github.com/lightning-dabbler/sportscrape/dataprovider/baseballreferencemlb/model"
+	baseballsavantmlb "github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+	basketballreferencenba "github.com/lightning-dabbler/sportscrape/dataprovider/basketballreferencenba/model"
+	espnmma "github.com/lightning-dabbler/sportscrape/dataprovider/espn/mma/model"
+	foxsports "github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model"
+	nba "github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+)
+
+// ErrUnknownFeed is returned by ForFeed for feeds without a registered model.
+var ErrUnknownFeed = errors.New("unknown feed")
+
+// models maps every feed to the model of its records.
+var models = map[sportscrape.Feed]reflect.Type{
+	// nba.com
+	sportscrape.NBAMatchup:               reflect.TypeFor[nba.Matchup](),
+	sportscrape.NBAMatchupPeriods:        reflect.TypeFor[nba.MatchupPeriods](),
+	sportscrape.NBALiveBoxScore:          reflect.TypeFor[nba.BoxScoreLive](),
+	sportscrape.NBAAdvancedBoxScore:      reflect.TypeFor[nba.BoxScoreAdvanced](),
+	sportscrape.NBAAdvancedBoxScoreQ1:    reflect.TypeFor[nba.BoxScoreAdvanced](),
+	sportscrape.NBAAdvancedBoxScoreQ2:    reflect.TypeFor[nba.BoxScoreAdvanced](),
+	sportscrape.NBAAdvancedBoxScoreQ3:    reflect.TypeFor[nba.BoxScoreAdvanced](),
+	sportscrape.NBAAdvancedBoxScoreQ4:    reflect.TypeFor[nba.BoxScoreAdvanced](),
+	sportscrape.NBAAdvancedBoxScoreH1:    reflect.TypeFor[nba.BoxScoreAdvanced](),
+	sportscrape.NBAAdvancedBoxScoreH2:    reflect.TypeFor[nba.BoxScoreAdvanced](),
+	sportscrape.NBAAdvancedBoxScoreOT:    reflect.TypeFor[nba.BoxScoreAdvanced](),
+	sportscrape.NBATraditionalBoxScore:   reflect.TypeFor[nba.BoxScoreTraditional](),
+	sportscrape.NBATraditionalBoxScoreQ1: reflect.TypeFor[nba.BoxScoreTraditional](),
+	sportscrape.NBATraditionalBoxScoreQ2: reflect.TypeFor[nba.BoxScoreTraditional](),
+	sportscrape.NBATraditionalBoxScoreQ3: reflect.TypeFor[nba.BoxScoreTraditional](),
+	sportscrape.NBATraditionalBoxScoreQ4: reflect.TypeFor[nba.BoxScoreTraditional](),
+	sportscrape.NBATraditionalBoxScoreH1: reflect.TypeFor[nba.BoxScoreTraditional](),
+	sportscrape.NBATraditionalBoxScoreH2: reflect.TypeFor[nba.BoxScoreTraditional](),
+	sportscrape.NBATraditionalBoxScoreOT: reflect.TypeFor[nba.BoxScoreTraditional](),
+	sportscrape.NBAScoringBoxScore:       reflect.TypeFor[nba.BoxScoreScoring](),
+	sportscrape.NBAScoringBoxScoreQ1:     reflect.TypeFor[nba.BoxScoreScoring](),
+	sportscrape.NBAScoringBoxScoreQ2:     reflect.TypeFor[nba.BoxScoreScoring](),
+	sportscrape.NBAScoringBoxScoreQ3:     reflect.TypeFor[nba.BoxScoreScoring](),
+	sportscrape.NBAScoringBoxScoreQ4:     reflect.TypeFor[nba.BoxScoreScoring](),
+	sportscrape.NBAScoringBoxScoreH1:     reflect.TypeFor[nba.BoxScoreScoring](),
+	sportscrape.NBAScoringBoxScoreH2:     reflect.TypeFor[nba.BoxScoreScoring](),
+	sportscrape.NBAScoringBoxScoreOT:     reflect.TypeFor[nba.BoxScoreScoring](),
+	sportscrape.NBAUsageBoxScore:         reflect.TypeFor[nba.BoxScoreUsage](),
+	sportscrape.NBAUsageBoxScoreQ1:       reflect.TypeFor[nba.BoxScoreUsage](),
+	sportscrape.NBAUsageBoxScoreQ2:       reflect.TypeFor[nba.BoxScoreUsage](),
+	sportscrape.NBAUsageBoxScoreQ3:       reflect.TypeFor[nba.BoxScoreUsage](),
+	sportscrape.NBAUsageBoxScoreQ4:       reflect.TypeFor[nba.BoxScoreUsage](),
+	sportscrape.NBAUsageBoxScoreH1:       reflect.TypeFor[nba.BoxScoreUsage](),
+	sportscrape.NBAUsageBoxScoreH2:       reflect.TypeFor[nba.BoxScoreUsage](),
+	sportscrape.NBAUsageBoxScoreOT:       reflect.TypeFor[nba.BoxScoreUsage](),
+	sportscrape.NBAMiscBoxScore:          reflect.TypeFor[nba.BoxScoreMisc](),
+	sportscrape.NBAMiscBoxScoreQ1:        reflect.TypeFor[nba.BoxScoreMisc](),
+	sportscrape.NBAMiscBoxScoreQ2:        reflect.TypeFor[nba.BoxScoreMisc](),
+	sportscrape.NBAMiscBoxScoreQ3:        reflect.TypeFor[nba.BoxScoreMisc](),
+	sportscrape.NBAMiscBoxScoreQ4:        reflect.TypeFor[nba.BoxScoreMisc](),
+	sportscrape.NBAMiscBoxScoreH1:        reflect.TypeFor[nba.BoxScoreMisc](),
+	sportscrape.NBAMiscBoxScoreH2:        reflect.TypeFor[nba.BoxScoreMisc](),
+	sportscrape.NBAMiscBoxScoreOT:        reflect.TypeFor[nba.BoxScoreMisc](),
+	sportscrape.NBAFourFactorsBoxScore:   reflect.TypeFor[nba.BoxScoreFourFactors](),
+	sportscrape.NBAFourFactorsBoxScoreQ1: reflect.TypeFor[nba.BoxScoreFourFactors](),
+	sportscrape.NBAFourFactorsBoxScoreQ2: reflect.TypeFor[nba.BoxScoreFourFactors](),
+	sportscrape.NBAFourFactorsBoxScoreQ3: reflect.TypeFor[nba.BoxScoreFourFactors](),
+	sportscrape.NBAFourFactorsBoxScoreQ4: reflect.TypeFor[nba.BoxScoreFourFactors](),
+	sportscrape.NBAFourFactorsBoxScoreH1: reflect.TypeFor[nba.BoxScoreFourFactors](),
+	sportscrape.NBAFourFactorsBoxScoreH2: reflect.TypeFor[nba.BoxScoreFourFactors](),
+	sportscrape.NBAFourFactorsBoxScoreOT: reflect.TypeFor[nba.BoxScoreFourFactors](),
+	sportscrape.NBAHustleBoxScore:        reflect.TypeFor[nba.BoxScoreHustle](),
+	sportscrape.NBAMatchupsBoxScore:      reflect.TypeFor[nba.BoxScoreMatchups](),
+	sportscrape.NBADefenseBoxScore:       reflect.TypeFor[nba.BoxScoreDefense](),
+	sportscrape.NBATrackingBoxScore:      reflect.TypeFor[nba.BoxScoreTracking](),
+	sportscrape.NBAPlayByPlay:            reflect.TypeFor[nba.PlayByPlay](),
+	// fox sports
+	sportscrape.FSNBAMatchup:                 reflect.TypeFor[foxsports.Matchup](),
+	sportscrape.FSWNBAMatchup:                reflect.TypeFor[foxsports.Matchup](),
+	sportscrape.FSMLBMatchup:                 reflect.TypeFor[foxsports.Matchup](),
+	sportscrape.FSNFLMatchup:                 reflect.TypeFor[foxsports.Matchup](),
+	sportscrape.FSNCAABMatchup:               reflect.TypeFor[foxsports.Matchup](),
+	sportscrape.FSNBABoxScore:                reflect.TypeFor[foxsports.NBABoxScoreStats](),
+	sportscrape.FSWNBABoxScore:               reflect.TypeFor[foxsports.NBABoxScoreStats](),
+	sportscrape.FSMLBBattingBoxScore:         reflect.TypeFor[foxsports.MLBBattingBoxScoreStats](),
+	sportscrape.FSMLBPitchingBoxScore:        reflect.TypeFor[foxsports.MLBPitchingBoxScoreStats](),
+	sportscrape.FSMLBProbableStartingPitcher: reflect.TypeFor[foxsports.MLBProbableStartingPitcher](),
+	sportscrape.FSMLBOddsTotal:               reflect.TypeFor[foxsports.MLBOddsTotal](),
+	sportscrape.FSMLBOddsMoneyLine:           reflect.TypeFor[foxsports.MLBOddsMoneyLine](),
+	// baseball savant
+	sportscrape.BaseballSavantMLBMatchup:          reflect.TypeFor[baseballsavantmlb.Matchup](),
+	sportscrape.BaseballSavantMLBPitchingBoxScore: reflect.TypeFor[baseballsavantmlb.PitchingBoxScore](),
+	sportscrape.BaseballSavantMLBBattingBoxScore:  reflect.TypeFor[baseballsavantmlb.BattingBoxScore](),
+	sportscrape.BaseballSavantMLBFieldingBoxScore: reflect.TypeFor[baseballsavantmlb.FieldingBoxScore](),
+	sportscrape.BaseballSavantMLBPlayByPlay:       reflect.TypeFor[baseballsavantmlb.PlayByPlay](),
+	// espn mma
+	sportscrape.ESPNUFCMatchups:     reflect.TypeFor[espnmma.Matchup](),
+	sportscrape.ESPNUFCFightDetails: reflect.TypeFor[espnmma.FightDetails](),
+	sportscrape.ESPNPFLMatchups:     reflect.TypeFor[espnmma.Matchup](),
+	sportscrape.ESPNPFLFightDetails: reflect.TypeFor[espnmma.FightDetails](),
+	// baseball reference (deprecated)
+	sportscrape.BaseballReferenceMLBMatchup:          reflect.TypeFor[baseballreferencemlb.MLBMatchup](),
+	sportscrape.BaseballReferenceMLBBattingBoxScore:  reflect.TypeFor[baseballreferencemlb.MLBBattingBoxScoreStats](),
+	sportscrape.BaseballReferenceMLBPitchingBoxScore: reflect.TypeFor[baseballreferencemlb.MLBPitchingBoxScoreStats](),
+	// basketball reference (deprecated)
+	sportscrape.BasketballReferenceNBAMatchup:     reflect.TypeFor[basketballreferencenba.NBAMatchup](),
+	sportscrape.BasketballReferenceNBABoxScore:    reflect.TypeFor[basketballreferencenba.NBABasicBoxScoreStats](),
+	sportscrape.BasketballReferenceNBABoxScoreQ1:  reflect.TypeFor[basketballreferencenba.NBABasicBoxScoreStats](),
+	sportscrape.BasketballReferenceNBABoxScoreQ2:  reflect.TypeFor[basketballreferencenba.NBABasicBoxScoreStats](),
+	sportscrape.BasketballReferenceNBABoxScoreQ3:  reflect.TypeFor[basketballreferencenba.NBABasicBoxScoreStats](),
+	sportscrape.BasketballReferenceNBABoxScoreQ4:  reflect.TypeFor[basketballreferencenba.NBABasicBoxScoreStats](),
+	sportscrape.BasketballReferenceNBABoxScoreH1:  reflect.TypeFor[basketballreferencenba.NBABasicBoxScoreStats](),
+	sportscrape.BasketballReferenceNBABoxScoreH2:  reflect.TypeFor[basketballreferencenba.NBABasicBoxScoreStats](),
+	sportscrape.BasketballReferenceNBAAdvBoxScore: reflect.TypeFor[basketballreferencenba.NBAAdvBoxScoreStats](),
+}
+
+// ForFeed returns the schema of the records of feed.
+func ForFeed(feed sportscrape.Feed) (Schema, error) {
+	t, ok := models[feed]
+	if !ok {
+		return Schema{}, fmt.Errorf("%w: %q", ErrUnknownFeed, feed)
+	}
+	return FromType(t)
+}
+
+// Feeds lists the feeds ForFeed knows, sorted.
+func Feeds() []sportscrape.Feed {
+	feeds := make([]sportscrape.Feed, 0, len(models))
+	for feed := range models {
+		feeds = append(feeds, feed)
+	}
+	slices.Sort(feeds)
+	return feeds
+}
@@ -0,0 +1,120 @@
+// Command gendoc writes the doc comments of the dataprovider model structs and
+// their fields to descriptions_gen.go, as reflection cannot read comments.
+//
+// Run through go generate from the schema package directory.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const module = "github.com/lightning-dabbler/sportscrape"
+
+func main() {
+	// type key (import path + "." + type name) -> field name ("" for the type itself) -> comment
+	descriptions := map[string]map[string]string{}
+	err := filepath.WalkDir("../dataprovider", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() || d.Name() != "model" {
+			return err
+		}
+		importPath := module + "/" + filepath.ToSlash(strings.TrimPrefix(path, "../"))
+		return parseModels(path, importPath, descriptions)
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gendoc; DO NOT EDIT.\n\npackage schema\n\n")
+	buf.WriteString("// descriptions holds the doc comments of the model structs (\"\" key) and their fields, by import path and type name.\n")
+	buf.WriteString("var descriptions = map[string]map[string]string{\n")
+	for _, typ := range sortedKeys(descriptions) {
+		fmt.Fprintf(&buf, "%q: {\n", typ)
+		for _, field := range sortedKeys(descriptions[typ]) {
+			fmt.Fprintf(&buf, "%q: %q,\n", field, descriptions[typ][field])
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("descriptions_gen.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseModels adds the comments of the structs declared in dir to descriptions.
+func parseModels(dir, importPath string, descriptions map[string]map[string]string) error {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+					structType, ok := typeSpec.Type.(*ast.StructType)
+					if !ok {
+						continue
+					}
+					comments := map[string]string{}
+					doc := typeSpec.Doc
+					if doc == nil {
+						doc = gen.Doc
+					}
+					if text := comment(doc); text != "" {
+						comments[""] = text
+					}
+					for _, field := range structType.Fields.List {
+						text := comment(field.Doc)
+						if text == "" {
+							text = comment(field.Comment)
+						}
+						for _, name := range field.Names {
+							if text != "" {
+								comments[name.Name] = text
+							}
+						}
+					}
+					descriptions[importPath+"."+typeSpec.Name.Name] = comments
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// comment returns the text of group on a single line.
+func comment(group *ast.CommentGroup) string {
+	return strings.Join(strings.Fields(group.Text()), " ")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
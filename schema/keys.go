@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"reflect"
+
+	baseballreferencemlb "github.com/lightning-dabbler/sportscrape/dataprovider/baseballreferencemlb/model"
+	baseballsavantmlb "github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+	basketballreferencenba "github.com/lightning-dabbler/sportscrape/dataprovider/basketballreferencenba/model"
+	espnmma "github.com/lightning-dabbler/sportscrape/dataprovider/espn/mma/model"
+	foxsports "github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model"
+	nba "github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+)
+
+// keys maps every model to the columns of its natural key: the event and,
+// for records of several entities per event, the player, play or period.
+var keys = map[reflect.Type][]string{
+	// nba.com
+	reflect.TypeFor[nba.Matchup]():             {"event_id"},
+	reflect.TypeFor[nba.MatchupPeriods]():      {"event_id", "period"},
+	reflect.TypeFor[nba.BoxScoreLive]():        {"event_id", "player_id"},
+	reflect.TypeFor[nba.BoxScoreAdvanced]():    {"event_id", "player_id"},
+	reflect.TypeFor[nba.BoxScoreTraditional](): {"event_id", "player_id"},
+	reflect.TypeFor[nba.BoxScoreScoring]():     {"event_id", "player_id"},
+	reflect.TypeFor[nba.BoxScoreUsage]():       {"event_id", "player_id"},
+	reflect.TypeFor[nba.BoxScoreMisc]():        {"event_id", "player_id"},
+	reflect.TypeFor[nba.BoxScoreFourFactors](): {"event_id", "player_id"},
+	reflect.TypeFor[nba.BoxScoreHustle]():      {"event_id", "player_id"},
+	reflect.TypeFor[nba.BoxScoreMatchups]():    {"event_id", "player_id", "opponent_player_id"},
+	reflect.TypeFor[nba.BoxScoreDefense]():     {"event_id", "player_id"},
+	reflect.TypeFor[nba.BoxScoreTracking]():    {"event_id", "player_id"},
+	reflect.TypeFor[nba.PlayByPlay]():          {"event_id", "action_id"},
+	// fox sports
+	reflect.TypeFor[foxsports.Matchup]():                    {"event_id"},
+	reflect.TypeFor[foxsports.NBABoxScoreStats]():           {"event_id", "player_id"},
+	reflect.TypeFor[foxsports.MLBBattingBoxScoreStats]():    {"event_id", "player_id"},
+	reflect.TypeFor[foxsports.MLBPitchingBoxScoreStats]():   {"event_id", "player_id"},
+	reflect.TypeFor[foxsports.MLBProbableStartingPitcher](): {"event_id", "team_id"},
+	reflect.TypeFor[foxsports.MLBOddsTotal]():               {"event_id"},
+	reflect.TypeFor[foxsports.MLBOddsMoneyLine]():           {"event_id"},
+	// baseball savant
+	reflect.TypeFor[baseballsavantmlb.Matchup]():          {"event_id"},
+	reflect.TypeFor[baseballsavantmlb.PitchingBoxScore](): {"event_id", "player_id"},
+	reflect.TypeFor[baseballsavantmlb.BattingBoxScore]():  {"event_id", "player_id"},
+	reflect.TypeFor[baseballsavantmlb.FieldingBoxScore](): {"event_id", "player_id"},
+	reflect.TypeFor[baseballsavantmlb.PlayByPlay]():       {"event_id", "play_id"},
+	// espn mma
+	reflect.TypeFor[espnmma.Matchup]():      {"event_id"},
+	reflect.TypeFor[espnmma.FightDetails](): {"event_id", "id"},
+	// baseball reference (deprecated)
+	reflect.TypeFor[baseballreferencemlb.MLBMatchup]():               {"event_id"},
+	reflect.TypeFor[baseballreferencemlb.MLBBattingBoxScoreStats]():  {"event_id", "player_id"},
+	reflect.TypeFor[baseballreferencemlb.MLBPitchingBoxScoreStats](): {"event_id", "player_id"},
+	// basketball reference (deprecated)
+	reflect.TypeFor[basketballreferencenba.NBAMatchup]():            {"event_id"},
+	reflect.TypeFor[basketballreferencenba.NBABasicBoxScoreStats](): {"event_id", "player_id"},
+	reflect.TypeFor[basketballreferencenba.NBAAdvBoxScoreStats]():   {"event_id", "player_id"},
+}
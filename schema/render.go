@@ -0,0 +1,169 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// JSONSchema renders s as a JSON Schema (draft 2020-12) of a JSON lines record.
+// Timestamps and dates are date-time strings, as time.Time marshals to RFC 3339.
+func (s Schema) JSONSchema() ([]byte, error) {
+	type property struct {
+		Type        any    `json:"type"`
+		Format      string `json:"format,omitempty"`
+		Description string `json:"description,omitempty"`
+	}
+	properties := orderedObject{}
+	required := []string{}
+	for _, column := range s.Columns {
+		if column.JSONName == "" {
+			continue
+		}
+		var p property
+		switch column.Type {
+		case String:
+			p.Type = "string"
+		case Boolean:
+			p.Type = "boolean"
+		case Int32, Int64:
+			p.Type = "integer"
+			p.Format = string(column.Type)
+		case Float, Double:
+			p.Type = "number"
+		case Timestamp, Date:
+			p.Type = "string"
+			p.Format = "date-time"
+		}
+		if column.Nullable {
+			p.Type = []string{p.Type.(string), "null"}
+		}
+		p.Description = column.Description
+		properties = append(properties, member{column.JSONName, p})
+		required = append(required, column.JSONName)
+	}
+	document := orderedObject{
+		{"$schema", "https://json-schema.org/draft/2020-12/schema"},
+		{"$id", s.Namespace + "." + s.Name},
+		{"title", s.Name},
+	}
+	if s.Description != "" {
+		document = append(document, member{"description", s.Description})
+	}
+	document = append(document,
+		member{"type", "object"},
+		member{"properties", properties},
+		member{"required", required},
+	)
+	return json.MarshalIndent(document, "", "  ")
+}
+
+// Parquet renders s as the Parquet message type written by the parquet exporter.
+func (s Schema) Parquet() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "message %s {\n", s.Name)
+	for _, column := range s.Columns {
+		repetition := "required"
+		if column.Nullable {
+			repetition = "optional"
+		}
+		var physical, annotation string
+		switch column.Type {
+		case String:
+			physical, annotation = "binary", " (STRING)"
+		case Boolean:
+			physical = "boolean"
+		case Int32:
+			physical = "int32"
+		case Int64:
+			physical = "int64"
+		case Float:
+			physical = "float"
+		case Double:
+			physical = "double"
+		case Timestamp:
+			physical, annotation = "int64", " (TIMESTAMP(MILLIS,true))"
+		case Date:
+			physical, annotation = "int32", " (DATE)"
+		}
+		fmt.Fprintf(&b, "  %s %s %s%s;\n", repetition, physical, column.Name, annotation)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Avro renders s as an Avro record schema. Nullable columns are unions with
+// null defaulting to null.
+func (s Schema) Avro() ([]byte, error) {
+	fields := []orderedObject{}
+	for _, column := range s.Columns {
+		var typ any
+		switch column.Type {
+		case String:
+			typ = "string"
+		case Boolean:
+			typ = "boolean"
+		case Int32:
+			typ = "int"
+		case Int64:
+			typ = "long"
+		case Float:
+			typ = "float"
+		case Double:
+			typ = "double"
+		case Timestamp:
+			typ = orderedObject{{"type", "long"}, {"logicalType", "timestamp-millis"}}
+		case Date:
+			typ = orderedObject{{"type", "int"}, {"logicalType", "date"}}
+		}
+		field := orderedObject{{"name", column.Name}}
+		if column.Nullable {
+			field = append(field, member{"type", []any{"null", typ}}, member{"default", nil})
+		} else {
+			field = append(field, member{"type", typ})
+		}
+		if column.Description != "" {
+			field = append(field, member{"doc", column.Description})
+		}
+		fields = append(fields, field)
+	}
+	record := orderedObject{
+		{"type", "record"},
+		{"name", s.Name},
+		{"namespace", s.Namespace},
+	}
+	if s.Description != "" {
+		record = append(record, member{"doc", s.Description})
+	}
+	record = append(record, member{"fields", fields})
+	return json.MarshalIndent(record, "", "  ")
+}
+
+// member is a key of an orderedObject.
+type member struct {
+	key   string
+	value any
+}
+
+// orderedObject is a JSON object keeping its keys in order, so rendered
+// schemas list columns as the model declares them.
+type orderedObject []member
+
+func (o orderedObject) MarshalJSON() ([]byte, error) {
+	b := []byte{'{'}
+	for i, m := range o {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		key, err := json.Marshal(m.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		b = append(append(append(b, key...), ':'), value...)
+	}
+	return append(b, '}'), nil
+}
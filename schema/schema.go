@@ -0,0 +1,215 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNotStruct is returned for models that are not structs.
+	ErrNotStruct = errors.New("model is not a struct")
+	// ErrUnsupportedType is returned for fields whose Go or parquet type has no column type.
+	ErrUnsupportedType = errors.New("unsupported field type")
+)
+
+// Type is the logical type of a column.
+type Type string
+
+const (
+	String  Type = "string"
+	Boolean Type = "boolean"
+	Int32   Type = "int32"
+	Int64   Type = "int64"
+	Float   Type = "float"
+	Double  Type = "double"
+	// Timestamp is a UTC timestamp with millisecond precision
+	Timestamp Type = "timestamp"
+	// Date is a calendar day
+	Date Type = "date"
+)
+
+// Column is a column of a model's output.
+type Column struct {
+	// Name is the column name in parquet and Avro
+	Name string
+	// JSONName is the key of the column in JSON lines output, empty if it is not exported as JSON
+	JSONName string
+	// Field is the Go field the column is read from
+	Field string
+	Type  Type
+	// Nullable columns come from pointer fields
+	Nullable    bool
+	Description string
+}
+
+// Schema is the output schema of a model.
+type Schema struct {
+	// Name is the model type name, e.g. PlayByPlay
+	Name string
+	// Namespace identifies the provider of the model, e.g. sportscrape.nba
+	Namespace   string
+	Description string
+	Columns     []Column
+	// Key lists the columns of the natural key identifying a record, e.g.
+	// event_id and player_id for box scores; empty for unregistered models
+	Key []string
+}
+
+// Of returns the schema of the model E.
+func Of[E any]() (Schema, error) {
+	return FromType(reflect.TypeFor[E]())
+}
+
+// FromType returns the schema of the model struct t. A time.Time field
+// exported as JSON and its "<Field>Parquet" twin holding the parquet
+// timestamp or date become a single column; pointer fields are nullable.
+func FromType(t reflect.Type) (Schema, error) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return Schema{}, fmt.Errorf("%w: %s", ErrNotStruct, t)
+	}
+	comments := descriptions[t.PkgPath()+"."+t.Name()]
+	s := Schema{
+		Name:        t.Name(),
+		Namespace:   namespace(t.PkgPath()),
+		Description: comments[""],
+		Key:         slices.Clone(keys[t]),
+	}
+	twins := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || twins[field.Name] {
+			continue
+		}
+		jsonName := jsonName(field)
+		parquetTag := parseParquetTag(field.Tag.Get("parquet"))
+		if jsonName == "" && parquetTag == nil {
+			continue
+		}
+		column := Column{
+			Name:        parquetTag["name"],
+			JSONName:    jsonName,
+			Field:       field.Name,
+			Nullable:    field.Type.Kind() == reflect.Pointer,
+			Description: comments[field.Name],
+		}
+		if twin, ok := t.FieldByName(field.Name + "Parquet"); ok && parquetTag == nil {
+			// The JSON field of a parquet twin, e.g. PullTimestamp and PullTimestampParquet
+			twins[twin.Name] = true
+			parquetTag = parseParquetTag(twin.Tag.Get("parquet"))
+			column.Name = parquetTag["name"]
+			if column.Description == "" {
+				column.Description = comments[twin.Name]
+			}
+		}
+		if column.Name == "" {
+			column.Name = jsonName
+		}
+		var err error
+		column.Type, err = columnType(field.Type, parquetTag)
+		if err != nil {
+			return Schema{}, fmt.Errorf("%s.%s: %w", t.Name(), field.Name, err)
+		}
+		s.Columns = append(s.Columns, column)
+	}
+	return s, nil
+}
+
+// jsonName returns the JSON key of field, empty when it is not marshalled.
+func jsonName(field reflect.StructField) string {
+	tag, ok := field.Tag.Lookup("json")
+	if !ok {
+		return field.Name
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	default:
+		return name
+	}
+}
+
+// parseParquetTag returns the key=value pairs of a parquet struct tag with
+// lower-cased keys, nil for an empty tag.
+func parseParquetTag(tag string) map[string]string {
+	if tag == "" {
+		return nil
+	}
+	pairs := map[string]string{}
+	for _, pair := range strings.Split(tag, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(pair), "=")
+		pairs[strings.ToLower(key)] = value
+	}
+	return pairs
+}
+
+// columnType returns the column type of a field from its parquet tag, or from
+// its Go type when it has none.
+func columnType(t reflect.Type, parquetTag map[string]string) (Type, error) {
+	if parquetTag != nil {
+		switch parquetTag["type"] {
+		case "BYTE_ARRAY":
+			return String, nil
+		case "BOOLEAN":
+			return Boolean, nil
+		case "INT32":
+			if parquetTag["convertedtype"] == "DATE" || parquetTag["logicaltype"] == "DATE" {
+				return Date, nil
+			}
+			return Int32, nil
+		case "INT64":
+			if parquetTag["convertedtype"] == "TIMESTAMP_MILLIS" || parquetTag["logicaltype"] == "TIMESTAMP" {
+				return Timestamp, nil
+			}
+			return Int64, nil
+		case "FLOAT":
+			return Float, nil
+		case "DOUBLE":
+			return Double, nil
+		default:
+			return "", fmt.Errorf("%w: parquet type %q", ErrUnsupportedType, parquetTag["type"])
+		}
+	}
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == reflect.TypeFor[time.Time]() {
+		return Timestamp, nil
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return String, nil
+	case reflect.Bool:
+		return Boolean, nil
+	case reflect.Int32:
+		return Int32, nil
+	case reflect.Int, reflect.Int64:
+		return Int64, nil
+	case reflect.Float32:
+		return Float, nil
+	case reflect.Float64:
+		return Double, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedType, t)
+	}
+}
+
+// namespace derives the namespace of a model package from its import path,
+// e.g. .../dataprovider/espn/mma/model becomes sportscrape.espn.mma.
+func namespace(pkgPath string) string {
+	_, provider, found := strings.Cut(pkgPath, "/dataprovider/")
+	if !found {
+		return "sportscrape"
+	}
+	provider = strings.TrimSuffix(provider, "/model")
+	return "sportscrape." + strings.ReplaceAll(provider, "/", ".")
+}
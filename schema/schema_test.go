@@ -0,0 +1,163 @@
+//go:build unit
+
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape"
+	baseballsavantmlb "github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+	nba "github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go/parquet"
+	parquetschema "github.com/xitongsys/parquet-go/schema"
+)
+
+func TestOf(t *testing.T) {
+	s, err := Of[nba.PlayByPlay]()
+	require.NoError(t, err)
+	assert.Equal(t, "PlayByPlay", s.Name)
+	assert.Equal(t, "sportscrape.nba", s.Namespace)
+	assert.Equal(t, "PlayByPlay - composite key: event_id, action_id", s.Description)
+	assert.Equal(t, []string{"event_id", "action_id"}, s.Key)
+	assert.Equal(t, Column{
+		Name:        "pull_timestamp",
+		JSONName:    "pull_timestamp",
+		Field:       "PullTimestamp",
+		Type:        Timestamp,
+		Description: "PullTimestamp is the fetch timestamp for when the request was made to the API",
+	}, s.Columns[0], "time.Time and parquet twin merged")
+	assert.Equal(t, "event_id", s.Columns[1].Name)
+	for _, column := range s.Columns {
+		assert.NotContains(t, column.Field, "Parquet")
+	}
+
+	s, err = Of[baseballsavantmlb.PlayByPlay]()
+	require.NoError(t, err)
+	var hitDistance Column
+	for _, column := range s.Columns {
+		if column.Name == "hit_distance" {
+			hitDistance = column
+		}
+	}
+	assert.Equal(t, Int32, hitDistance.Type)
+	assert.True(t, hitDistance.Nullable)
+
+	_, err = Of[string]()
+	assert.ErrorIs(t, err, ErrNotStruct)
+	_, err = Of[struct{ Values []int }]()
+	assert.ErrorIs(t, err, ErrUnsupportedType)
+}
+
+func TestFromTypeWithoutTags(t *testing.T) {
+	type record struct {
+		ID      int64
+		Name    string `json:"name,omitempty"`
+		Ratio   *float64
+		Updated time.Time
+		hidden  bool
+	}
+	s, err := Of[record]()
+	require.NoError(t, err)
+	assert.Equal(t, []Column{
+		{Name: "ID", JSONName: "ID", Field: "ID", Type: Int64},
+		{Name: "name", JSONName: "name", Field: "Name", Type: String},
+		{Name: "Ratio", JSONName: "Ratio", Field: "Ratio", Type: Double, Nullable: true},
+		{Name: "Updated", JSONName: "Updated", Field: "Updated", Type: Timestamp},
+	}, s.Columns)
+	assert.Empty(t, s.Key)
+}
+
+// TestForFeed checks the schema of every feed against the parquet schema the
+// parquet exporter writes.
+func TestForFeed(t *testing.T) {
+	for _, feed := range Feeds() {
+		t.Run(string(feed), func(t *testing.T) {
+			s, err := ForFeed(feed)
+			require.NoError(t, err)
+			handler, err := parquetschema.NewSchemaHandlerFromStruct(reflect.New(models[feed]).Interface())
+			require.NoError(t, err)
+			elements := handler.SchemaElements[1:]
+			require.Len(t, s.Columns, len(elements))
+			for i, element := range elements {
+				column := s.Columns[i]
+				assert.Equal(t, handler.GetExName(i+1), column.Name)
+				assert.Equal(t, element.GetRepetitionType() == parquet.FieldRepetitionType_OPTIONAL, column.Nullable, column.Name)
+			}
+
+			require.NotEmpty(t, s.Key)
+			for _, key := range s.Key {
+				i := slices.IndexFunc(s.Columns, func(c Column) bool { return c.Name == key })
+				require.GreaterOrEqual(t, i, 0, "key column %s", key)
+				assert.False(t, s.Columns[i].Nullable, "key column %s", key)
+			}
+
+			for _, render := range []func() ([]byte, error){s.JSONSchema, s.Avro} {
+				b, err := render()
+				require.NoError(t, err)
+				assert.True(t, json.Valid(b))
+			}
+		})
+	}
+	_, err := ForFeed(sportscrape.DummyFeed)
+	assert.ErrorIs(t, err, ErrUnknownFeed)
+}
+
+func TestRender(t *testing.T) {
+	s := Schema{
+		Name:      "Odds",
+		Namespace: "sportscrape.test",
+		Columns: []Column{
+			{Name: "pull_timestamp", JSONName: "pull_timestamp", Type: Timestamp, Description: "Fetch time"},
+			{Name: "event_date", JSONName: "event_date", Type: Date},
+			{Name: "team", JSONName: "team", Type: String},
+			{Name: "odds", JSONName: "odds", Type: Float, Nullable: true},
+		},
+	}
+
+	assert.Equal(t, strings.Join([]string{
+		"message Odds {",
+		"  required int64 pull_timestamp (TIMESTAMP(MILLIS,true));",
+		"  required int32 event_date (DATE);",
+		"  required binary team (STRING);",
+		"  optional float odds;",
+		"}",
+		"",
+	}, "\n"), s.Parquet())
+
+	b, err := s.Avro()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "record",
+		"name": "Odds",
+		"namespace": "sportscrape.test",
+		"fields": [
+			{"name": "pull_timestamp", "type": {"type": "long", "logicalType": "timestamp-millis"}, "doc": "Fetch time"},
+			{"name": "event_date", "type": {"type": "int", "logicalType": "date"}},
+			{"name": "team", "type": "string"},
+			{"name": "odds", "type": ["null", "float"], "default": null}
+		]
+	}`, string(b))
+
+	b, err = s.JSONSchema()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"$id": "sportscrape.test.Odds",
+		"title": "Odds",
+		"type": "object",
+		"properties": {
+			"pull_timestamp": {"type": "string", "format": "date-time", "description": "Fetch time"},
+			"event_date": {"type": "string", "format": "date-time"},
+			"team": {"type": "string"},
+			"odds": {"type": ["number", "null"]}
+		},
+		"required": ["pull_timestamp", "event_date", "team", "odds"]
+	}`, string(b))
+}
@@ -1,8 +1,10 @@
 package scraper
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
@@ -18,28 +20,52 @@ type BaseDocumentScraper struct {
 	Timeout           time.Duration
 	Debug             bool
 	NetworkHeaders    network.Headers
+	// Proxy routes the browser's traffic through an HTTP or SOCKS5 proxy, see request.ParseProxy. Default nil = direct
+	Proxy *url.URL
+	// ChromePath is the Chrome binary to launch. Default "" = found on PATH
+	ChromePath string
+	// ChromeFlags are extra Chrome command line flags, e.g. {"disable-gpu": true}
+	ChromeFlags map[string]any
+	// NoSandbox disables Chrome's sandbox, typically required in containers running as root
+	NoSandbox bool
+	// MaxTabs bounds the number of tabs loading documents at once. Default 0 = unlimited
+	MaxTabs int
+	// ReuseTabs keeps tabs open between fetches instead of opening a new tab for each
+	ReuseTabs bool
 }
 
 // Init initializes the DocumentRetriever using the scraper's Timeout, Debug,
-// and NetworkHeaders fields. If DocumentRetriever is already set, Init is a
-// no-op. Calls log.Fatalln if Timeout is zero and no DocumentRetriever is set.
-func (s *BaseDocumentScraper) Init() {
+// NetworkHeaders, browser launch (Proxy, ChromePath, ChromeFlags, NoSandbox)
+// and tab (MaxTabs, ReuseTabs) fields. If DocumentRetriever is already set, Init is a
+// no-op. Returns ErrMissingTimeout if Timeout is zero and no DocumentRetriever
+// is set, or the error launching the browser.
+func (s *BaseDocumentScraper) Init() error {
 	if s.DocumentRetriever == nil {
 		if s.Timeout == 0 {
-			log.Fatalln("Timeout needs to be > 0")
+			return ErrMissingTimeout
 		}
 
-		documentretriever, err := request.NewDocumentRetrieverV2(
-			s.NetworkHeaders,
+		options := []request.RetrieverOptionV2{
 			request.WithDebugV2(s.Debug),
 			request.WithTimeoutV2(s.Timeout),
-		)
+			request.WithProxyV2(s.Proxy),
+			request.WithExecPathV2(s.ChromePath),
+			request.WithNoSandboxV2(s.NoSandbox),
+			request.WithMaxTabsV2(s.MaxTabs),
+			request.WithTabReuseV2(s.ReuseTabs),
+		}
+		for name, value := range s.ChromeFlags {
+			options = append(options, request.WithAllocatorFlagV2(name, value))
+		}
+		documentretriever, err := request.NewDocumentRetrieverV2(s.NetworkHeaders, options...)
 		if err != nil {
-			log.Fatalln(err)
+			return fmt.Errorf("launching browser: %w", err)
 		}
 		s.DocumentRetriever = documentretriever
 	}
+	return nil
 }
+
 // FetchDoc retrieves and parses the HTML document at URL, waiting for the
 // element matched by selector to be ready before extracting its outer HTML.
 //
@@ -49,10 +75,16 @@ func (s *BaseDocumentScraper) Init() {
 //
 // Returns an error if DocumentRetriever is nil or if retrieval or parsing fails.
 func (s *BaseDocumentScraper) FetchDoc(URL string, selector string) (*goquery.Document, error) {
+	return s.FetchDocContext(context.Background(), URL, selector)
+}
+
+// FetchDocContext is FetchDoc bound to ctx; retrieval is aborted and the
+// browser tab closed once ctx is cancelled.
+func (s *BaseDocumentScraper) FetchDocContext(ctx context.Context, URL string, selector string) (*goquery.Document, error) {
 	if s.DocumentRetriever == nil {
 		return nil, fmt.Errorf("DocumentRetriever is required")
 	}
-	doc, err := s.DocumentRetriever.RetrieveDocument(URL, selector)
+	doc, err := s.DocumentRetriever.RetrieveDocumentContext(ctx, URL, selector)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +92,17 @@ func (s *BaseDocumentScraper) FetchDoc(URL string, selector string) (*goquery.Do
 	return doc, nil
 }
 
+// GetDocumentRetriever returns the scraper's DocumentRetriever.
+func (s *BaseDocumentScraper) GetDocumentRetriever() *request.DocumentRetrieverV2 {
+	return s.DocumentRetriever
+}
+
+// SetDocumentRetriever replaces the scraper's DocumentRetriever, e.g. to share a
+// browser session with another scraper. Init is a no-op once one is set.
+func (s *BaseDocumentScraper) SetDocumentRetriever(documentRetriever *request.DocumentRetrieverV2) {
+	s.DocumentRetriever = documentRetriever
+}
+
 // Close tears down the underlying browser session and sets DocumentRetriever
 // to nil. Safe to call when DocumentRetriever is nil.
 func (s *BaseDocumentScraper) Close() {
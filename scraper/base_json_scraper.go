@@ -1,6 +1,7 @@
 package scraper
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,14 +11,22 @@ import (
 	"github.com/lightning-dabbler/sportscrape/util/request"
 )
 
-type BaseJsonScraper[T any] struct{}
+type BaseJsonScraper[T any] struct {
+	// Client fetches the JSON payloads. Default nil = request.DefaultClient
+	Client *request.Client
+}
 
-func (s BaseJsonScraper[T]) Init() {}
+func (s BaseJsonScraper[T]) Init() error { return nil }
 
 // RetrieveBytes retrieves a []byte slice from the specified URL.
 func (s BaseJsonScraper[T]) RetrieveBytes(url string) (*[]byte, error) {
+	return s.RetrieveBytesContext(context.Background(), url)
+}
+
+// RetrieveBytesContext retrieves a []byte slice from the specified URL, aborting when ctx is cancelled.
+func (s BaseJsonScraper[T]) RetrieveBytesContext(ctx context.Context, url string) (*[]byte, error) {
 
-	resp, err := request.Get(url)
+	resp, err := s.Client.GetContext(ctx, url)
 	if err != nil {
 
 		return nil, err
@@ -52,7 +61,12 @@ func (s BaseJsonScraper[T]) HydrateModel(payload []byte) (*T, error) {
 
 // RetrieveModel retrieves a model struct from the specified URL.
 func (s BaseJsonScraper[T]) RetrieveModel(url string) (*T, error) {
-	body, err := s.RetrieveBytes(url)
+	return s.RetrieveModelContext(context.Background(), url)
+}
+
+// RetrieveModelContext retrieves a model struct from the specified URL, aborting when ctx is cancelled.
+func (s BaseJsonScraper[T]) RetrieveModelContext(ctx context.Context, url string) (*T, error) {
+	body, err := s.RetrieveBytesContext(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/lightning-dabbler/sportscrape/util/request"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -36,6 +37,18 @@ func TestRetrieveBytes_Success(t *testing.T) {
 	assert.Equal(t, []byte("hello world"), *got, "RetrieveBytes() mismatch")
 }
 
+func TestRetrieveBytes_Client(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Header.Get("User-Agent")))
+	}))
+	defer ts.Close()
+
+	s := BaseJsonScraper[testModelNoRaw]{Client: request.NewClient(request.WithUserAgent("test-agent"))}
+	got, err := s.RetrieveBytes(ts.URL)
+	require.NoError(t, err, "RetrieveBytes() unexpected error")
+	assert.Equal(t, []byte("test-agent"), *got, "RetrieveBytes() should use the scraper's Client")
+}
+
 func TestRetrieveModel_Success(t *testing.T) {
 	const payload = `{"name":"alpha"}`
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1,7 +1,6 @@
 package scraper
 
 import (
-	"log"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
@@ -18,10 +17,12 @@ type BaseScraper struct {
 	Debug bool
 }
 
-func (s BaseScraper) Init() {
+// Init returns ErrMissingTimeout if Timeout is zero.
+func (s BaseScraper) Init() error {
 	if s.Timeout == 0 {
-		log.Fatalln("Timeout needs to be > 0")
+		return ErrMissingTimeout
 	}
+	return nil
 }
 
 // RetrieveDocument fetches and parses a web document from the specified URL.
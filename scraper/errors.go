@@ -0,0 +1,21 @@
+package scraper
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidConfig is wrapped by every configuration error returned from a scraper's Init.
+var ErrInvalidConfig = errors.New("invalid scraper configuration")
+
+// Validation errors returned from Init. Providers wrap them with the scraper
+// they stem from and match with errors.Is.
+var (
+	ErrMissingTimeout    = fmt.Errorf("%w: Timeout needs to be > 0", ErrInvalidConfig)
+	ErrMissingDate       = fmt.Errorf("%w: Date is a required argument", ErrInvalidConfig)
+	ErrInvalidDate       = fmt.Errorf("%w: Date is invalid", ErrInvalidConfig)
+	ErrMissingYear       = fmt.Errorf("%w: Year is a required argument", ErrInvalidConfig)
+	ErrUndefinedLeague   = fmt.Errorf("%w: League is a required argument", ErrInvalidConfig)
+	ErrUnsupportedLeague = fmt.Errorf("%w: League is not supported", ErrInvalidConfig)
+	ErrUndefinedPeriod   = fmt.Errorf("%w: Period is a required argument", ErrInvalidConfig)
+)
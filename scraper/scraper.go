@@ -1,19 +1,52 @@
 package scraper
 
-import "github.com/lightning-dabbler/sportscrape"
+import (
+	"context"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape"
+	"github.com/lightning-dabbler/sportscrape/util/request"
+)
 
 type MatchupScraper[M any] interface {
-	Scrape() sportscrape.MatchupOutput[M]
-	Init()
+	Scrape(ctx context.Context) sportscrape.MatchupOutput[M]
+	// Init validates the scraper's configuration and acquires its resources.
+	Init() error
 	Feed() sportscrape.Feed
 	Provider() sportscrape.Provider
 	Close()
 }
 
 type EventDataScraper[M, E any] interface {
-	Scrape(matchup M) sportscrape.EventDataOutput[E]
+	Scrape(ctx context.Context, matchup M) sportscrape.EventDataOutput[E]
 	Feed() sportscrape.Feed
 	Provider() sportscrape.Provider
-	Init()
+	// Init validates the scraper's configuration and acquires its resources.
+	Init() error
 	Close()
 }
+
+// DocumentScraper is implemented by scrapers backed by a DocumentRetrieverV2
+// (see BaseDocumentScraper), allowing a single browser session to be shared
+// between scrapers.
+type DocumentScraper interface {
+	GetDocumentRetriever() *request.DocumentRetrieverV2
+	SetDocumentRetriever(documentRetriever *request.DocumentRetrieverV2)
+}
+
+// Payload is the raw JSON or HTML fetched for an event.
+type Payload struct {
+	// URL the payload was fetched from
+	URL string
+	// PullTimestamp is when the payload was fetched
+	PullTimestamp time.Time
+	Body          []byte
+}
+
+// EventDataParser is implemented by event data scrapers whose parsing is split
+// from fetching: Scrape fetches the event's payload and hands it to Parse,
+// which builds the records without network access. Records can thus be
+// rebuilt from archived payloads (see runner.RawEvent).
+type EventDataParser[M, E any] interface {
+	Parse(matchup M, payload Payload) ([]E, error)
+}
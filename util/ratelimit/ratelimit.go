@@ -0,0 +1,141 @@
+// Package ratelimit provides process-wide token bucket rate limiters keyed by
+// sportscrape.Provider, so that every runner scraping the same provider shares
+// a single request budget.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape"
+)
+
+// Limit is a token bucket configuration.
+// A RequestsPerSecond <= 0 means unlimited.
+type Limit struct {
+	// RequestsPerSecond is the rate at which tokens are replenished.
+	RequestsPerSecond float64
+	// Burst is the bucket size, i.e. the number of requests allowed at once. Minimum 1.
+	Burst int
+}
+
+// Unlimited reports whether the limit disables throttling.
+func (l Limit) Unlimited() bool {
+	return l.RequestsPerSecond <= 0
+}
+
+// Defaults are the per-provider limits used when a provider's limiter is first
+// created. Providers not listed are unlimited.
+var Defaults = map[sportscrape.Provider]Limit{
+	sportscrape.NBA:                 {RequestsPerSecond: 2, Burst: 4},
+	sportscrape.FS:                  {RequestsPerSecond: 5, Burst: 10},
+	sportscrape.BaseballSavant:      {RequestsPerSecond: 5, Burst: 10},
+	sportscrape.ESPNMMA:             {RequestsPerSecond: 2, Burst: 4},
+	sportscrape.BasketballReference: {RequestsPerSecond: 1.0 / 3, Burst: 1},
+	sportscrape.BaseballReference:   {RequestsPerSecond: 1.0 / 3, Burst: 1},
+}
+
+// Limiter is a token bucket rate limiter. It is safe for concurrent use.
+type Limiter struct {
+	mu     sync.Mutex
+	limit  Limit
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter creates a Limiter with a full bucket.
+func NewLimiter(limit Limit) *Limiter {
+	if limit.Burst < 1 {
+		limit.Burst = 1
+	}
+	return &Limiter{limit: limit, tokens: float64(limit.Burst), last: time.Now()}
+}
+
+// Limit returns the current limit.
+func (l *Limiter) Limit() Limit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limit
+}
+
+// SetLimit replaces the limit. The bucket keeps its tokens, capped to the new
+// burst, so changing the limit never grants an extra burst of requests.
+func (l *Limiter) SetLimit(limit Limit) {
+	if limit.Burst < 1 {
+		limit.Burst = 1
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	if l.limit.Unlimited() {
+		// No tokens were counted while unlimited
+		l.tokens = float64(limit.Burst)
+	} else {
+		l.tokens = math.Min(float64(l.limit.Burst), l.tokens+now.Sub(l.last).Seconds()*l.limit.RequestsPerSecond)
+	}
+	l.tokens = math.Min(float64(limit.Burst), l.tokens)
+	l.limit = limit
+	l.last = now
+}
+
+// Wait blocks until a token is available or ctx is done. Tokens are reserved in
+// call order, so waiters are served first come, first served.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	l.mu.Lock()
+	if l.limit.Unlimited() {
+		l.mu.Unlock()
+		return nil
+	}
+	now := time.Now()
+	elapsed := now.Sub(l.last).Seconds()
+	l.last = now
+	l.tokens = math.Min(float64(l.limit.Burst), l.tokens+elapsed*l.limit.RequestsPerSecond)
+	l.tokens--
+	if l.tokens >= 0 {
+		l.mu.Unlock()
+		return nil
+	}
+	delay := time.Duration(-l.tokens / l.limit.RequestsPerSecond * float64(time.Second))
+	l.mu.Unlock()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Hand the reserved token back.
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+var (
+	registryMu sync.Mutex
+	registry   = map[sportscrape.Provider]*Limiter{}
+)
+
+// ForProvider returns the process-wide Limiter for provider, creating it from
+// Defaults on first use.
+func ForProvider(provider sportscrape.Provider) *Limiter {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	l, ok := registry[provider]
+	if !ok {
+		l = NewLimiter(Defaults[provider])
+		registry[provider] = l
+	}
+	return l
+}
+
+// Set overrides the limit of provider's process-wide Limiter.
+func Set(provider sportscrape.Provider, limit Limit) {
+	ForProvider(provider).SetLimit(limit)
+}
@@ -0,0 +1,73 @@
+//go:build unit
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiterWait(t *testing.T) {
+	l := NewLimiter(Limit{RequestsPerSecond: 20, Burst: 2})
+	start := time.Now()
+	for range 4 {
+		assert.NoError(t, l.Wait(context.Background()))
+	}
+	// Burst of 2 is immediate; the remaining 2 tokens take ~50ms each.
+	elapsed := time.Since(start)
+	assert.GreaterOrEqual(t, elapsed, 90*time.Millisecond)
+	assert.Less(t, elapsed, 500*time.Millisecond)
+}
+
+func TestLimiterSetLimitKeepsTokens(t *testing.T) {
+	l := NewLimiter(Limit{RequestsPerSecond: 20, Burst: 2})
+	for range 2 {
+		assert.NoError(t, l.Wait(context.Background()))
+	}
+	// Reapplying the limit must not refill the emptied bucket
+	l.SetLimit(Limit{RequestsPerSecond: 20, Burst: 2})
+	start := time.Now()
+	assert.NoError(t, l.Wait(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+
+	// A smaller burst caps the tokens saved up
+	l = NewLimiter(Limit{RequestsPerSecond: 20, Burst: 10})
+	l.SetLimit(Limit{RequestsPerSecond: 20, Burst: 1})
+	assert.NoError(t, l.Wait(context.Background()))
+	start = time.Now()
+	assert.NoError(t, l.Wait(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+}
+
+func TestLimiterUnlimited(t *testing.T) {
+	l := NewLimiter(Limit{})
+	start := time.Now()
+	for range 1000 {
+		assert.NoError(t, l.Wait(context.Background()))
+	}
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestLimiterWaitCancelled(t *testing.T) {
+	l := NewLimiter(Limit{RequestsPerSecond: 1, Burst: 1})
+	assert.NoError(t, l.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, l.Wait(ctx), context.DeadlineExceeded)
+}
+
+func TestForProvider(t *testing.T) {
+	l := ForProvider(sportscrape.NBA)
+	assert.Same(t, l, ForProvider(sportscrape.NBA), "limiters are shared per provider")
+	assert.Equal(t, Defaults[sportscrape.NBA], l.Limit())
+
+	assert.True(t, ForProvider(sportscrape.DummyProvider).Limit().Unlimited())
+
+	Set(sportscrape.DummyProvider, Limit{RequestsPerSecond: 3, Burst: 6})
+	assert.Equal(t, Limit{RequestsPerSecond: 3, Burst: 6}, ForProvider(sportscrape.DummyProvider).Limit())
+}
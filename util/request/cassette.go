@@ -0,0 +1,267 @@
+package request
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/chromedp/cdproto/network"
+)
+
+// RecordEnv is the environment variable switching CassetteModeFromEnv to ModeRecord, e.g.
+//
+//	SPORTSCRAPE_RECORD=1 go test -tags=unit ./dataprovider/nba/...
+const RecordEnv = "SPORTSCRAPE_RECORD"
+
+// ErrCassetteMiss is returned in ModeReplay for requests the cassette did not record.
+var ErrCassetteMiss = errors.New("request not recorded in cassette")
+
+// CassetteMode selects whether a Cassette replays or records.
+type CassetteMode int
+
+const (
+	// ModeReplay serves recorded responses and never touches the network.
+	ModeReplay CassetteMode = iota
+	// ModeRecord performs real requests and records their responses,
+	// replacing the previous content of the cassette on Save.
+	ModeRecord
+)
+
+// CassetteModeFromEnv returns ModeRecord when RecordEnv is set to a non-empty value, ModeReplay otherwise.
+func CassetteModeFromEnv() CassetteMode {
+	if os.Getenv(RecordEnv) != "" {
+		return ModeRecord
+	}
+	return ModeReplay
+}
+
+// Cassette records HTTP responses and browser HTML snapshots into a JSON file
+// (typically under testdata/) and replays them, so scrapers can be tested
+// offline and deterministically. Create one with OpenCassette and call Save
+// once recording is done. Safe for concurrent use.
+//
+// HTTP requests go through Transport, which GetContext uses when the context
+// was built with Context. Browser fetches go through DocumentInterceptor, see
+// NewDocumentRetriever.
+type Cassette struct {
+	Path string
+	Mode CassetteMode
+
+	mu           sync.Mutex
+	interactions map[string]Interaction
+	documents    map[string]Snapshot
+}
+
+// Interaction is a recorded HTTP request and its response.
+type Interaction struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	// Body is the raw response body, base64 encoded in the cassette file.
+	Body []byte `json:"body"`
+}
+
+// sensitiveHeaders are dropped from recorded interactions so cassettes
+// committed to the repository carry no session or credentials.
+var sensitiveHeaders = []string{"Set-Cookie", "Authorization", "Cookie"}
+
+// Snapshot is the recorded outer HTML of the element matching Selector at URL.
+type Snapshot struct {
+	URL      string `json:"url"`
+	Selector string `json:"selector"`
+	HTML     string `json:"html"`
+}
+
+type cassetteFile struct {
+	Interactions []Interaction `json:"interactions"`
+	Documents    []Snapshot    `json:"documents"`
+}
+
+// OpenCassette loads the cassette at path. In ModeReplay the file must exist;
+// in ModeRecord it starts empty and is written by Save.
+func OpenCassette(path string, mode CassetteMode) (*Cassette, error) {
+	c := &Cassette{
+		Path:         path,
+		Mode:         mode,
+		interactions: map[string]Interaction{},
+		documents:    map[string]Snapshot{},
+	}
+	if mode == ModeRecord {
+		return c, nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading cassette (record it with %s=1): %w", RecordEnv, err)
+	}
+	var file cassetteFile
+	if err := json.Unmarshal(b, &file); err != nil {
+		return nil, fmt.Errorf("reading cassette %s: %w", path, err)
+	}
+	for _, interaction := range file.Interactions {
+		c.interactions[interactionKey(interaction.Method, interaction.URL)] = interaction
+	}
+	for _, snapshot := range file.Documents {
+		c.documents[snapshotKey(snapshot.URL, snapshot.Selector)] = snapshot
+	}
+	return c, nil
+}
+
+// Save writes the recorded interactions and snapshots to Path, sorted so
+// re-recording unchanged responses produces no diff. Set-Cookie, Authorization
+// and Cookie headers are not recorded. No-op in ModeReplay.
+func (c *Cassette) Save() error {
+	if c.Mode != ModeRecord {
+		return nil
+	}
+	c.mu.Lock()
+	var file cassetteFile
+	for _, interaction := range c.interactions {
+		file.Interactions = append(file.Interactions, interaction)
+	}
+	for _, snapshot := range c.documents {
+		file.Documents = append(file.Documents, snapshot)
+	}
+	c.mu.Unlock()
+	sort.Slice(file.Interactions, func(i, j int) bool {
+		return interactionKey(file.Interactions[i].Method, file.Interactions[i].URL) < interactionKey(file.Interactions[j].Method, file.Interactions[j].URL)
+	})
+	sort.Slice(file.Documents, func(i, j int) bool {
+		return snapshotKey(file.Documents[i].URL, file.Documents[i].Selector) < snapshotKey(file.Documents[j].URL, file.Documents[j].Selector)
+	})
+	b, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.Path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.Path, append(b, '\n'), 0o644)
+}
+
+// Context returns a copy of ctx whose GetContext requests go through the cassette's Transport.
+func (c *Cassette) Context(ctx context.Context) context.Context {
+	return ContextWithTransport(ctx, c.Transport(nil))
+}
+
+// Transport returns an http.RoundTripper replaying recorded responses in
+// ModeReplay and recording the responses of next (http.DefaultTransport when
+// nil) in ModeRecord.
+func (c *Cassette) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &cassetteTransport{cassette: c, next: next}
+}
+
+type cassetteTransport struct {
+	cassette *Cassette
+	next     http.RoundTripper
+}
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c := t.cassette
+	key := interactionKey(req.Method, req.URL.String())
+	if c.Mode != ModeRecord {
+		c.mu.Lock()
+		interaction, ok := c.interactions[key]
+		c.mu.Unlock()
+		if !ok {
+			return nil, fmt.Errorf("%w: %s %s", ErrCassetteMiss, req.Method, req.URL)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+			StatusCode:    interaction.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(interaction.Body)),
+			ContentLength: int64(len(interaction.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	header := resp.Header.Clone()
+	for _, name := range sensitiveHeaders {
+		header.Del(name)
+	}
+	c.mu.Lock()
+	c.interactions[key] = Interaction{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		Header:     header,
+		Body:       body,
+	}
+	c.mu.Unlock()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// DocumentInterceptor returns a DocumentInterceptor replaying recorded HTML
+// snapshots in ModeReplay and recording the browser's in ModeRecord.
+func (c *Cassette) DocumentInterceptor() DocumentInterceptor {
+	return func(next DocumentFetcher) DocumentFetcher {
+		return func(ctx context.Context, url, waitReadySelector string) (string, error) {
+			key := snapshotKey(url, waitReadySelector)
+			if c.Mode != ModeRecord {
+				c.mu.Lock()
+				snapshot, ok := c.documents[key]
+				c.mu.Unlock()
+				if !ok {
+					return "", fmt.Errorf("%w: document %s (%s)", ErrCassetteMiss, url, waitReadySelector)
+				}
+				return snapshot.HTML, nil
+			}
+			html, err := next(ctx, url, waitReadySelector)
+			if err != nil {
+				return "", err
+			}
+			c.mu.Lock()
+			c.documents[key] = Snapshot{URL: url, Selector: waitReadySelector, HTML: html}
+			c.mu.Unlock()
+			return html, nil
+		}
+	}
+}
+
+// NewDocumentRetriever returns a DocumentRetrieverV2 fetching through the
+// cassette's DocumentInterceptor. In ModeReplay no browser is launched; in
+// ModeRecord it behaves like NewDocumentRetrieverV2.
+func (c *Cassette) NewDocumentRetriever(networkHeaders network.Headers, options ...RetrieverOptionV2) (*DocumentRetrieverV2, error) {
+	options = append(options, WithDocumentInterceptor(c.DocumentInterceptor()))
+	if c.Mode == ModeRecord {
+		return NewDocumentRetrieverV2(networkHeaders, options...)
+	}
+	dr := newDocumentRetrieverV2(networkHeaders)
+	for _, option := range options {
+		option(dr)
+	}
+	return dr, nil
+}
+
+func interactionKey(method, url string) string {
+	return method + " " + url
+}
+
+func snapshotKey(url, selector string) string {
+	return url + " " + selector
+}
@@ -0,0 +1,141 @@
+//go:build unit
+
+package request
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCassetteHTTP(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata", "cassette.json")
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"game":1}`))
+	}))
+
+	recorder, err := OpenCassette(path, ModeRecord)
+	require.NoError(t, err)
+	resp, err := GetContext(recorder.Context(context.Background()), ts.URL+"/game")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, `{"game":1}`, string(body))
+	_, err = GetContext(recorder.Context(context.Background()), ts.URL+"/missing")
+	assert.Error(t, err)
+	require.NoError(t, recorder.Save())
+	ts.Close()
+	assert.Equal(t, 2, requests)
+
+	player, err := OpenCassette(path, ModeReplay)
+	require.NoError(t, err)
+	ctx := player.Context(context.Background())
+	resp, err = GetContext(ctx, ts.URL+"/game")
+	require.NoError(t, err)
+	body, err = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, `{"game":1}`, string(body))
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	var statusErr *StatusError
+	_, err = GetContext(ctx, ts.URL+"/missing")
+	require.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusNotFound, statusErr.StatusCode)
+
+	_, err = GetContext(ctx, ts.URL+"/unrecorded")
+	assert.ErrorIs(t, err, ErrCassetteMiss)
+	assert.Equal(t, 2, requests, "replay must not reach the network")
+}
+
+func TestCassetteDocuments(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recorder, err := OpenCassette(path, ModeRecord)
+	require.NoError(t, err)
+	browser := func(ctx context.Context, url, waitReadySelector string) (string, error) {
+		return `<script id="__NEXT_DATA__">{"props":{}}</script>`, nil
+	}
+	html, err := recorder.DocumentInterceptor()(browser)(context.Background(), "https://www.nba.com/games", "script#__NEXT_DATA__")
+	require.NoError(t, err)
+	assert.Contains(t, html, "__NEXT_DATA__")
+	require.NoError(t, recorder.Save())
+
+	player, err := OpenCassette(path, ModeReplay)
+	require.NoError(t, err)
+	dr, err := player.NewDocumentRetriever(nil)
+	require.NoError(t, err)
+	defer dr.Close()
+	doc, err := dr.RetrieveDocument("https://www.nba.com/games", "script#__NEXT_DATA__")
+	require.NoError(t, err)
+	assert.Equal(t, `{"props":{}}`, doc.Find("script#__NEXT_DATA__").Text())
+
+	_, err = dr.RetrieveDocument("https://www.nba.com/games", "div")
+	assert.ErrorIs(t, err, ErrCassetteMiss)
+}
+
+func TestCassetteBinaryBodyWithoutSensitiveHeaders(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	payload := []byte{0x1f, 0x8b, 0x00, 0xff, 0xfe, '\n'}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.Header().Set("Authorization", "Bearer secret")
+		w.Header().Set("Cookie", "session=secret")
+		w.Write(payload)
+	}))
+	defer ts.Close()
+
+	recorder, err := OpenCassette(path, ModeRecord)
+	require.NoError(t, err)
+	resp, err := GetContext(recorder.Context(context.Background()), ts.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "session=secret", resp.Header.Get("Set-Cookie"), "the live response is left untouched")
+	require.NoError(t, recorder.Save())
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "secret")
+	assert.Contains(t, string(b), base64.StdEncoding.EncodeToString(payload))
+
+	player, err := OpenCassette(path, ModeReplay)
+	require.NoError(t, err)
+	resp, err = GetContext(player.Context(context.Background()), ts.URL)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, payload, body)
+	assert.Equal(t, "application/octet-stream", resp.Header.Get("Content-Type"))
+	for _, header := range []string{"Set-Cookie", "Authorization", "Cookie"} {
+		assert.Empty(t, resp.Header.Get(header), header)
+	}
+}
+
+func TestOpenCassetteReplayMissingFile(t *testing.T) {
+	_, err := OpenCassette(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+	assert.ErrorContains(t, err, RecordEnv)
+}
+
+func TestCassetteModeFromEnv(t *testing.T) {
+	t.Setenv(RecordEnv, "")
+	assert.Equal(t, ModeReplay, CassetteModeFromEnv())
+	t.Setenv(RecordEnv, "1")
+	assert.Equal(t, ModeRecord, CassetteModeFromEnv())
+}
@@ -0,0 +1,252 @@
+package request
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/lightning-dabbler/sportscrape/version"
+)
+
+// DefaultUserAgent is the User-Agent header NewClient sends unless overridden.
+var DefaultUserAgent = "sportscrape/" + version.Version
+
+// ErrBodyTooLarge is returned while reading a response body larger than Client.MaxBodySize.
+var ErrBodyTooLarge = errors.New("response body exceeds max body size")
+
+// DefaultClient is the Client used by Get, GetContext and by scrapers without a Client of their own.
+// It sends requests as http.Get does, with Go's User-Agent and no timeout; the
+// defaults of NewClient are opt-in.
+var DefaultClient = &Client{HTTPClient: http.DefaultClient, Header: http.Header{}}
+
+// Client performs HTTP GET requests for scrapers fetching JSON. Create one
+// with NewClient. A nil *Client behaves like DefaultClient. Safe for
+// concurrent use.
+type Client struct {
+	// HTTPClient sends the requests
+	HTTPClient *http.Client
+	// Header is added to every request
+	Header http.Header
+	// Timeout bounds every request, including reading its body. 0 = no timeout
+	Timeout time.Duration
+	// MaxBodySize is the max number of (decompressed) response body bytes read
+	// before failing with ErrBodyTooLarge. 0 = unlimited
+	MaxBodySize int64
+	// proxy, set by WithProxy, is applied to HTTPClient by NewClient
+	proxy *url.URL
+}
+
+// ClientOption defines a function that configures a Client.
+type ClientOption func(*Client)
+
+// WithHTTPClient returns a ClientOption that sets the *http.Client sending the requests,
+// e.g. to tune its transport or inject a test client.
+//
+// Parameter:
+//   - httpClient: The *http.Client
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.HTTPClient = httpClient
+	}
+}
+
+// WithClientHeader returns a ClientOption that sets a header on every request.
+// Setting Accept-Encoding to gzip is supported: gzipped responses are decompressed.
+//
+// Parameters:
+//   - key: The header name
+//   - value: The header value
+func WithClientHeader(key, value string) ClientOption {
+	return func(c *Client) {
+		c.Header.Set(key, value)
+	}
+}
+
+// WithUserAgent returns a ClientOption that sets the User-Agent header of every request.
+//
+// Parameter:
+//   - userAgent: The User-Agent header value
+func WithUserAgent(userAgent string) ClientOption {
+	return WithClientHeader("User-Agent", userAgent)
+}
+
+// WithClientTimeout returns a ClientOption that sets the timeout of every request.
+//
+// Parameter:
+//   - timeout: The duration after which a request and the read of its body are cancelled. 0 = no timeout
+func WithClientTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.Timeout = timeout
+	}
+}
+
+// WithMaxBodySize returns a ClientOption that limits the size of response bodies.
+//
+// Parameter:
+//   - size: The max number of response body bytes. 0 = unlimited
+func WithMaxBodySize(size int64) ClientOption {
+	return func(c *Client) {
+		c.MaxBodySize = size
+	}
+}
+
+// WithProxy returns a ClientOption that sends every request through an HTTP or SOCKS5 proxy.
+// Credentials in the URL are used to authenticate with the proxy.
+//
+// Parameter:
+//   - proxy: The proxy URL, see ParseProxy
+func WithProxy(proxy *url.URL) ClientOption {
+	return func(c *Client) {
+		c.proxy = proxy
+	}
+}
+
+// NewClient creates a new Client with default settings, which can be
+// overridden by the provided options.
+//
+// By default, the client uses:
+//   - http.DefaultClient
+//   - DefaultUserAgent as User-Agent
+//   - 1 minute timeout
+//   - Unlimited body size
+func NewClient(options ...ClientOption) *Client {
+	c := &Client{
+		HTTPClient: http.DefaultClient,
+		Header:     http.Header{"User-Agent": []string{DefaultUserAgent}},
+		Timeout:    1 * time.Minute,
+	}
+	for _, option := range options {
+		option(c)
+	}
+	if c.proxy != nil {
+		c.HTTPClient = withProxy(c.HTTPClient, c.proxy)
+	}
+	return c
+}
+
+// Get performs a GET request
+// Returns an http response
+func (c *Client) Get(url string) (*http.Response, error) {
+	return c.GetContext(context.Background(), url)
+}
+
+// GetContext performs a GET request bound to ctx. The request is aborted
+// when ctx is cancelled, its deadline or the client Timeout expires.
+// Returns an http response; responses other than 200 OK fail with a *StatusError
+func (c *Client) GetContext(ctx context.Context, url string) (*http.Response, error) {
+	if c == nil {
+		c = DefaultClient
+	}
+	log.Printf("Fetching from %s\n", url)
+	cancel := context.CancelFunc(func() {})
+	if c.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("HTTP Error at %s: %w", url, err)
+	}
+	for key, values := range c.Header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if err := waitLimiter(ctx); err != nil {
+		cancel()
+		return nil, fmt.Errorf("HTTP Error at %s: %w", url, err)
+	}
+	resp, err := c.httpClient(ctx).Do(req)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("HTTP Error at %s: %w", url, err)
+	}
+	if resp.StatusCode != 200 {
+		resp.Body.Close()
+		cancel()
+		return nil, &StatusError{URL: url, StatusCode: resp.StatusCode, Status: resp.Status}
+	}
+
+	body := &responseBody{Reader: resp.Body, closers: []func() error{resp.Body.Close}}
+	// http.Transport only decompresses when it requested gzip itself, not
+	// when Accept-Encoding was set by the caller
+	if !resp.Uncompressed && strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		gz, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			resp.Body.Close()
+			cancel()
+			return nil, fmt.Errorf("HTTP Error at %s: %w", url, err)
+		}
+		body.Reader = gz
+		body.closers = append([]func() error{gz.Close}, body.closers...)
+		resp.Header.Del("Content-Encoding")
+		resp.Header.Del("Content-Length")
+		resp.ContentLength = -1
+		resp.Uncompressed = true
+	}
+	if c.MaxBodySize > 0 {
+		body.Reader = &maxBytesReader{reader: body.Reader, remaining: c.MaxBodySize, url: url}
+	}
+	if record := payloadRecorder(ctx); record != nil {
+		body.Reader = &recordingReader{reader: body.Reader, record: func(payload []byte) { record(url, payload) }}
+	}
+	body.closers = append(body.closers, func() error { cancel(); return nil })
+	resp.Body = body
+	return resp, nil
+}
+
+// httpClient returns the *http.Client sending requests bound to ctx, using the transport of ContextWithTransport if any.
+func (c *Client) httpClient(ctx context.Context) *http.Client {
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if transport, ok := ctx.Value(transportKey{}).(http.RoundTripper); ok && transport != nil {
+		withTransport := *client
+		withTransport.Transport = transport
+		client = &withTransport
+	}
+	return client
+}
+
+// responseBody is a response body closing every layer wrapped around the original.
+type responseBody struct {
+	io.Reader
+	closers []func() error
+}
+
+func (b *responseBody) Close() error {
+	var errs error
+	for _, close := range b.closers {
+		errs = errors.Join(errs, close())
+	}
+	return errs
+}
+
+// maxBytesReader fails with ErrBodyTooLarge once more than remaining bytes are read.
+type maxBytesReader struct {
+	reader    io.Reader
+	remaining int64
+	url       string
+}
+
+func (r *maxBytesReader) Read(p []byte) (int, error) {
+	if int64(len(p)) > r.remaining+1 {
+		p = p[:r.remaining+1]
+	}
+	n, err := r.reader.Read(p)
+	if int64(n) > r.remaining {
+		n = int(r.remaining)
+		r.remaining = 0
+		return n, fmt.Errorf("HTTP Error at %s: %w", r.url, ErrBodyTooLarge)
+	}
+	r.remaining -= int64(n)
+	return n, err
+}
@@ -0,0 +1,132 @@
+//go:build unit
+
+package request
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClient(t *testing.T) {
+	c := NewClient()
+	assert.Equal(t, http.DefaultClient, c.HTTPClient)
+	assert.Equal(t, DefaultUserAgent, c.Header.Get("User-Agent"))
+	assert.Equal(t, 1*time.Minute, c.Timeout)
+	assert.Zero(t, c.MaxBodySize)
+
+	httpClient := &http.Client{}
+	c = NewClient(
+		WithHTTPClient(httpClient),
+		WithUserAgent("test-agent"),
+		WithClientHeader("Accept", "application/json"),
+		WithClientTimeout(5*time.Second),
+		WithMaxBodySize(1024),
+	)
+	assert.Same(t, httpClient, c.HTTPClient)
+	assert.Equal(t, "test-agent", c.Header.Get("User-Agent"))
+	assert.Equal(t, "application/json", c.Header.Get("Accept"))
+	assert.Equal(t, 5*time.Second, c.Timeout)
+	assert.Equal(t, int64(1024), c.MaxBodySize)
+}
+
+func TestClientGetContext(t *testing.T) {
+	var gzipped bytes.Buffer
+	gz := gzip.NewWriter(&gzipped)
+	gz.Write([]byte(`{"gzip":true}`))
+	gz.Close()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/headers":
+			w.Write([]byte(r.Header.Get("User-Agent") + " " + r.Header.Get("Accept")))
+		case "/gzip":
+			w.Header().Set("Content-Encoding", "gzip")
+			w.Write(gzipped.Bytes())
+		case "/large":
+			w.Write([]byte(strings.Repeat("x", 100)))
+		case "/slow":
+			select {
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+			}
+		}
+	}))
+	defer ts.Close()
+
+	read := func(c *Client, path string) (string, error) {
+		resp, err := c.GetContext(context.Background(), ts.URL+path)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		return string(body), err
+	}
+
+	t.Run("default headers", func(t *testing.T) {
+		body, err := read(NewClient(WithUserAgent("test-agent"), WithClientHeader("Accept", "application/json")), "/headers")
+		require.NoError(t, err)
+		assert.Equal(t, "test-agent application/json", body)
+	})
+
+	t.Run("nil client uses DefaultClient", func(t *testing.T) {
+		var c *Client
+		body, err := read(c, "/headers")
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(body, "Go-http-client/"), body)
+		assert.Zero(t, DefaultClient.Timeout)
+	})
+
+	t.Run("gzip requested by the caller", func(t *testing.T) {
+		body, err := read(NewClient(WithClientHeader("Accept-Encoding", "gzip")), "/gzip")
+		require.NoError(t, err)
+		assert.Equal(t, `{"gzip":true}`, body)
+	})
+
+	t.Run("max body size", func(t *testing.T) {
+		body, err := read(NewClient(WithMaxBodySize(100)), "/large")
+		require.NoError(t, err)
+		assert.Len(t, body, 100)
+
+		body, err = read(NewClient(WithMaxBodySize(99)), "/large")
+		assert.ErrorIs(t, err, ErrBodyTooLarge)
+		assert.Len(t, body, 99)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		start := time.Now()
+		_, err := read(NewClient(WithClientTimeout(50*time.Millisecond)), "/slow")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("transport from context", func(t *testing.T) {
+		var seen string
+		ctx := ContextWithTransport(context.Background(), roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			seen = r.Header.Get("User-Agent")
+			return &http.Response{StatusCode: 200, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("stubbed"))}, nil
+		}))
+		resp, err := NewClient(WithUserAgent("test-agent")).GetContext(ctx, ts.URL+"/headers")
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		require.NoError(t, err)
+		assert.Equal(t, "stubbed", string(body))
+		assert.Equal(t, "test-agent", seen)
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -0,0 +1,25 @@
+package request
+
+import "context"
+
+// Limiter throttles outbound requests. *ratelimit.Limiter satisfies it.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+type limiterKey struct{}
+
+// ContextWithLimiter returns a copy of ctx carrying limiter. GetContext and
+// DocumentRetrieverV2.RetrieveDocumentContext wait on it before every request.
+func ContextWithLimiter(ctx context.Context, limiter Limiter) context.Context {
+	return context.WithValue(ctx, limiterKey{}, limiter)
+}
+
+// waitLimiter blocks on the Limiter carried by ctx, if any.
+func waitLimiter(ctx context.Context) error {
+	limiter, ok := ctx.Value(limiterKey{}).(Limiter)
+	if !ok || limiter == nil {
+		return nil
+	}
+	return limiter.Wait(ctx)
+}
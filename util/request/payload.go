@@ -0,0 +1,50 @@
+package request
+
+import (
+	"context"
+	"io"
+)
+
+// PayloadRecorder receives every payload fetched with a context carrying it:
+// the body of a GetContext response once fully read, or the HTML of a
+// RetrieveDocumentContext document. It may be called concurrently.
+type PayloadRecorder func(url string, payload []byte)
+
+type payloadRecorderKey struct{}
+
+// ContextWithPayloadRecorder returns a copy of ctx whose fetched payloads are
+// passed to record, e.g. to archive the source of scraped records.
+func ContextWithPayloadRecorder(ctx context.Context, record PayloadRecorder) context.Context {
+	return context.WithValue(ctx, payloadRecorderKey{}, record)
+}
+
+// payloadRecorder returns the PayloadRecorder carried by ctx, nil if none.
+func payloadRecorder(ctx context.Context) PayloadRecorder {
+	record, _ := ctx.Value(payloadRecorderKey{}).(PayloadRecorder)
+	return record
+}
+
+// recordPayload passes payload to the PayloadRecorder carried by ctx, if any.
+func recordPayload(ctx context.Context, url string, payload []byte) {
+	if record := payloadRecorder(ctx); record != nil {
+		record(url, payload)
+	}
+}
+
+// recordingReader copies what is read from reader and hands it to record
+// once reader is read to EOF.
+type recordingReader struct {
+	reader  io.Reader
+	payload []byte
+	record  func(payload []byte)
+}
+
+func (r *recordingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.payload = append(r.payload, p[:n]...)
+	if err == io.EOF && r.record != nil {
+		r.record(r.payload)
+		r.record = nil
+	}
+	return n, err
+}
@@ -0,0 +1,50 @@
+//go:build unit
+
+package request
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextWithPayloadRecorder(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"game":1}`))
+	}))
+	defer ts.Close()
+
+	recorded := map[string]string{}
+	ctx := ContextWithPayloadRecorder(context.Background(), func(url string, payload []byte) {
+		recorded[url] = string(payload)
+	})
+
+	resp, err := NewClient(WithMaxBodySize(5)).GetContext(ctx, ts.URL+"/truncated")
+	require.NoError(t, err)
+	_, err = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.ErrorIs(t, err, ErrBodyTooLarge)
+	assert.Empty(t, recorded, "partially read body recorded")
+
+	resp, err = GetContext(ctx, ts.URL+"/game")
+	require.NoError(t, err)
+	_, err = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{ts.URL + "/game": `{"game":1}`}, recorded)
+
+	dr := newDocumentRetrieverV2(nil)
+	dr.Interceptor = func(next DocumentFetcher) DocumentFetcher {
+		return func(ctx context.Context, url, waitReadySelector string) (string, error) {
+			return `<div id="game">1</div>`, nil
+		}
+	}
+	_, err = dr.RetrieveDocumentContext(ctx, "https://www.nba.com/games", "div#game")
+	require.NoError(t, err)
+	assert.Equal(t, `<div id="game">1</div>`, recorded["https://www.nba.com/games"])
+}
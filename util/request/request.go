@@ -18,15 +18,33 @@ import (
 // Get performs a GET request
 // Returns an http response
 func Get(url string) (*http.Response, error) {
-	log.Printf("Fetching from %s\n", url)
-	resp, err := http.Get(url)
-	if err != nil {
-		return nil, fmt.Errorf("HTTP Error at %s: %w", url, err)
-	}
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Request to '%s' received a %s status", url, resp.Status)
-	}
-	return resp, nil
+	return GetContext(context.Background(), url)
+}
+
+// GetContext performs a GET request bound to ctx with DefaultClient. The
+// request is aborted when ctx is cancelled or its deadline expires.
+// Returns an http response
+func GetContext(ctx context.Context, url string) (*http.Response, error) {
+	return DefaultClient.GetContext(ctx, url)
+}
+
+type transportKey struct{}
+
+// ContextWithTransport returns a copy of ctx carrying transport, which
+// Client.GetContext then sends requests through, e.g. Cassette.Transport.
+func ContextWithTransport(ctx context.Context, transport http.RoundTripper) context.Context {
+	return context.WithValue(ctx, transportKey{}, transport)
+}
+
+// StatusError is returned by Get and GetContext when the response status is not 200 OK.
+type StatusError struct {
+	URL        string
+	StatusCode int
+	Status     string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("Request to '%s' received a %s status", e.URL, e.Status)
 }
 
 // DocumentRetriever
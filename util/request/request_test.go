@@ -56,7 +56,9 @@ func TestGetUnitTests(t *testing.T) {
 			resp, err := Get(dummyServer.URL)
 
 			if tt.isError {
-				assert.Error(t, err)
+				var statusErr *StatusError
+				assert.ErrorAs(t, err, &statusErr)
+				assert.Equal(t, tt.status, statusErr.StatusCode)
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, resp)
@@ -68,6 +70,42 @@ func TestGetUnitTests(t *testing.T) {
 	}
 }
 
+func TestGetContextCancelled(t *testing.T) {
+	dummyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer dummyServer.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	resp, err := GetContext(ctx, dummyServer.URL)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, resp)
+}
+
+type countingLimiter struct{ calls int }
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.calls++
+	return ctx.Err()
+}
+
+func TestGetContextLimiter(t *testing.T) {
+	dummyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer dummyServer.Close()
+
+	limiter := &countingLimiter{}
+	ctx := ContextWithLimiter(context.Background(), limiter)
+	for range 3 {
+		resp, err := GetContext(ctx, dummyServer.URL)
+		assert.NoError(t, err)
+		resp.Body.Close()
+	}
+	assert.Equal(t, 3, limiter.calls)
+}
+
 func TestGetIntegrationTests(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration test")
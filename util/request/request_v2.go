@@ -6,7 +6,9 @@ import (
 	"io"
 	"log"
 	"log/slog"
+	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
@@ -17,8 +19,9 @@ import (
 // DocumentRetrieverV2 manages a persistent headless Chrome browser session for
 // fetching and parsing web documents. Unlike DocumentRetriever, the browser
 // process and cookies are shared across all RetrieveDocument calls.
-// Each RetrieveDocument call opens a new tab within the shared browser session,
-// allowing safe concurrent use. Create with NewDocumentRetrieverV2 and call
+// Each RetrieveDocument call loads the document in its own tab within the
+// shared browser session, allowing safe concurrent use; MaxTabs bounds the
+// number of tabs and ReuseTabs keeps them open between calls. Create with NewDocumentRetrieverV2 and call
 // Close when done.
 type DocumentRetrieverV2 struct {
 	Timeout        time.Duration
@@ -26,12 +29,36 @@ type DocumentRetrieverV2 struct {
 	ChromeRun      func(ctx context.Context, actions ...chromedp.Action) error
 	DocumentReader func(r io.Reader) (*goquery.Document, error)
 	NewTabContext  func(ctx context.Context) (context.Context, context.CancelFunc)
+	// Interceptor, when set, wraps every browser fetch, e.g. Cassette.DocumentInterceptor
+	Interceptor DocumentInterceptor
+	// Proxy routes the browser's traffic through an HTTP or SOCKS5 proxy. Default nil = direct
+	Proxy *url.URL
+	// ExecPath is the Chrome binary to launch. Default "" = found on PATH
+	ExecPath string
+	// AllocatorFlags are extra Chrome command line flags, e.g. {"disable-gpu": true}
+	AllocatorFlags map[string]any
+	// NoSandbox disables Chrome's sandbox, typically required in containers running as root
+	NoSandbox bool
+	// MaxTabs is the max number of tabs loading documents at once; further
+	// fetches wait for a tab. Default 0 = unlimited
+	MaxTabs int
+	// ReuseTabs keeps tabs open after successful fetches for the next one instead of closing them
+	ReuseTabs bool
+	tabsOnce  sync.Once
+	tabs      *tabPool
 	// Persistent browser context — parent for all per-call tab contexts
 	browserCtx     context.Context
 	browserCancel  context.CancelFunc
 	networkHeaders network.Headers
 }
 
+// DocumentFetcher returns the outer HTML of the element matching waitReadySelector at url.
+type DocumentFetcher func(ctx context.Context, url, waitReadySelector string) (string, error)
+
+// DocumentInterceptor wraps the browser fetch of DocumentRetrieverV2. It may
+// call next to reach the browser or answer without it.
+type DocumentInterceptor func(next DocumentFetcher) DocumentFetcher
+
 // RetrieverOptionV2 is a functional option for configuring a DocumentRetrieverV2.
 type RetrieverOptionV2 func(*DocumentRetrieverV2)
 
@@ -55,29 +82,94 @@ func WithDebugV2(debug bool) RetrieverOptionV2 {
 	}
 }
 
+// WithDocumentInterceptor returns a RetrieverOptionV2 that sets the DocumentInterceptor wrapping every browser fetch.
+//
+// Parameter:
+//   - interceptor: The DocumentInterceptor, e.g. Cassette.DocumentInterceptor()
+func WithDocumentInterceptor(interceptor DocumentInterceptor) RetrieverOptionV2 {
+	return func(dr *DocumentRetrieverV2) {
+		dr.Interceptor = interceptor
+	}
+}
+
+// WithProxyV2 returns a RetrieverOptionV2 that routes the browser's traffic through a proxy.
+// Credentials in the URL answer the proxy's auth challenges; Chrome only supports them for HTTP(S) proxies.
+//
+// Parameter:
+//   - proxy: The proxy URL, see ParseProxy
+func WithProxyV2(proxy *url.URL) RetrieverOptionV2 {
+	return func(dr *DocumentRetrieverV2) {
+		dr.Proxy = proxy
+	}
+}
+
+// WithExecPathV2 returns a RetrieverOptionV2 that sets the Chrome binary to launch.
+//
+// Parameter:
+//   - path: The path of the Chrome or Chromium binary
+func WithExecPathV2(path string) RetrieverOptionV2 {
+	return func(dr *DocumentRetrieverV2) {
+		dr.ExecPath = path
+	}
+}
+
+// WithAllocatorFlagV2 returns a RetrieverOptionV2 that adds a Chrome command line flag.
+//
+// Parameters:
+//   - name: The flag name without leading dashes, e.g. disable-gpu
+//   - value: The flag value; true adds the bare flag and false removes it
+func WithAllocatorFlagV2(name string, value any) RetrieverOptionV2 {
+	return func(dr *DocumentRetrieverV2) {
+		if dr.AllocatorFlags == nil {
+			dr.AllocatorFlags = map[string]any{}
+		}
+		dr.AllocatorFlags[name] = value
+	}
+}
+
+// WithNoSandboxV2 returns a RetrieverOptionV2 that enables or disables Chrome's --no-sandbox flag.
+//
+// Parameter:
+//   - noSandbox: When true, Chrome runs without its sandbox, e.g. as root in a container
+func WithNoSandboxV2(noSandbox bool) RetrieverOptionV2 {
+	return func(dr *DocumentRetrieverV2) {
+		dr.NoSandbox = noSandbox
+	}
+}
+
+// WithMaxTabsV2 returns a RetrieverOptionV2 that bounds the number of tabs loading documents at once.
+//
+// Parameter:
+//   - maxTabs: The max number of concurrent tabs. 0 = unlimited
+func WithMaxTabsV2(maxTabs int) RetrieverOptionV2 {
+	return func(dr *DocumentRetrieverV2) {
+		dr.MaxTabs = maxTabs
+	}
+}
+
+// WithTabReuseV2 returns a RetrieverOptionV2 that enables or disables tab reuse.
+//
+// Parameter:
+//   - reuse: When true, tabs stay open after successful fetches and are reused by later ones
+func WithTabReuseV2(reuse bool) RetrieverOptionV2 {
+	return func(dr *DocumentRetrieverV2) {
+		dr.ReuseTabs = reuse
+	}
+}
+
 // NewDocumentRetrieverV2 creates and initializes a persistent browser session,
 // storing network headers for per-tab setup and applying any provided options.
 // Call Close() when done.
 func NewDocumentRetrieverV2(networkHeaders network.Headers, options ...RetrieverOptionV2) (*DocumentRetrieverV2, error) {
-	dr := &DocumentRetrieverV2{
-		Timeout:        1 * time.Minute,
-		Debug:          false,
-		ChromeRun:      chromedp.Run,
-		DocumentReader: goquery.NewDocumentFromReader,
-		NewTabContext: func(ctx context.Context) (context.Context, context.CancelFunc) {
-			return chromedp.NewContext(ctx)
-		},
-		networkHeaders: networkHeaders,
-	}
+	dr := newDocumentRetrieverV2(networkHeaders)
 	for _, option := range options {
 		option(dr)
 	}
 
-	allocOpts := append(chromedp.DefaultExecAllocatorOptions[:],
-		chromedp.Flag("disable-blink-features", "AutomationControlled"),
-	)
-
-	allocCtx, allocCancel := chromedp.NewExecAllocator(context.Background(), allocOpts...)
+	if dr.Proxy != nil && dr.Proxy.Scheme == "socks5" && dr.Proxy.User != nil {
+		return nil, ErrProxyAuthUnsupported
+	}
+	allocCtx, allocCancel := chromedp.NewExecAllocator(context.Background(), dr.allocatorOptions()...)
 
 	var contextOptions []chromedp.ContextOption
 	if dr.Debug {
@@ -103,38 +195,135 @@ func NewDocumentRetrieverV2(networkHeaders network.Headers, options ...Retriever
 	return dr, nil
 }
 
+// allocatorOptions returns the options launching Chrome.
+func (dr *DocumentRetrieverV2) allocatorOptions() []chromedp.ExecAllocatorOption {
+	allocOpts := append(chromedp.DefaultExecAllocatorOptions[:],
+		chromedp.Flag("disable-blink-features", "AutomationControlled"),
+	)
+	if dr.ExecPath != "" {
+		allocOpts = append(allocOpts, chromedp.ExecPath(dr.ExecPath))
+	}
+	if dr.NoSandbox {
+		allocOpts = append(allocOpts, chromedp.NoSandbox)
+	}
+	if dr.Proxy != nil {
+		allocOpts = append(allocOpts, chromedp.ProxyServer(proxyServer(dr.Proxy)))
+	}
+	for name, value := range dr.AllocatorFlags {
+		allocOpts = append(allocOpts, chromedp.Flag(name, value))
+	}
+	return allocOpts
+}
+
+// newDocumentRetrieverV2 returns a DocumentRetrieverV2 with default settings and no browser session.
+func newDocumentRetrieverV2(networkHeaders network.Headers) *DocumentRetrieverV2 {
+	return &DocumentRetrieverV2{
+		Timeout:        1 * time.Minute,
+		Debug:          false,
+		ChromeRun:      chromedp.Run,
+		DocumentReader: goquery.NewDocumentFromReader,
+		NewTabContext: func(ctx context.Context) (context.Context, context.CancelFunc) {
+			return chromedp.NewContext(ctx)
+		},
+		networkHeaders: networkHeaders,
+	}
+}
+
 // Close tears down the persistent browser session.
 func (dr *DocumentRetrieverV2) Close() {
+	tabs := dr.tabPool()
+	stats := tabs.snapshot()
+	slog.Debug("Browser tabs", "created", stats.Created, "active", stats.Active, "idle", stats.Idle)
+	tabs.close()
 	if dr.browserCancel != nil {
 		dr.browserCancel()
 	}
 }
 
-// RetrieveDocument opens a new tab within the existing browser session,
-// navigates to url, waits for waitReadySelector, and returns the parsed
-// document. The tab is closed when the call returns. Safe to call concurrently.
+// RetrieveDocument takes a tab within the existing browser session (waiting
+// while MaxTabs are busy), navigates to url, waits for waitReadySelector, and
+// returns the parsed document. The tab is closed when the call returns unless
+// ReuseTabs is set. Safe to call concurrently.
 func (dr *DocumentRetrieverV2) RetrieveDocument(url string, waitReadySelector string) (*goquery.Document, error) {
-	tabCtx, tabCancel := dr.NewTabContext(dr.browserCtx)
-	defer tabCancel()
+	return dr.RetrieveDocumentContext(context.Background(), url, waitReadySelector)
+}
+
+// RetrieveDocumentContext behaves like RetrieveDocument but additionally
+// aborts navigation and closes the tab as soon as parent is cancelled.
+func (dr *DocumentRetrieverV2) RetrieveDocumentContext(parent context.Context, url string, waitReadySelector string) (*goquery.Document, error) {
+	if err := parent.Err(); err != nil {
+		return nil, fmt.Errorf("error fetching document from %s: %w", url, err)
+	}
+	if err := waitLimiter(parent); err != nil {
+		return nil, fmt.Errorf("error fetching document from %s: %w", url, err)
+	}
+	fetch := dr.fetchOuterHTML
+	if dr.Interceptor != nil {
+		fetch = dr.Interceptor(fetch)
+	}
+	outer, err := fetch(parent, url, waitReadySelector)
+	if err != nil {
+		if parent.Err() != nil {
+			err = parent.Err()
+		}
+		return nil, fmt.Errorf("error fetching document from %s: %w", url, err)
+	}
+	recordPayload(parent, url, []byte(outer))
+
+	doc, err := dr.DocumentReader(strings.NewReader(outer))
+	if err != nil {
+		return nil, fmt.Errorf("error reading document from %s: %w", url, err)
+	}
+	return doc, nil
+}
 
-	ctx, cancel := context.WithTimeout(tabCtx, dr.Timeout)
+// fetchOuterHTML loads url in a new tab of the browser session and returns the outer HTML of waitReadySelector.
+func (dr *DocumentRetrieverV2) fetchOuterHTML(parent context.Context, url string, waitReadySelector string) (string, error) {
+	if dr.browserCtx == nil {
+		return "", fmt.Errorf("no browser session, create the retriever with NewDocumentRetrieverV2")
+	}
+	tabs := dr.tabPool()
+	t, err := tabs.acquire(parent)
+	if err != nil {
+		return "", err
+	}
+	// Derived contexts only abort the actions; cancelling t.ctx closes the tab
+	ctx, cancel := context.WithTimeout(t.ctx, dr.Timeout)
 	defer cancel()
+	// The tab must descend from the browser context, so propagate cancellation
+	// from parent rather than deriving from it.
+	stop := context.AfterFunc(parent, cancel)
+	defer stop()
 
 	slog.Info("Retrieving document", "url", url)
+	actions := []chromedp.Action{}
+	if dr.Proxy != nil && dr.Proxy.User != nil {
+		actions = append(actions, proxyAuth(ctx, dr.Proxy))
+	}
 	var outer string
-	if err := dr.ChromeRun(ctx,
+	actions = append(actions,
 		network.Enable(),
 		network.SetExtraHTTPHeaders(dr.networkHeaders),
 		chromedp.Navigate(url),
 		chromedp.WaitReady(waitReadySelector),
 		chromedp.OuterHTML(waitReadySelector, &outer, chromedp.ByQuery),
-	); err != nil {
-		return nil, fmt.Errorf("error fetching document from %s: %w", url, err)
-	}
+	)
+	err = dr.ChromeRun(ctx, actions...)
+	tabs.release(t, err == nil)
+	return outer, err
+}
 
-	doc, err := dr.DocumentReader(strings.NewReader(outer))
-	if err != nil {
-		return nil, fmt.Errorf("error reading document from %s: %w", url, err)
-	}
-	return doc, nil
+// TabStats returns the number of waiting, active, idle and created tabs, e.g. to tune MaxTabs against the concurrency of the runners.
+func (dr *DocumentRetrieverV2) TabStats() TabPoolStats {
+	return dr.tabPool().snapshot()
+}
+
+// tabPool returns the pool of tabs, created on first use from MaxTabs and ReuseTabs.
+func (dr *DocumentRetrieverV2) tabPool() *tabPool {
+	dr.tabsOnce.Do(func() {
+		dr.tabs = newTabPool(dr.MaxTabs, dr.ReuseTabs, func() (context.Context, context.CancelFunc) {
+			return dr.NewTabContext(dr.browserCtx)
+		})
+	})
+	return dr.tabs
 }
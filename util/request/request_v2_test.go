@@ -116,6 +116,43 @@ func TestDocumentRetrieverV2RetrieveDocument(t *testing.T) {
 	}
 }
 
+// TestDocumentRetrieverV2RetrieveDocumentContext verifies that cancelling the
+// caller's context aborts an in-flight ChromeRun and surfaces context.Canceled.
+func TestDocumentRetrieverV2RetrieveDocumentContext(t *testing.T) {
+	browserCtx, browserCancel := context.WithCancel(context.Background())
+	defer browserCancel()
+
+	dr := &DocumentRetrieverV2{
+		Timeout: 5 * time.Second,
+		ChromeRun: func(ctx context.Context, actions ...chromedp.Action) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		DocumentReader: goquery.NewDocumentFromReader,
+		NewTabContext: func(parent context.Context) (context.Context, context.CancelFunc) {
+			return context.WithCancel(parent)
+		},
+		browserCtx: browserCtx,
+	}
+
+	t.Run("cancelled mid-flight", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+		doc, err := dr.RetrieveDocumentContext(ctx, "https://example.com", "body")
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, doc)
+		assert.NoError(t, browserCtx.Err(), "browser session must survive a cancelled tab")
+	})
+
+	t.Run("already cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		doc, err := dr.RetrieveDocumentContext(ctx, "https://example.com", "body")
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, doc)
+	})
+}
+
 // TestDocumentRetrieverV2Close verifies that Close invokes browserCancel exactly
 // once and is safe to call when browserCancel is nil.
 func TestDocumentRetrieverV2Close(t *testing.T) {
@@ -0,0 +1,127 @@
+package request
+
+import (
+	"context"
+	"sync"
+)
+
+// TabPoolStats is a snapshot of the tabs of a DocumentRetrieverV2.
+type TabPoolStats struct {
+	// Waiting is the number of fetches waiting for a tab because MaxTabs are active
+	Waiting int
+	// Active is the number of tabs currently loading a document
+	Active int
+	// Idle is the number of open tabs kept for reuse
+	Idle int
+	// Created is the total number of tabs opened
+	Created int
+}
+
+// tab is a browser tab context and the function closing it.
+type tab struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// tabPool bounds the number of tabs loading documents at once and, with
+// reuse, keeps healthy tabs open for the next fetch.
+type tabPool struct {
+	// slots holds one token per active tab; nil when unbounded
+	slots  chan struct{}
+	reuse  bool
+	newTab func() (context.Context, context.CancelFunc)
+
+	mu     sync.Mutex
+	idle   []tab
+	stats  TabPoolStats
+	closed bool
+}
+
+func newTabPool(maxTabs int, reuse bool, newTab func() (context.Context, context.CancelFunc)) *tabPool {
+	p := &tabPool{reuse: reuse, newTab: newTab}
+	if maxTabs > 0 {
+		p.slots = make(chan struct{}, maxTabs)
+	}
+	return p
+}
+
+// acquire returns a tab once fewer than maxTabs are active, giving up when ctx is done.
+func (p *tabPool) acquire(ctx context.Context) (tab, error) {
+	if p.slots != nil {
+		p.mu.Lock()
+		p.stats.Waiting++
+		p.mu.Unlock()
+		var err error
+		select {
+		case p.slots <- struct{}{}:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+		p.mu.Lock()
+		p.stats.Waiting--
+		p.mu.Unlock()
+		if err != nil {
+			return tab{}, err
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.stats.Active++
+	if n := len(p.idle); n > 0 {
+		t := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.stats.Idle = len(p.idle)
+		return t, nil
+	}
+	p.stats.Created++
+	ctx, cancel := p.newTab()
+	return tab{ctx: ctx, cancel: cancel}, nil
+}
+
+// release returns t to the pool. Tabs are closed unless reuse is enabled and
+// the fetch succeeded, as a failed navigation can leave the tab unusable.
+func (p *tabPool) release(t tab, healthy bool) {
+	p.mu.Lock()
+	p.stats.Active--
+	keep := p.reuse && healthy && !p.closed && t.ctx.Err() == nil
+	if keep {
+		p.idle = append(p.idle, t)
+		p.stats.Idle = len(p.idle)
+	}
+	p.mu.Unlock()
+	if !keep {
+		t.cancel()
+	}
+	p.releaseSlot()
+}
+
+// releaseSlot frees the slot taken by acquire, if any.
+func (p *tabPool) releaseSlot() {
+	if p.slots == nil {
+		return
+	}
+	select {
+	case <-p.slots:
+	default:
+	}
+}
+
+// close closes the idle tabs; tabs released afterwards are closed too.
+func (p *tabPool) close() {
+	p.mu.Lock()
+	idle := p.idle
+	p.idle = nil
+	p.stats.Idle = 0
+	p.closed = true
+	p.mu.Unlock()
+	for _, t := range idle {
+		t.cancel()
+	}
+}
+
+func (p *tabPool) snapshot() TabPoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.stats
+}
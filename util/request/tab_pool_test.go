@@ -0,0 +1,116 @@
+//go:build unit
+
+package request
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/chromedp/chromedp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPooledRetriever returns a DocumentRetrieverV2 whose ChromeRun blocks until
+// release is closed, and the set of tab contexts it opened.
+func newPooledRetriever(t *testing.T, release chan struct{}, options ...RetrieverOptionV2) (*DocumentRetrieverV2, *sync.Map) {
+	browserCtx, browserCancel := context.WithCancel(context.Background())
+	t.Cleanup(browserCancel)
+	tabs := &sync.Map{}
+	dr := &DocumentRetrieverV2{
+		Timeout: 5 * time.Second,
+		ChromeRun: func(ctx context.Context, actions ...chromedp.Action) error {
+			select {
+			case <-release:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			return nil
+		},
+		DocumentReader: goquery.NewDocumentFromReader,
+		NewTabContext: func(parent context.Context) (context.Context, context.CancelFunc) {
+			ctx, cancel := context.WithCancel(parent)
+			tabs.Store(ctx, true)
+			return ctx, cancel
+		},
+		browserCtx: browserCtx,
+	}
+	for _, option := range options {
+		option(dr)
+	}
+	return dr, tabs
+}
+
+func TestTabPoolMaxTabs(t *testing.T) {
+	release := make(chan struct{})
+	dr, _ := newPooledRetriever(t, release, WithMaxTabsV2(2))
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := dr.RetrieveDocument("https://example.com", "body")
+			assert.NoError(t, err)
+		}()
+	}
+	require.Eventually(t, func() bool {
+		stats := dr.TabStats()
+		return stats.Active == 2 && stats.Waiting == 3
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, 2, dr.TabStats().Created)
+
+	close(release)
+	wg.Wait()
+	stats := dr.TabStats()
+	assert.Equal(t, TabPoolStats{Created: 5}, stats)
+}
+
+func TestTabPoolWaitRespectsContext(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	dr, _ := newPooledRetriever(t, release, WithMaxTabsV2(1))
+
+	go dr.RetrieveDocument("https://example.com", "body")
+	require.Eventually(t, func() bool { return dr.TabStats().Active == 1 }, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := dr.RetrieveDocumentContext(ctx, "https://example.com", "body")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, TabPoolStats{Active: 1, Created: 1}, dr.TabStats())
+}
+
+func TestTabPoolReuse(t *testing.T) {
+	release := make(chan struct{})
+	close(release)
+	dr, tabs := newPooledRetriever(t, release, WithMaxTabsV2(1), WithTabReuseV2(true))
+
+	for range 3 {
+		_, err := dr.RetrieveDocument("https://example.com", "body")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, TabPoolStats{Idle: 1, Created: 1}, dr.TabStats())
+
+	// A failed fetch closes its tab instead of returning it to the pool
+	dr.ChromeRun = func(ctx context.Context, actions ...chromedp.Action) error { return errors.New("navigation failed") }
+	_, err := dr.RetrieveDocument("https://example.com", "body")
+	assert.Error(t, err)
+	assert.Equal(t, TabPoolStats{Created: 1}, dr.TabStats())
+
+	dr.ChromeRun = func(ctx context.Context, actions ...chromedp.Action) error { return nil }
+	_, err = dr.RetrieveDocument("https://example.com", "body")
+	require.NoError(t, err)
+	assert.Equal(t, TabPoolStats{Idle: 1, Created: 2}, dr.TabStats())
+
+	dr.Close()
+	assert.Equal(t, TabPoolStats{Created: 2}, dr.TabStats())
+	tabs.Range(func(tab, _ any) bool {
+		assert.Error(t, tab.(context.Context).Err(), "every tab must be closed")
+		return true
+	})
+}
@@ -0,0 +1,45 @@
+package validate
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+)
+
+// baseballSavantPlayByPlay are the rules of the Baseball Savant play by play.
+var baseballSavantPlayByPlay = Rules[model.Matchup, model.PlayByPlay]{
+	{
+		Name: "game-final",
+		Check: func(matchup model.Matchup, _ []model.PlayByPlay) error {
+			switch matchup.Status {
+			case "Final", "Game Over", "Completed Early":
+				return nil
+			default:
+				return fmt.Errorf("event %d is %q, play by play may be partial", matchup.EventID, matchup.Status)
+			}
+		},
+	},
+	{
+		Name: "pitch-numbers-monotonic",
+		Check: func(_ model.Matchup, records []model.PlayByPlay) error {
+			// Records are grouped by pitcher; put them back in game order
+			pitches := slices.SortedFunc(slices.Values(records), func(a, b model.PlayByPlay) int {
+				return cmp.Compare(a.GameTotalPitches, b.GameTotalPitches)
+			})
+			for i := 1; i < len(pitches); i++ {
+				prev, pitch := pitches[i-1], pitches[i]
+				switch {
+				case pitch.GameTotalPitches == prev.GameTotalPitches:
+					return fmt.Errorf("game pitch %d appears twice", pitch.GameTotalPitches)
+				case pitch.AtBatNum < prev.AtBatNum:
+					return fmt.Errorf("game pitch %d is in at-bat %d, after at-bat %d", pitch.GameTotalPitches, pitch.AtBatNum, prev.AtBatNum)
+				case pitch.AtBatNum == prev.AtBatNum && pitch.PitchNumber <= prev.PitchNumber:
+					return fmt.Errorf("at-bat %d pitch %d follows pitch %d", pitch.AtBatNum, pitch.PitchNumber, prev.PitchNumber)
+				}
+			}
+			return nil
+		},
+	},
+}
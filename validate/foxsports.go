@@ -0,0 +1,59 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model"
+)
+
+// foxSportsMLBBattingBoxScore are the rules of the Fox Sports MLB batting box score.
+var foxSportsMLBBattingBoxScore = Rules[model.Matchup, model.MLBBattingBoxScoreStats]{
+	{
+		Name: "game-final",
+		Check: func(matchup model.Matchup, _ []model.MLBBattingBoxScoreStats) error {
+			// e.g. FINAL, FINAL/10
+			if !strings.HasPrefix(strings.ToUpper(matchup.StatusLine), "FINAL") {
+				return fmt.Errorf("event %d is %q, box score may be partial", matchup.EventID, matchup.StatusLine)
+			}
+			return nil
+		},
+	},
+	{
+		Name: "non-negative-stats",
+		Check: func(_ model.Matchup, records []model.MLBBattingBoxScoreStats) error {
+			for _, r := range records {
+				if min(r.AtBat, r.Runs, r.Hits, r.RunsBattedIn, r.Walks, r.Strikeouts, r.LeftOnBase) < 0 {
+					return fmt.Errorf("player %s (%d) has a negative stat", r.Player, r.PlayerID)
+				}
+			}
+			return nil
+		},
+	},
+	{
+		Name: "hits-within-at-bats",
+		Check: func(_ model.Matchup, records []model.MLBBattingBoxScoreStats) error {
+			for _, r := range records {
+				if r.Hits > r.AtBat {
+					return fmt.Errorf("player %s (%d) has %d hits in %d at-bats", r.Player, r.PlayerID, r.Hits, r.AtBat)
+				}
+			}
+			return nil
+		},
+	},
+	{
+		Name: "team-runs-match-score",
+		Check: func(matchup model.Matchup, records []model.MLBBattingBoxScoreStats) error {
+			runs := map[int64]int32{}
+			for _, record := range records {
+				runs[record.TeamID] += record.Runs
+			}
+			if runs[matchup.HomeTeamID] != matchup.HomeScore || runs[matchup.AwayTeamID] != matchup.AwayScore {
+				return fmt.Errorf("player runs sum to %s %d - %s %d, matchup score is %d - %d",
+					matchup.AwayTeamAbbreviation, runs[matchup.AwayTeamID], matchup.HomeTeamAbbreviation, runs[matchup.HomeTeamID],
+					matchup.AwayScore, matchup.HomeScore)
+			}
+			return nil
+		},
+	},
+}
@@ -0,0 +1,51 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+)
+
+// nbaFinal is the nba.com event status of a final game (1=pregame, 2=in progress).
+const nbaFinal = 3
+
+// nbaTraditionalBoxScore are the rules of the full game traditional box score.
+// The period variants are left out: their points don't add up to the final score.
+var nbaTraditionalBoxScore = Rules[model.Matchup, model.BoxScoreTraditional]{
+	{
+		Name: "game-final",
+		Check: func(matchup model.Matchup, _ []model.BoxScoreTraditional) error {
+			if matchup.EventStatus != nbaFinal {
+				return fmt.Errorf("event %s is %q, box score may be partial", matchup.EventID, matchup.EventStatusText)
+			}
+			return nil
+		},
+	},
+	{
+		Name: "team-points-match-score",
+		Check: func(matchup model.Matchup, records []model.BoxScoreTraditional) error {
+			points := map[int64]int32{}
+			for _, record := range records {
+				points[record.TeamID] += record.Points
+			}
+			if points[matchup.HomeTeamID] != matchup.HomeTeamScore || points[matchup.AwayTeamID] != matchup.AwayTeamScore {
+				return fmt.Errorf("player points sum to %s %d - %s %d, matchup score is %d - %d",
+					matchup.AwayTeamAbbreviation, points[matchup.AwayTeamID], matchup.HomeTeamAbbreviation, points[matchup.HomeTeamID],
+					matchup.AwayTeamScore, matchup.HomeTeamScore)
+			}
+			return nil
+		},
+	},
+	{
+		Name: "made-within-attempted",
+		Check: func(_ model.Matchup, records []model.BoxScoreTraditional) error {
+			for _, r := range records {
+				if r.FieldGoalsMade > r.FieldGoalsAttempted || r.ThreePointersMade > r.ThreePointersAttempted ||
+					r.FreeThrowsMade > r.FreeThrowsAttempted || r.ThreePointersMade > r.FieldGoalsMade {
+					return fmt.Errorf("player %s (%d) made more shots than attempted", r.PlayerName, r.PlayerID)
+				}
+			}
+			return nil
+		},
+	},
+}
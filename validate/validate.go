@@ -0,0 +1,106 @@
+// Package validate checks scraped records for silent bad data, such as
+// partial box scores scraped before a game was final or player stats that
+// don't add up to the team's score. Rules run per event against the matchup
+// the records were scraped for; runners apply the built-in rules of a feed
+// when their Validation mode is set.
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/lightning-dabbler/sportscrape"
+)
+
+var (
+	// ErrInvalid is wrapped by every rule violation.
+	ErrInvalid = errors.New("invalid records")
+	// ErrInvalidMode is returned by ParseMode for unknown modes.
+	ErrInvalidMode = errors.New("invalid validation mode")
+)
+
+// Mode is how a runner treats rule violations.
+type Mode string
+
+const (
+	// Off skips validation.
+	Off Mode = ""
+	// Warn logs violations and keeps the event's records.
+	Warn Mode = "warn"
+	// Fail fails the event, as if its scrape errored.
+	Fail Mode = "fail"
+)
+
+// ParseMode returns the Mode named s: "warn", "fail", or "" / "off" for Off.
+func ParseMode(s string) (Mode, error) {
+	switch s {
+	case "", "off":
+		return Off, nil
+	case string(Warn), string(Fail):
+		return Mode(s), nil
+	default:
+		return Off, fmt.Errorf("%w %q, valid options: warn, fail", ErrInvalidMode, s)
+	}
+}
+
+// Rule checks the records scraped for a single event.
+type Rule[M, E any] struct {
+	// Name identifies the rule in violations, e.g. team-points-match-score
+	Name string
+	// Check returns why records scraped for matchup are invalid, nil when they are valid.
+	Check func(matchup M, records []E) error
+}
+
+// Rules is the rule set of a feed.
+type Rules[M, E any] []Rule[M, E]
+
+// Validate checks records against every rule and returns the violations
+// joined, nil when all rules pass.
+func (r Rules[M, E]) Validate(matchup M, records []E) error {
+	var errs []error
+	for _, rule := range r {
+		if err := rule.Check(matchup, records); err != nil {
+			errs = append(errs, &Violation{Rule: rule.Name, Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Violation is a rule the records of an event failed.
+type Violation struct {
+	Rule string
+	Err  error
+}
+
+func (v *Violation) Error() string {
+	return fmt.Sprintf("validation rule %s: %v", v.Rule, v.Err)
+}
+
+func (v *Violation) Unwrap() []error {
+	return []error{ErrInvalid, v.Err}
+}
+
+// builtin maps feeds to their rule sets, each a Rules of the feed's matchup and record models.
+var builtin = map[sportscrape.Feed]any{
+	sportscrape.NBATraditionalBoxScore:      nbaTraditionalBoxScore,
+	sportscrape.FSMLBBattingBoxScore:        foxSportsMLBBattingBoxScore,
+	sportscrape.BaseballSavantMLBPlayByPlay: baseballSavantPlayByPlay,
+}
+
+// For returns the built-in rules of feed, nil when feed has none or its
+// models are not M and E.
+func For[M, E any](feed sportscrape.Feed) Rules[M, E] {
+	rules, _ := builtin[feed].(Rules[M, E])
+	return rules
+}
+
+// Feeds returns the feeds with built-in rules, sorted.
+func Feeds() []sportscrape.Feed {
+	feeds := make([]sportscrape.Feed, 0, len(builtin))
+	for feed := range builtin {
+		feeds = append(feeds, feed)
+	}
+	slices.Sort(feeds)
+	return feeds
+}
@@ -0,0 +1,104 @@
+//go:build unit
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/lightning-dabbler/sportscrape"
+	baseballsavantmlb "github.com/lightning-dabbler/sportscrape/dataprovider/baseballsavantmlb/model"
+	foxsports "github.com/lightning-dabbler/sportscrape/dataprovider/foxsports/model"
+	nba "github.com/lightning-dabbler/sportscrape/dataprovider/nba/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMode(t *testing.T) {
+	for input, want := range map[string]Mode{"": Off, "off": Off, "warn": Warn, "fail": Fail} {
+		mode, err := ParseMode(input)
+		require.NoError(t, err)
+		assert.Equal(t, want, mode)
+	}
+	_, err := ParseMode("strict")
+	assert.ErrorIs(t, err, ErrInvalidMode)
+}
+
+func TestFor(t *testing.T) {
+	assert.NotEmpty(t, For[nba.Matchup, nba.BoxScoreTraditional](sportscrape.NBATraditionalBoxScore))
+	assert.Nil(t, For[nba.Matchup, nba.BoxScoreTraditional](sportscrape.NBATraditionalBoxScoreQ1), "period points don't add up to the score")
+	assert.Nil(t, For[nba.Matchup, nba.BoxScoreAdvanced](sportscrape.NBATraditionalBoxScore), "mismatched models")
+	for _, feed := range Feeds() {
+		assert.Contains(t, builtin, feed)
+	}
+}
+
+// violated returns the names of the rules records violate.
+func violated[M, E any](t *testing.T, rules Rules[M, E], matchup M, records []E) []string {
+	t.Helper()
+	err := rules.Validate(matchup, records)
+	if err == nil {
+		return nil
+	}
+	assert.ErrorIs(t, err, ErrInvalid)
+	var names []string
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+		names = append(names, err.(*Violation).Rule)
+	}
+	return names
+}
+
+func TestNBATraditionalBoxScore(t *testing.T) {
+	matchup := nba.Matchup{EventID: "0042400407", EventStatus: 3, HomeTeamID: 1, HomeTeamScore: 103, AwayTeamID: 2, AwayTeamScore: 91}
+	records := []nba.BoxScoreTraditional{
+		{TeamID: 1, Points: 60, FieldGoalsMade: 20, FieldGoalsAttempted: 40},
+		{TeamID: 1, Points: 43},
+		{TeamID: 2, Points: 91, ThreePointersMade: 5, ThreePointersAttempted: 12, FieldGoalsMade: 30, FieldGoalsAttempted: 70},
+	}
+	assert.Empty(t, violated(t, nbaTraditionalBoxScore, matchup, records))
+
+	partial := matchup
+	partial.EventStatus = 2
+	partial.HomeTeamScore = 110
+	assert.Equal(t, []string{"game-final", "team-points-match-score"}, violated(t, nbaTraditionalBoxScore, partial, records))
+
+	records[2].ThreePointersMade = 31
+	assert.Equal(t, []string{"made-within-attempted"}, violated(t, nbaTraditionalBoxScore, matchup, records))
+}
+
+func TestFoxSportsMLBBattingBoxScore(t *testing.T) {
+	matchup := foxsports.Matchup{EventID: 91234, StatusLine: "FINAL/10", HomeTeamID: 1, HomeScore: 4, AwayTeamID: 2, AwayScore: 3}
+	records := []foxsports.MLBBattingBoxScoreStats{
+		{TeamID: 1, AtBat: 4, Hits: 2, Runs: 3},
+		{TeamID: 1, AtBat: 3, Hits: 1, Runs: 1},
+		{TeamID: 2, AtBat: 5, Hits: 3, Runs: 3},
+	}
+	assert.Empty(t, violated(t, foxSportsMLBBattingBoxScore, matchup, records))
+
+	records[0].AtBat = -1
+	records[2].Runs = 2
+	matchup.StatusLine = "BOT 9TH"
+	assert.Equal(t, []string{"game-final", "non-negative-stats", "hits-within-at-bats", "team-runs-match-score"}, violated(t, foxSportsMLBBattingBoxScore, matchup, records))
+}
+
+func TestBaseballSavantPlayByPlay(t *testing.T) {
+	matchup := baseballsavantmlb.Matchup{EventID: 776543, Status: "Final"}
+	// Grouped by pitcher, not in game order
+	records := []baseballsavantmlb.PlayByPlay{
+		{AtBatNum: 1, PitchNumber: 1, GameTotalPitches: 1},
+		{AtBatNum: 1, PitchNumber: 2, GameTotalPitches: 2},
+		{AtBatNum: 3, PitchNumber: 1, GameTotalPitches: 5},
+		{AtBatNum: 2, PitchNumber: 1, GameTotalPitches: 3},
+		{AtBatNum: 2, PitchNumber: 2, GameTotalPitches: 4},
+	}
+	assert.Empty(t, violated(t, baseballSavantPlayByPlay, matchup, records))
+
+	records[4].PitchNumber = 1
+	assert.Equal(t, []string{"pitch-numbers-monotonic"}, violated(t, baseballSavantPlayByPlay, matchup, records))
+	records[4].PitchNumber = 2
+	records[2].AtBatNum = 1
+	assert.Equal(t, []string{"pitch-numbers-monotonic"}, violated(t, baseballSavantPlayByPlay, matchup, records))
+
+	matchup.Status = "In Progress"
+	records[2].AtBatNum = 3
+	assert.Equal(t, []string{"game-final"}, violated(t, baseballSavantPlayByPlay, matchup, records))
+}